@@ -3,22 +3,30 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 )
 
 // Config represents the application configuration
 type Config struct {
-	Server    ServerConfig    `json:"server"`
-	Database  DatabaseConfig  `json:"database"`
-	NATS      NATSConfig      `json:"nats"`
-	Logging   LoggingConfig   `json:"logging"`
-	Proxy     ProxyConfig     `json:"proxy"`
-	Providers ProvidersConfig `json:"providers"`
+	Server     ServerConfig     `json:"server"`
+	Database   DatabaseConfig   `json:"database"`
+	NATS       NATSConfig       `json:"nats"`
+	Logging    LoggingConfig    `json:"logging"`
+	Proxy      ProxyConfig      `json:"proxy"`
+	Controller ControllerConfig `json:"controller"`
+	Scheduler  SchedulerConfig  `json:"scheduler"`
+	Secrets    SecretsConfig    `json:"secrets"`
+	Auth       AuthConfig       `json:"auth"`
+	Providers  ProvidersConfig  `json:"providers"`
 }
 
-// ServerConfig represents HTTP server configuration
+// ServerConfig represents API server configuration
 type ServerConfig struct {
-	Address string `json:"address"`
-	Port    int    `json:"port"`
+	Address      string `json:"address"`
+	Port         int    `json:"port"`
+	TLSCertFile  string `json:"tlsCertFile"` // serves TLS when set
+	TLSKeyFile   string `json:"tlsKeyFile"`
+	ClientCAFile string `json:"clientCaFile"` // verifies client certificates when set
 }
 
 // DatabaseConfig represents database configuration
@@ -49,6 +57,52 @@ type ProxyConfig struct {
 	Port    int  `json:"port"`
 }
 
+// ControllerConfig represents workload controller configuration
+type ControllerConfig struct {
+	Enabled         bool `json:"enabled"`
+	ResyncInterval  int  `json:"resyncInterval"`  // seconds
+	MaxBackoff      int  `json:"maxBackoff"`      // seconds
+	NodeTimeout     int  `json:"nodeTimeout"`     // seconds without a heartbeat before a node is unhealthy
+	NodeGracePeriod int  `json:"nodeGracePeriod"` // seconds an unhealthy node keeps its workloads
+
+	// Provider resources that no workload refers to are collected as orphans
+	OrphanInterval    int  `json:"orphanInterval"`    // seconds between provider scans, 0 disables collection
+	OrphanGracePeriod int  `json:"orphanGracePeriod"` // seconds a resource stays orphaned before it is deleted
+	OrphanDryRun      bool `json:"orphanDryRun"`      // report orphans without deleting them
+
+	// Cron workloads are run by the replica that holds the cron scheduler lease
+	CronInterval int `json:"cronInterval"` // seconds between passes of the cron scheduler
+}
+
+// SchedulerConfig represents scheduler configuration
+type SchedulerConfig struct {
+	Type               string   `json:"type"`     // "simple" or "framework"
+	Strategy           string   `json:"strategy"` // "lowest_cost", "best_performance", "balanced", "high_availability", "custom"
+	CostWeight         float64  `json:"costWeight"`
+	PerformanceWeight  float64  `json:"performanceWeight"`
+	ReliabilityWeight  float64  `json:"reliabilityWeight"`
+	LatencyWeight      float64  `json:"latencyWeight"`
+	MaxCostPerHour     float64  `json:"maxCostPerHour"` // 0 means no limit
+	PreferredProviders []string `json:"preferredProviders"`
+	ExcludedProviders  []string `json:"excludedProviders"`
+	RequireGPU         bool     `json:"requireGpu"`
+	MinCPUCores        int      `json:"minCpuCores"` // 0 means no minimum
+	MinMemoryGB        int      `json:"minMemoryGb"` // 0 means no minimum
+}
+
+// SecretsConfig represents secret management configuration
+type SecretsConfig struct {
+	EncryptionKey string `json:"encryptionKey"` // base64-encoded 32-byte AES-256 key
+	AllowReveal   bool   `json:"allowReveal"`   // whether secret values may be returned over the API
+}
+
+// AuthConfig represents API authentication configuration
+type AuthConfig struct {
+	Enabled        bool     `json:"enabled"`
+	BootstrapToken string   `json:"bootstrapToken"` // static token of the "bootstrap" cluster admin
+	Admins         []string `json:"admins"`         // principals that are cluster admins without a role binding
+}
+
 // CRIUConfig represents CRIU snapshot configuration
 type CRIUConfig struct {
 	Enabled        bool   `json:"enabled"`
@@ -69,6 +123,7 @@ type ProvidersConfig struct {
 	Kubernetes KubernetesConfig `json:"kubernetes"`
 	Nosana     NosanaConfig     `json:"nosana"`
 	Fly        FlyConfig        `json:"fly"`
+	Fabric     FabricConfig     `json:"fabric"`
 }
 
 // KubernetesConfig represents Kubernetes provider configuration
@@ -92,12 +147,24 @@ type FlyConfig struct {
 	Region       string `json:"region"`
 }
 
+// FabricConfig represents configuration for self-hosted Shuttle nodes. Weaver
+// reaches node agents over mutual TLS with the agent certificate files.
+type FabricConfig struct {
+	Enabled       bool   `json:"enabled"`
+	AgentCertFile string `json:"agentCertFile"` // client certificate agents accept as Weaver
+	AgentKeyFile  string `json:"agentKeyFile"`
+	AgentCAFile   string `json:"agentCaFile"` // CA that issued the agent certificates
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	config := &Config{
 		Server: ServerConfig{
-			Address: getEnv("SERVER_ADDRESS", ":8080"),
-			Port:    getEnvInt("SERVER_PORT", 8080),
+			Address:      getEnv("SERVER_ADDRESS", ":8080"),
+			Port:         getEnvInt("SERVER_PORT", 8080),
+			TLSCertFile:  getEnv("SERVER_TLS_CERT_FILE", ""),
+			TLSKeyFile:   getEnv("SERVER_TLS_KEY_FILE", ""),
+			ClientCAFile: getEnv("SERVER_CLIENT_CA_FILE", ""),
 		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
@@ -119,6 +186,42 @@ func Load() (*Config, error) {
 			Enabled: getEnv("PROXY_ENABLED", "false") == "true",
 			Port:    getEnvInt("PROXY_PORT", 8081),
 		},
+		Controller: ControllerConfig{
+			Enabled:         getEnv("CONTROLLER_ENABLED", "true") == "true",
+			ResyncInterval:  getEnvInt("CONTROLLER_RESYNC_INTERVAL", 10),
+			MaxBackoff:      getEnvInt("CONTROLLER_MAX_BACKOFF", 300),
+			NodeTimeout:     getEnvInt("CONTROLLER_NODE_TIMEOUT", 45),
+			NodeGracePeriod: getEnvInt("CONTROLLER_NODE_GRACE_PERIOD", 120),
+
+			OrphanInterval:    getEnvInt("CONTROLLER_ORPHAN_INTERVAL", 300),
+			OrphanGracePeriod: getEnvInt("CONTROLLER_ORPHAN_GRACE_PERIOD", 900),
+			OrphanDryRun:      getEnv("CONTROLLER_ORPHAN_DRY_RUN", "false") == "true",
+
+			CronInterval: getEnvInt("CONTROLLER_CRON_INTERVAL", 10),
+		},
+		Scheduler: SchedulerConfig{
+			Type:               getEnv("SCHEDULER_TYPE", "simple"),
+			Strategy:           getEnv("SCHEDULER_STRATEGY", "balanced"),
+			CostWeight:         getEnvFloat("SCHEDULER_COST_WEIGHT", 0.4),
+			PerformanceWeight:  getEnvFloat("SCHEDULER_PERFORMANCE_WEIGHT", 0.3),
+			ReliabilityWeight:  getEnvFloat("SCHEDULER_RELIABILITY_WEIGHT", 0.2),
+			LatencyWeight:      getEnvFloat("SCHEDULER_LATENCY_WEIGHT", 0.1),
+			MaxCostPerHour:     getEnvFloat("SCHEDULER_MAX_COST_PER_HOUR", 0),
+			PreferredProviders: getEnvList("SCHEDULER_PREFERRED_PROVIDERS"),
+			ExcludedProviders:  getEnvList("SCHEDULER_EXCLUDED_PROVIDERS"),
+			RequireGPU:         getEnv("SCHEDULER_REQUIRE_GPU", "false") == "true",
+			MinCPUCores:        getEnvInt("SCHEDULER_MIN_CPU_CORES", 0),
+			MinMemoryGB:        getEnvInt("SCHEDULER_MIN_MEMORY_GB", 0),
+		},
+		Secrets: SecretsConfig{
+			EncryptionKey: getEnv("SECRETS_ENCRYPTION_KEY", ""),
+			AllowReveal:   getEnv("SECRETS_ALLOW_REVEAL", "false") == "true",
+		},
+		Auth: AuthConfig{
+			Enabled:        getEnv("AUTH_ENABLED", "true") == "true",
+			BootstrapToken: getEnv("AUTH_BOOTSTRAP_TOKEN", ""),
+			Admins:         getEnvList("AUTH_ADMINS"),
+		},
 		Providers: ProvidersConfig{
 			Kubernetes: KubernetesConfig{
 				Enabled:    getEnv("KUBERNETES_ENABLED", "false") == "true",
@@ -135,6 +238,12 @@ func Load() (*Config, error) {
 				Organization: getEnv("FLY_ORGANIZATION", ""),
 				Region:       getEnv("FLY_REGION", ""),
 			},
+			Fabric: FabricConfig{
+				Enabled:       getEnv("FABRIC_ENABLED", "true") == "true",
+				AgentCertFile: getEnv("FABRIC_AGENT_CERT_FILE", ""),
+				AgentKeyFile:  getEnv("FABRIC_AGENT_KEY_FILE", ""),
+				AgentCAFile:   getEnv("FABRIC_AGENT_CA_FILE", ""),
+			},
 		},
 	}
 
@@ -158,3 +267,24 @@ func getEnvInt(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+// getEnvFloat gets an environment variable as float with a default value
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
+// getEnvList gets a comma-separated environment variable as a list
+func getEnvList(key string) []string {
+	var result []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
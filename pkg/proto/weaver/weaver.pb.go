@@ -0,0 +1,9928 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: weaver/proto/weaver/weaver.proto
+
+package weaver
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Workload messages
+type CreateWorkloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Spec          *WorkloadSpec          `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string      `protobuf:"bytes,5,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkloadRequest) Reset() {
+	*x = CreateWorkloadRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkloadRequest) ProtoMessage() {}
+
+func (x *CreateWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateWorkloadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWorkloadRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CreateWorkloadRequest) GetSpec() *WorkloadSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *CreateWorkloadRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateWorkloadRequest) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+type CreateWorkloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Status        *WorkloadStatus        `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkloadResponse) Reset() {
+	*x = CreateWorkloadResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkloadResponse) ProtoMessage() {}
+
+func (x *CreateWorkloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkloadResponse.ProtoReflect.Descriptor instead.
+func (*CreateWorkloadResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateWorkloadResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateWorkloadResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWorkloadResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CreateWorkloadResponse) GetStatus() *WorkloadStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CreateWorkloadResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetWorkloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkloadRequest) Reset() {
+	*x = GetWorkloadRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkloadRequest) ProtoMessage() {}
+
+func (x *GetWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetWorkloadRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetWorkloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workload      *Workload              `protobuf:"bytes,1,opt,name=workload,proto3" json:"workload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkloadResponse) Reset() {
+	*x = GetWorkloadResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkloadResponse) ProtoMessage() {}
+
+func (x *GetWorkloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkloadResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkloadResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetWorkloadResponse) GetWorkload() *Workload {
+	if x != nil {
+		return x.Workload
+	}
+	return nil
+}
+
+type ListWorkloadsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	LabelSelector map[string]string      `protobuf:"bytes,2,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Page size; all matching workloads when 0
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Token of the previous page, which must be listed with the same filters
+	ContinueToken string `protobuf:"bytes,4,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
+	// Label selector expression, e.g. "app=web,tier in (frontend,edge),!canary"
+	Selector string `protobuf:"bytes,5,opt,name=selector,proto3" json:"selector,omitempty"`
+	// Field selector on status.phase, status.provider and status.nodeId,
+	// e.g. "status.phase!=Succeeded"
+	FieldSelector string `protobuf:"bytes,6,opt,name=field_selector,json=fieldSelector,proto3" json:"field_selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkloadsRequest) Reset() {
+	*x = ListWorkloadsRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkloadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkloadsRequest) ProtoMessage() {}
+
+func (x *ListWorkloadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkloadsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkloadsRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListWorkloadsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListWorkloadsRequest) GetLabelSelector() map[string]string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return nil
+}
+
+func (x *ListWorkloadsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListWorkloadsRequest) GetContinueToken() string {
+	if x != nil {
+		return x.ContinueToken
+	}
+	return ""
+}
+
+func (x *ListWorkloadsRequest) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *ListWorkloadsRequest) GetFieldSelector() string {
+	if x != nil {
+		return x.FieldSelector
+	}
+	return ""
+}
+
+type ListWorkloadsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Workloads []*Workload            `protobuf:"bytes,1,rep,name=workloads,proto3" json:"workloads,omitempty"`
+	// Empty on the last page
+	ContinueToken string `protobuf:"bytes,2,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
+	// Number of workloads in this page
+	Total         int32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkloadsResponse) Reset() {
+	*x = ListWorkloadsResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkloadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkloadsResponse) ProtoMessage() {}
+
+func (x *ListWorkloadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkloadsResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkloadsResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListWorkloadsResponse) GetWorkloads() []*Workload {
+	if x != nil {
+		return x.Workloads
+	}
+	return nil
+}
+
+func (x *ListWorkloadsResponse) GetContinueToken() string {
+	if x != nil {
+		return x.ContinueToken
+	}
+	return ""
+}
+
+func (x *ListWorkloadsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Replaces the spec, labels and annotations of a workload. Changes the provider
+// cannot apply to the running workload recreate it.
+type UpdateWorkloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec          *WorkloadSpec          `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string      `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkloadRequest) Reset() {
+	*x = UpdateWorkloadRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkloadRequest) ProtoMessage() {}
+
+func (x *UpdateWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateWorkloadRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWorkloadRequest) GetSpec() *WorkloadSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *UpdateWorkloadRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UpdateWorkloadRequest) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+type UpdateWorkloadResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Workload *Workload              `protobuf:"bytes,1,opt,name=workload,proto3" json:"workload,omitempty"`
+	// None, InPlace or Recreate
+	Strategy string `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// Changed parts of the workload, e.g. image, env or labels
+	Changes       []string `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkloadResponse) Reset() {
+	*x = UpdateWorkloadResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkloadResponse) ProtoMessage() {}
+
+func (x *UpdateWorkloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkloadResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkloadResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateWorkloadResponse) GetWorkload() *Workload {
+	if x != nil {
+		return x.Workload
+	}
+	return nil
+}
+
+func (x *UpdateWorkloadResponse) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *UpdateWorkloadResponse) GetChanges() []string {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type WatchWorkloadsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	LabelSelector map[string]string      `protobuf:"bytes,2,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Resume after this version; 0 starts with a snapshot of the matching workloads
+	ResourceVersion uint64 `protobuf:"varint,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	// Label and field selectors as in ListWorkloadsRequest
+	Selector      string `protobuf:"bytes,4,opt,name=selector,proto3" json:"selector,omitempty"`
+	FieldSelector string `protobuf:"bytes,5,opt,name=field_selector,json=fieldSelector,proto3" json:"field_selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchWorkloadsRequest) Reset() {
+	*x = WatchWorkloadsRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchWorkloadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchWorkloadsRequest) ProtoMessage() {}
+
+func (x *WatchWorkloadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchWorkloadsRequest.ProtoReflect.Descriptor instead.
+func (*WatchWorkloadsRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WatchWorkloadsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WatchWorkloadsRequest) GetLabelSelector() map[string]string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return nil
+}
+
+func (x *WatchWorkloadsRequest) GetResourceVersion() uint64 {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return 0
+}
+
+func (x *WatchWorkloadsRequest) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *WatchWorkloadsRequest) GetFieldSelector() string {
+	if x != nil {
+		return x.FieldSelector
+	}
+	return ""
+}
+
+type WorkloadEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ADDED, MODIFIED or DELETED
+	Type            string    `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Workload        *Workload `protobuf:"bytes,2,opt,name=workload,proto3" json:"workload,omitempty"`
+	ResourceVersion uint64    `protobuf:"varint,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WorkloadEvent) Reset() {
+	*x = WorkloadEvent{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadEvent) ProtoMessage() {}
+
+func (x *WorkloadEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadEvent.ProtoReflect.Descriptor instead.
+func (*WorkloadEvent) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WorkloadEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WorkloadEvent) GetWorkload() *Workload {
+	if x != nil {
+		return x.Workload
+	}
+	return nil
+}
+
+func (x *WorkloadEvent) GetResourceVersion() uint64 {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return 0
+}
+
+type StreamWorkloadLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Defaults to the main container; sidecars are selected by name
+	Container string `protobuf:"bytes,2,opt,name=container,proto3" json:"container,omitempty"`
+	Follow    bool   `protobuf:"varint,3,opt,name=follow,proto3" json:"follow,omitempty"`
+	// Only the last tail_lines lines when set
+	TailLines     int64                  `protobuf:"varint,4,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamWorkloadLogsRequest) Reset() {
+	*x = StreamWorkloadLogsRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamWorkloadLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamWorkloadLogsRequest) ProtoMessage() {}
+
+func (x *StreamWorkloadLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamWorkloadLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamWorkloadLogsRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StreamWorkloadLogsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StreamWorkloadLogsRequest) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *StreamWorkloadLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *StreamWorkloadLogsRequest) GetTailLines() int64 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
+}
+
+func (x *StreamWorkloadLogsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+type WorkloadLogEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Line  string                 `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	// Unset when the provider does not report it
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// stdout or stderr, when known
+	Stream        string `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkloadLogEntry) Reset() {
+	*x = WorkloadLogEntry{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadLogEntry) ProtoMessage() {}
+
+func (x *WorkloadLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadLogEntry.ProtoReflect.Descriptor instead.
+func (*WorkloadLogEntry) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WorkloadLogEntry) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+func (x *WorkloadLogEntry) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *WorkloadLogEntry) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+// The first request starts the command; later requests carry its input
+type ExecRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ExecRequest_Start
+	//	*ExecRequest_Stdin
+	//	*ExecRequest_Resize
+	//	*ExecRequest_CloseStdin
+	Payload       isExecRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecRequest) Reset() {
+	*x = ExecRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecRequest) ProtoMessage() {}
+
+func (x *ExecRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecRequest.ProtoReflect.Descriptor instead.
+func (*ExecRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExecRequest) GetPayload() isExecRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ExecRequest) GetStart() *ExecStart {
+	if x != nil {
+		if x, ok := x.Payload.(*ExecRequest_Start); ok {
+			return x.Start
+		}
+	}
+	return nil
+}
+
+func (x *ExecRequest) GetStdin() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ExecRequest_Stdin); ok {
+			return x.Stdin
+		}
+	}
+	return nil
+}
+
+func (x *ExecRequest) GetResize() *TerminalSize {
+	if x != nil {
+		if x, ok := x.Payload.(*ExecRequest_Resize); ok {
+			return x.Resize
+		}
+	}
+	return nil
+}
+
+func (x *ExecRequest) GetCloseStdin() bool {
+	if x != nil {
+		if x, ok := x.Payload.(*ExecRequest_CloseStdin); ok {
+			return x.CloseStdin
+		}
+	}
+	return false
+}
+
+type isExecRequest_Payload interface {
+	isExecRequest_Payload()
+}
+
+type ExecRequest_Start struct {
+	Start *ExecStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type ExecRequest_Stdin struct {
+	Stdin []byte `protobuf:"bytes,2,opt,name=stdin,proto3,oneof"`
+}
+
+type ExecRequest_Resize struct {
+	Resize *TerminalSize `protobuf:"bytes,3,opt,name=resize,proto3,oneof"`
+}
+
+type ExecRequest_CloseStdin struct {
+	// Closes the command's stdin
+	CloseStdin bool `protobuf:"varint,4,opt,name=close_stdin,json=closeStdin,proto3,oneof"`
+}
+
+func (*ExecRequest_Start) isExecRequest_Payload() {}
+
+func (*ExecRequest_Stdin) isExecRequest_Payload() {}
+
+func (*ExecRequest_Resize) isExecRequest_Payload() {}
+
+func (*ExecRequest_CloseStdin) isExecRequest_Payload() {}
+
+type ExecStart struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Defaults to the main container; sidecars are selected by name
+	Container     string   `protobuf:"bytes,2,opt,name=container,proto3" json:"container,omitempty"`
+	Command       []string `protobuf:"bytes,3,rep,name=command,proto3" json:"command,omitempty"`
+	Tty           bool     `protobuf:"varint,4,opt,name=tty,proto3" json:"tty,omitempty"`
+	Stdin         bool     `protobuf:"varint,5,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecStart) Reset() {
+	*x = ExecStart{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecStart) ProtoMessage() {}
+
+func (x *ExecStart) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecStart.ProtoReflect.Descriptor instead.
+func (*ExecStart) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExecStart) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExecStart) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *ExecStart) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *ExecStart) GetTty() bool {
+	if x != nil {
+		return x.Tty
+	}
+	return false
+}
+
+func (x *ExecStart) GetStdin() bool {
+	if x != nil {
+		return x.Stdin
+	}
+	return false
+}
+
+type TerminalSize struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Width         uint32                 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height        uint32                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalSize) Reset() {
+	*x = TerminalSize{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalSize) ProtoMessage() {}
+
+func (x *TerminalSize) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalSize.ProtoReflect.Descriptor instead.
+func (*TerminalSize) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TerminalSize) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *TerminalSize) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// Output of the command, ending with its exit
+type ExecResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ExecResponse_Stdout
+	//	*ExecResponse_Stderr
+	//	*ExecResponse_Exit
+	Payload       isExecResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecResponse) Reset() {
+	*x = ExecResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecResponse) ProtoMessage() {}
+
+func (x *ExecResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecResponse.ProtoReflect.Descriptor instead.
+func (*ExecResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExecResponse) GetPayload() isExecResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ExecResponse) GetStdout() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ExecResponse_Stdout); ok {
+			return x.Stdout
+		}
+	}
+	return nil
+}
+
+func (x *ExecResponse) GetStderr() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ExecResponse_Stderr); ok {
+			return x.Stderr
+		}
+	}
+	return nil
+}
+
+func (x *ExecResponse) GetExit() *ExecExit {
+	if x != nil {
+		if x, ok := x.Payload.(*ExecResponse_Exit); ok {
+			return x.Exit
+		}
+	}
+	return nil
+}
+
+type isExecResponse_Payload interface {
+	isExecResponse_Payload()
+}
+
+type ExecResponse_Stdout struct {
+	Stdout []byte `protobuf:"bytes,1,opt,name=stdout,proto3,oneof"`
+}
+
+type ExecResponse_Stderr struct {
+	Stderr []byte `protobuf:"bytes,2,opt,name=stderr,proto3,oneof"`
+}
+
+type ExecResponse_Exit struct {
+	Exit *ExecExit `protobuf:"bytes,3,opt,name=exit,proto3,oneof"`
+}
+
+func (*ExecResponse_Stdout) isExecResponse_Payload() {}
+
+func (*ExecResponse_Stderr) isExecResponse_Payload() {}
+
+func (*ExecResponse_Exit) isExecResponse_Payload() {}
+
+type ExecExit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExitCode      int32                  `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecExit) Reset() {
+	*x = ExecExit{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecExit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecExit) ProtoMessage() {}
+
+func (x *ExecExit) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecExit.ProtoReflect.Descriptor instead.
+func (*ExecExit) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExecExit) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+// Each stream forwards one connection. The first request selects the port;
+// later requests carry data and closing the send side closes the connection.
+type PortForwardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*PortForwardRequest_Start
+	//	*PortForwardRequest_Data
+	Payload       isPortForwardRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortForwardRequest) Reset() {
+	*x = PortForwardRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortForwardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortForwardRequest) ProtoMessage() {}
+
+func (x *PortForwardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortForwardRequest.ProtoReflect.Descriptor instead.
+func (*PortForwardRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PortForwardRequest) GetPayload() isPortForwardRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *PortForwardRequest) GetStart() *PortForwardStart {
+	if x != nil {
+		if x, ok := x.Payload.(*PortForwardRequest_Start); ok {
+			return x.Start
+		}
+	}
+	return nil
+}
+
+func (x *PortForwardRequest) GetData() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*PortForwardRequest_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isPortForwardRequest_Payload interface {
+	isPortForwardRequest_Payload()
+}
+
+type PortForwardRequest_Start struct {
+	Start *PortForwardStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type PortForwardRequest_Data struct {
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*PortForwardRequest_Start) isPortForwardRequest_Payload() {}
+
+func (*PortForwardRequest_Data) isPortForwardRequest_Payload() {}
+
+type PortForwardStart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Port          uint32                 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortForwardStart) Reset() {
+	*x = PortForwardStart{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortForwardStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortForwardStart) ProtoMessage() {}
+
+func (x *PortForwardStart) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortForwardStart.ProtoReflect.Descriptor instead.
+func (*PortForwardStart) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PortForwardStart) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PortForwardStart) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type PortForwardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortForwardResponse) Reset() {
+	*x = PortForwardResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortForwardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortForwardResponse) ProtoMessage() {}
+
+func (x *PortForwardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortForwardResponse.ProtoReflect.Descriptor instead.
+func (*PortForwardResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PortForwardResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteWorkloadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Removes the workload without running its finalizers, leaving any
+	// provider resources, secrets and content behind
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWorkloadRequest) Reset() {
+	*x = DeleteWorkloadRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkloadRequest) ProtoMessage() {}
+
+func (x *DeleteWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteWorkloadRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteWorkloadRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// Namespace messages
+type CreateNamespaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Spec          *NamespaceSpec         `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string      `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNamespaceRequest) Reset() {
+	*x = CreateNamespaceRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNamespaceRequest) ProtoMessage() {}
+
+func (x *CreateNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*CreateNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateNamespaceRequest) GetSpec() *NamespaceSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *CreateNamespaceRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateNamespaceRequest) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+type CreateNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     *Namespace             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNamespaceResponse) Reset() {
+	*x = CreateNamespaceResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNamespaceResponse) ProtoMessage() {}
+
+func (x *CreateNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*CreateNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateNamespaceResponse) GetNamespace() *Namespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+type GetNamespaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceRequest) Reset() {
+	*x = GetNamespaceRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceRequest) ProtoMessage() {}
+
+func (x *GetNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     *Namespace             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceResponse) Reset() {
+	*x = GetNamespaceResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceResponse) ProtoMessage() {}
+
+func (x *GetNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetNamespaceResponse) GetNamespace() *Namespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+type ListNamespacesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LabelSelector map[string]string      `protobuf:"bytes,1,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNamespacesRequest) Reset() {
+	*x = ListNamespacesRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNamespacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNamespacesRequest) ProtoMessage() {}
+
+func (x *ListNamespacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNamespacesRequest.ProtoReflect.Descriptor instead.
+func (*ListNamespacesRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListNamespacesRequest) GetLabelSelector() map[string]string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return nil
+}
+
+type ListNamespacesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespaces    []*Namespace           `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNamespacesResponse) Reset() {
+	*x = ListNamespacesResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNamespacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNamespacesResponse) ProtoMessage() {}
+
+func (x *ListNamespacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNamespacesResponse.ProtoReflect.Descriptor instead.
+func (*ListNamespacesResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListNamespacesResponse) GetNamespaces() []*Namespace {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+// Replaces the spec, labels and annotations of a namespace
+type UpdateNamespaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Spec          *NamespaceSpec         `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string      `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNamespaceRequest) Reset() {
+	*x = UpdateNamespaceRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNamespaceRequest) ProtoMessage() {}
+
+func (x *UpdateNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateNamespaceRequest) GetSpec() *NamespaceSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *UpdateNamespaceRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UpdateNamespaceRequest) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+type UpdateNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     *Namespace             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNamespaceResponse) Reset() {
+	*x = UpdateNamespaceResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNamespaceResponse) ProtoMessage() {}
+
+func (x *UpdateNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateNamespaceResponse) GetNamespace() *Namespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+// Fails while the namespace still has workloads
+type DeleteNamespaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNamespaceRequest) Reset() {
+	*x = DeleteNamespaceRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNamespaceRequest) ProtoMessage() {}
+
+func (x *DeleteNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Namespace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string      `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Spec          *NamespaceSpec         `protobuf:"bytes,5,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *NamespaceStatus       `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Namespace) Reset() {
+	*x = Namespace{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Namespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Namespace) ProtoMessage() {}
+
+func (x *Namespace) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Namespace.ProtoReflect.Descriptor instead.
+func (*Namespace) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Namespace) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Namespace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Namespace) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Namespace) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Namespace) GetSpec() *NamespaceSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Namespace) GetStatus() *NamespaceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *Namespace) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Namespace) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type NamespaceSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quotas        *ResourceQuotas        `protobuf:"bytes,1,opt,name=quotas,proto3" json:"quotas,omitempty"`
+	NetworkPolicy *NetworkPolicy         `protobuf:"bytes,2,opt,name=network_policy,json=networkPolicy,proto3" json:"network_policy,omitempty"`
+	// Merged into workloads that leave placement fields unset
+	DefaultPlacement *PlacementSpec `protobuf:"bytes,3,opt,name=default_placement,json=defaultPlacement,proto3" json:"default_placement,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NamespaceSpec) Reset() {
+	*x = NamespaceSpec{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceSpec) ProtoMessage() {}
+
+func (x *NamespaceSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceSpec.ProtoReflect.Descriptor instead.
+func (*NamespaceSpec) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *NamespaceSpec) GetQuotas() *ResourceQuotas {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+func (x *NamespaceSpec) GetNetworkPolicy() *NetworkPolicy {
+	if x != nil {
+		return x.NetworkPolicy
+	}
+	return nil
+}
+
+func (x *NamespaceSpec) GetDefaultPlacement() *PlacementSpec {
+	if x != nil {
+		return x.DefaultPlacement
+	}
+	return nil
+}
+
+// Empty quotas are unlimited
+type ResourceQuotas struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxWorkloads  int32                  `protobuf:"varint,1,opt,name=max_workloads,json=maxWorkloads,proto3" json:"max_workloads,omitempty"`
+	MaxCpu        string                 `protobuf:"bytes,2,opt,name=max_cpu,json=maxCpu,proto3" json:"max_cpu,omitempty"`
+	MaxMemory     string                 `protobuf:"bytes,3,opt,name=max_memory,json=maxMemory,proto3" json:"max_memory,omitempty"`
+	MaxGpu        string                 `protobuf:"bytes,4,opt,name=max_gpu,json=maxGpu,proto3" json:"max_gpu,omitempty"`
+	MaxStorage    string                 `protobuf:"bytes,5,opt,name=max_storage,json=maxStorage,proto3" json:"max_storage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceQuotas) Reset() {
+	*x = ResourceQuotas{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceQuotas) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceQuotas) ProtoMessage() {}
+
+func (x *ResourceQuotas) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceQuotas.ProtoReflect.Descriptor instead.
+func (*ResourceQuotas) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ResourceQuotas) GetMaxWorkloads() int32 {
+	if x != nil {
+		return x.MaxWorkloads
+	}
+	return 0
+}
+
+func (x *ResourceQuotas) GetMaxCpu() string {
+	if x != nil {
+		return x.MaxCpu
+	}
+	return ""
+}
+
+func (x *ResourceQuotas) GetMaxMemory() string {
+	if x != nil {
+		return x.MaxMemory
+	}
+	return ""
+}
+
+func (x *ResourceQuotas) GetMaxGpu() string {
+	if x != nil {
+		return x.MaxGpu
+	}
+	return ""
+}
+
+func (x *ResourceQuotas) GetMaxStorage() string {
+	if x != nil {
+		return x.MaxStorage
+	}
+	return ""
+}
+
+type NetworkPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Isolation     string                 `protobuf:"bytes,1,opt,name=isolation,proto3" json:"isolation,omitempty"`
+	Ingress       []*NetworkRule         `protobuf:"bytes,2,rep,name=ingress,proto3" json:"ingress,omitempty"`
+	Egress        []*NetworkRule         `protobuf:"bytes,3,rep,name=egress,proto3" json:"egress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkPolicy) Reset() {
+	*x = NetworkPolicy{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkPolicy) ProtoMessage() {}
+
+func (x *NetworkPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkPolicy.ProtoReflect.Descriptor instead.
+func (*NetworkPolicy) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *NetworkPolicy) GetIsolation() string {
+	if x != nil {
+		return x.Isolation
+	}
+	return ""
+}
+
+func (x *NetworkPolicy) GetIngress() []*NetworkRule {
+	if x != nil {
+		return x.Ingress
+	}
+	return nil
+}
+
+func (x *NetworkPolicy) GetEgress() []*NetworkRule {
+	if x != nil {
+		return x.Egress
+	}
+	return nil
+}
+
+type NetworkRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          []*NetworkPeer         `protobuf:"bytes,1,rep,name=from,proto3" json:"from,omitempty"`
+	To            []*NetworkPeer         `protobuf:"bytes,2,rep,name=to,proto3" json:"to,omitempty"`
+	Ports         []*Port                `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
+	Protocols     []string               `protobuf:"bytes,4,rep,name=protocols,proto3" json:"protocols,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkRule) Reset() {
+	*x = NetworkRule{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkRule) ProtoMessage() {}
+
+func (x *NetworkRule) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkRule.ProtoReflect.Descriptor instead.
+func (*NetworkRule) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *NetworkRule) GetFrom() []*NetworkPeer {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *NetworkRule) GetTo() []*NetworkPeer {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *NetworkRule) GetPorts() []*Port {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *NetworkRule) GetProtocols() []string {
+	if x != nil {
+		return x.Protocols
+	}
+	return nil
+}
+
+type NetworkPeer struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	NamespaceSelector map[string]string      `protobuf:"bytes,1,rep,name=namespace_selector,json=namespaceSelector,proto3" json:"namespace_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	WorkloadSelector  map[string]string      `protobuf:"bytes,2,rep,name=workload_selector,json=workloadSelector,proto3" json:"workload_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	IpBlock           *IPBlock               `protobuf:"bytes,3,opt,name=ip_block,json=ipBlock,proto3" json:"ip_block,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NetworkPeer) Reset() {
+	*x = NetworkPeer{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkPeer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkPeer) ProtoMessage() {}
+
+func (x *NetworkPeer) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkPeer.ProtoReflect.Descriptor instead.
+func (*NetworkPeer) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *NetworkPeer) GetNamespaceSelector() map[string]string {
+	if x != nil {
+		return x.NamespaceSelector
+	}
+	return nil
+}
+
+func (x *NetworkPeer) GetWorkloadSelector() map[string]string {
+	if x != nil {
+		return x.WorkloadSelector
+	}
+	return nil
+}
+
+func (x *NetworkPeer) GetIpBlock() *IPBlock {
+	if x != nil {
+		return x.IpBlock
+	}
+	return nil
+}
+
+type IPBlock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cidr          string                 `protobuf:"bytes,1,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	Except        []string               `protobuf:"bytes,2,rep,name=except,proto3" json:"except,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IPBlock) Reset() {
+	*x = IPBlock{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IPBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPBlock) ProtoMessage() {}
+
+func (x *IPBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPBlock.ProtoReflect.Descriptor instead.
+func (*IPBlock) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *IPBlock) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *IPBlock) GetExcept() []string {
+	if x != nil {
+		return x.Except
+	}
+	return nil
+}
+
+type NamespaceStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phase         string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Usage         *ResourceUsage         `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	TailscaleTag  string                 `protobuf:"bytes,5,opt,name=tailscale_tag,json=tailscaleTag,proto3" json:"tailscale_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamespaceStatus) Reset() {
+	*x = NamespaceStatus{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceStatus) ProtoMessage() {}
+
+func (x *NamespaceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceStatus.ProtoReflect.Descriptor instead.
+func (*NamespaceStatus) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *NamespaceStatus) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *NamespaceStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NamespaceStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *NamespaceStatus) GetUsage() *ResourceUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *NamespaceStatus) GetTailscaleTag() string {
+	if x != nil {
+		return x.TailscaleTag
+	}
+	return ""
+}
+
+// Resources requested by the namespace's unfinished workloads
+type ResourceUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workloads     int32                  `protobuf:"varint,1,opt,name=workloads,proto3" json:"workloads,omitempty"`
+	Cpu           string                 `protobuf:"bytes,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory        string                 `protobuf:"bytes,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	Gpu           string                 `protobuf:"bytes,4,opt,name=gpu,proto3" json:"gpu,omitempty"`
+	Storage       string                 `protobuf:"bytes,5,opt,name=storage,proto3" json:"storage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceUsage) Reset() {
+	*x = ResourceUsage{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceUsage) ProtoMessage() {}
+
+func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ResourceUsage) GetWorkloads() int32 {
+	if x != nil {
+		return x.Workloads
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetCpu() string {
+	if x != nil {
+		return x.Cpu
+	}
+	return ""
+}
+
+func (x *ResourceUsage) GetMemory() string {
+	if x != nil {
+		return x.Memory
+	}
+	return ""
+}
+
+func (x *ResourceUsage) GetGpu() string {
+	if x != nil {
+		return x.Gpu
+	}
+	return ""
+}
+
+func (x *ResourceUsage) GetStorage() string {
+	if x != nil {
+		return x.Storage
+	}
+	return ""
+}
+
+// Secret messages
+type CreateSecretRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Defaults to "Opaque"
+	Type          string             `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Data          map[string][]byte  `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExternalRef   *ExternalSecretRef `protobuf:"bytes,5,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSecretRequest) Reset() {
+	*x = CreateSecretRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretRequest) ProtoMessage() {}
+
+func (x *CreateSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretRequest.ProtoReflect.Descriptor instead.
+func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateSecretRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CreateSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSecretRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateSecretRequest) GetData() map[string][]byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CreateSecretRequest) GetExternalRef() *ExternalSecretRef {
+	if x != nil {
+		return x.ExternalRef
+	}
+	return nil
+}
+
+type CreateSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSecretResponse) Reset() {
+	*x = CreateSecretResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretResponse) ProtoMessage() {}
+
+func (x *CreateSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretResponse.ProtoReflect.Descriptor instead.
+func (*CreateSecretResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateSecretResponse) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type GetSecretRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Return the secret's values; refused unless revealing secrets is allowed
+	Reveal        bool `protobuf:"varint,3,opt,name=reveal,proto3" json:"reveal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretRequest) Reset() {
+	*x = GetSecretRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretRequest) ProtoMessage() {}
+
+func (x *GetSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetSecretRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetSecretRequest) GetReveal() bool {
+	if x != nil {
+		return x.Reveal
+	}
+	return false
+}
+
+type GetSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretResponse) Reset() {
+	*x = GetSecretResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretResponse) ProtoMessage() {}
+
+func (x *GetSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetSecretResponse) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type ListSecretsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Reveal        bool                   `protobuf:"varint,3,opt,name=reveal,proto3" json:"reveal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretsRequest) Reset() {
+	*x = ListSecretsRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsRequest) ProtoMessage() {}
+
+func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListSecretsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListSecretsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListSecretsRequest) GetReveal() bool {
+	if x != nil {
+		return x.Reveal
+	}
+	return false
+}
+
+type ListSecretsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secrets       []*Secret              `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretsResponse) Reset() {
+	*x = ListSecretsResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsResponse) ProtoMessage() {}
+
+func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListSecretsResponse) GetSecrets() []*Secret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+// Replaces the type and external reference of a secret; data is replaced when set
+type UpdateSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Data          map[string][]byte      `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExternalRef   *ExternalSecretRef     `protobuf:"bytes,5,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSecretRequest) Reset() {
+	*x = UpdateSecretRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecretRequest) ProtoMessage() {}
+
+func (x *UpdateSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecretRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSecretRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateSecretRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSecretRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateSecretRequest) GetData() map[string][]byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UpdateSecretRequest) GetExternalRef() *ExternalSecretRef {
+	if x != nil {
+		return x.ExternalRef
+	}
+	return nil
+}
+
+type UpdateSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSecretResponse) Reset() {
+	*x = UpdateSecretResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecretResponse) ProtoMessage() {}
+
+func (x *UpdateSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecretResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSecretResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateSecretResponse) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type DeleteSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSecretRequest) Reset() {
+	*x = DeleteSecretRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretRequest) ProtoMessage() {}
+
+func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteSecretRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Pulls the data of a secret from its external provider
+type SyncSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncSecretRequest) Reset() {
+	*x = SyncSecretRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncSecretRequest) ProtoMessage() {}
+
+func (x *SyncSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncSecretRequest.ProtoReflect.Descriptor instead.
+func (*SyncSecretRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SyncSecretRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SyncSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SyncSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncSecretResponse) Reset() {
+	*x = SyncSecretResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncSecretResponse) ProtoMessage() {}
+
+func (x *SyncSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncSecretResponse.ProtoReflect.Descriptor instead.
+func (*SyncSecretResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SyncSecretResponse) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type Secret struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Type      string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// Names of the values the secret holds
+	Keys []string `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`
+	// Only set when the secret was revealed
+	Data          map[string][]byte      `protobuf:"bytes,6,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExternalRef   *ExternalSecretRef     `protobuf:"bytes,7,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"`
+	Status        *SecretStatus          `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Redacted      bool                   `protobuf:"varint,11,opt,name=redacted,proto3" json:"redacted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Secret) Reset() {
+	*x = Secret{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret) ProtoMessage() {}
+
+func (x *Secret) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secret.ProtoReflect.Descriptor instead.
+func (*Secret) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Secret) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Secret) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Secret) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Secret) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Secret) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *Secret) GetData() map[string][]byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Secret) GetExternalRef() *ExternalSecretRef {
+	if x != nil {
+		return x.ExternalRef
+	}
+	return nil
+}
+
+func (x *Secret) GetStatus() *SecretStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *Secret) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Secret) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Secret) GetRedacted() bool {
+	if x != nil {
+		return x.Redacted
+	}
+	return false
+}
+
+type ExternalSecretRef struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Provider string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Path     string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Version  string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// Values are only returned when the secret is revealed
+	Auth          map[string]string `protobuf:"bytes,4,rep,name=auth,proto3" json:"auth,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalSecretRef) Reset() {
+	*x = ExternalSecretRef{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalSecretRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalSecretRef) ProtoMessage() {}
+
+func (x *ExternalSecretRef) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalSecretRef.ProtoReflect.Descriptor instead.
+func (*ExternalSecretRef) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ExternalSecretRef) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ExternalSecretRef) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ExternalSecretRef) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ExternalSecretRef) GetAuth() map[string]string {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+type SecretStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phase         string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	LastSync      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_sync,json=lastSync,proto3" json:"last_sync,omitempty"`
+	SyncError     string                 `protobuf:"bytes,5,opt,name=sync_error,json=syncError,proto3" json:"sync_error,omitempty"`
+	SyncVersion   string                 `protobuf:"bytes,6,opt,name=sync_version,json=syncVersion,proto3" json:"sync_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretStatus) Reset() {
+	*x = SecretStatus{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretStatus) ProtoMessage() {}
+
+func (x *SecretStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretStatus.ProtoReflect.Descriptor instead.
+func (*SecretStatus) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SecretStatus) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *SecretStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SecretStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SecretStatus) GetLastSync() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSync
+	}
+	return nil
+}
+
+func (x *SecretStatus) GetSyncError() string {
+	if x != nil {
+		return x.SyncError
+	}
+	return ""
+}
+
+func (x *SecretStatus) GetSyncVersion() string {
+	if x != nil {
+		return x.SyncVersion
+	}
+	return ""
+}
+
+// Cron workload messages
+type CreateCronWorkloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Spec          *CronWorkloadSpec      `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string      `protobuf:"bytes,5,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCronWorkloadRequest) Reset() {
+	*x = CreateCronWorkloadRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCronWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCronWorkloadRequest) ProtoMessage() {}
+
+func (x *CreateCronWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCronWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*CreateCronWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateCronWorkloadRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CreateCronWorkloadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCronWorkloadRequest) GetSpec() *CronWorkloadSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *CreateCronWorkloadRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateCronWorkloadRequest) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+type CreateCronWorkloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CronWorkload  *CronWorkload          `protobuf:"bytes,1,opt,name=cron_workload,json=cronWorkload,proto3" json:"cron_workload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCronWorkloadResponse) Reset() {
+	*x = CreateCronWorkloadResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCronWorkloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCronWorkloadResponse) ProtoMessage() {}
+
+func (x *CreateCronWorkloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCronWorkloadResponse.ProtoReflect.Descriptor instead.
+func (*CreateCronWorkloadResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateCronWorkloadResponse) GetCronWorkload() *CronWorkload {
+	if x != nil {
+		return x.CronWorkload
+	}
+	return nil
+}
+
+type GetCronWorkloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCronWorkloadRequest) Reset() {
+	*x = GetCronWorkloadRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCronWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCronWorkloadRequest) ProtoMessage() {}
+
+func (x *GetCronWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCronWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*GetCronWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetCronWorkloadRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetCronWorkloadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetCronWorkloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CronWorkload  *CronWorkload          `protobuf:"bytes,1,opt,name=cron_workload,json=cronWorkload,proto3" json:"cron_workload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCronWorkloadResponse) Reset() {
+	*x = GetCronWorkloadResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCronWorkloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCronWorkloadResponse) ProtoMessage() {}
+
+func (x *GetCronWorkloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCronWorkloadResponse.ProtoReflect.Descriptor instead.
+func (*GetCronWorkloadResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetCronWorkloadResponse) GetCronWorkload() *CronWorkload {
+	if x != nil {
+		return x.CronWorkload
+	}
+	return nil
+}
+
+type ListCronWorkloadsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	LabelSelector map[string]string      `protobuf:"bytes,2,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCronWorkloadsRequest) Reset() {
+	*x = ListCronWorkloadsRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCronWorkloadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCronWorkloadsRequest) ProtoMessage() {}
+
+func (x *ListCronWorkloadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCronWorkloadsRequest.ProtoReflect.Descriptor instead.
+func (*ListCronWorkloadsRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListCronWorkloadsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListCronWorkloadsRequest) GetLabelSelector() map[string]string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return nil
+}
+
+type ListCronWorkloadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CronWorkloads []*CronWorkload        `protobuf:"bytes,1,rep,name=cron_workloads,json=cronWorkloads,proto3" json:"cron_workloads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCronWorkloadsResponse) Reset() {
+	*x = ListCronWorkloadsResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCronWorkloadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCronWorkloadsResponse) ProtoMessage() {}
+
+func (x *ListCronWorkloadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCronWorkloadsResponse.ProtoReflect.Descriptor instead.
+func (*ListCronWorkloadsResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListCronWorkloadsResponse) GetCronWorkloads() []*CronWorkload {
+	if x != nil {
+		return x.CronWorkloads
+	}
+	return nil
+}
+
+// Replaces the spec, labels and annotations of a cron workload; existing runs are kept
+type UpdateCronWorkloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Spec          *CronWorkloadSpec      `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string      `protobuf:"bytes,5,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCronWorkloadRequest) Reset() {
+	*x = UpdateCronWorkloadRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCronWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCronWorkloadRequest) ProtoMessage() {}
+
+func (x *UpdateCronWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCronWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCronWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateCronWorkloadRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateCronWorkloadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCronWorkloadRequest) GetSpec() *CronWorkloadSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *UpdateCronWorkloadRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UpdateCronWorkloadRequest) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+type UpdateCronWorkloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CronWorkload  *CronWorkload          `protobuf:"bytes,1,opt,name=cron_workload,json=cronWorkload,proto3" json:"cron_workload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCronWorkloadResponse) Reset() {
+	*x = UpdateCronWorkloadResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCronWorkloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCronWorkloadResponse) ProtoMessage() {}
+
+func (x *UpdateCronWorkloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCronWorkloadResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCronWorkloadResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateCronWorkloadResponse) GetCronWorkload() *CronWorkload {
+	if x != nil {
+		return x.CronWorkload
+	}
+	return nil
+}
+
+// Deletes the cron workload along with its runs
+type DeleteCronWorkloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCronWorkloadRequest) Reset() {
+	*x = DeleteCronWorkloadRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCronWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCronWorkloadRequest) ProtoMessage() {}
+
+func (x *DeleteCronWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCronWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCronWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteCronWorkloadRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteCronWorkloadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CronWorkload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string      `protobuf:"bytes,5,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Spec          *CronWorkloadSpec      `protobuf:"bytes,6,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *CronWorkloadStatus    `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CronWorkload) Reset() {
+	*x = CronWorkload{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CronWorkload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CronWorkload) ProtoMessage() {}
+
+func (x *CronWorkload) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CronWorkload.ProtoReflect.Descriptor instead.
+func (*CronWorkload) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CronWorkload) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CronWorkload) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CronWorkload) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CronWorkload) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CronWorkload) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *CronWorkload) GetSpec() *CronWorkloadSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *CronWorkload) GetStatus() *CronWorkloadStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CronWorkload) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CronWorkload) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CronWorkloadSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Five-field cron expression or a macro such as @daily
+	Schedule string `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// IANA time zone the schedule is evaluated in, UTC when empty
+	TimeZone string            `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Template *WorkloadTemplate `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	// Allow, Forbid or Replace runs while an earlier run is active
+	ConcurrencyPolicy string `protobuf:"bytes,4,opt,name=concurrency_policy,json=concurrencyPolicy,proto3" json:"concurrency_policy,omitempty"`
+	// Runs that cannot start within this many seconds of their schedule time are skipped
+	StartingDeadlineSeconds    *int64 `protobuf:"varint,5,opt,name=starting_deadline_seconds,json=startingDeadlineSeconds,proto3,oneof" json:"starting_deadline_seconds,omitempty"`
+	SuccessfulRunsHistoryLimit *int32 `protobuf:"varint,6,opt,name=successful_runs_history_limit,json=successfulRunsHistoryLimit,proto3,oneof" json:"successful_runs_history_limit,omitempty"`
+	FailedRunsHistoryLimit     *int32 `protobuf:"varint,7,opt,name=failed_runs_history_limit,json=failedRunsHistoryLimit,proto3,oneof" json:"failed_runs_history_limit,omitempty"`
+	Suspend                    bool   `protobuf:"varint,8,opt,name=suspend,proto3" json:"suspend,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *CronWorkloadSpec) Reset() {
+	*x = CronWorkloadSpec{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CronWorkloadSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CronWorkloadSpec) ProtoMessage() {}
+
+func (x *CronWorkloadSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CronWorkloadSpec.ProtoReflect.Descriptor instead.
+func (*CronWorkloadSpec) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CronWorkloadSpec) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *CronWorkloadSpec) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CronWorkloadSpec) GetTemplate() *WorkloadTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *CronWorkloadSpec) GetConcurrencyPolicy() string {
+	if x != nil {
+		return x.ConcurrencyPolicy
+	}
+	return ""
+}
+
+func (x *CronWorkloadSpec) GetStartingDeadlineSeconds() int64 {
+	if x != nil && x.StartingDeadlineSeconds != nil {
+		return *x.StartingDeadlineSeconds
+	}
+	return 0
+}
+
+func (x *CronWorkloadSpec) GetSuccessfulRunsHistoryLimit() int32 {
+	if x != nil && x.SuccessfulRunsHistoryLimit != nil {
+		return *x.SuccessfulRunsHistoryLimit
+	}
+	return 0
+}
+
+func (x *CronWorkloadSpec) GetFailedRunsHistoryLimit() int32 {
+	if x != nil && x.FailedRunsHistoryLimit != nil {
+		return *x.FailedRunsHistoryLimit
+	}
+	return 0
+}
+
+func (x *CronWorkloadSpec) GetSuspend() bool {
+	if x != nil {
+		return x.Suspend
+	}
+	return false
+}
+
+// Workload created for every run; kind defaults to Job
+type WorkloadTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Labels        map[string]string      `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string      `protobuf:"bytes,2,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Spec          *WorkloadSpec          `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkloadTemplate) Reset() {
+	*x = WorkloadTemplate{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadTemplate) ProtoMessage() {}
+
+func (x *WorkloadTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadTemplate.ProtoReflect.Descriptor instead.
+func (*WorkloadTemplate) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *WorkloadTemplate) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *WorkloadTemplate) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *WorkloadTemplate) GetSpec() *WorkloadSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type CronWorkloadStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IDs of the unfinished runs
+	Active             []string               `protobuf:"bytes,1,rep,name=active,proto3" json:"active,omitempty"`
+	LastScheduleTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_schedule_time,json=lastScheduleTime,proto3" json:"last_schedule_time,omitempty"`
+	LastSuccessfulTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_successful_time,json=lastSuccessfulTime,proto3" json:"last_successful_time,omitempty"`
+	NextScheduleTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_schedule_time,json=nextScheduleTime,proto3" json:"next_schedule_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CronWorkloadStatus) Reset() {
+	*x = CronWorkloadStatus{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CronWorkloadStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CronWorkloadStatus) ProtoMessage() {}
+
+func (x *CronWorkloadStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CronWorkloadStatus.ProtoReflect.Descriptor instead.
+func (*CronWorkloadStatus) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CronWorkloadStatus) GetActive() []string {
+	if x != nil {
+		return x.Active
+	}
+	return nil
+}
+
+func (x *CronWorkloadStatus) GetLastScheduleTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastScheduleTime
+	}
+	return nil
+}
+
+func (x *CronWorkloadStatus) GetLastSuccessfulTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSuccessfulTime
+	}
+	return nil
+}
+
+func (x *CronWorkloadStatus) GetNextScheduleTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextScheduleTime
+	}
+	return nil
+}
+
+// Provider messages
+type ListProvidersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Providers     []string               `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProvidersResponse) Reset() {
+	*x = ListProvidersResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersResponse) ProtoMessage() {}
+
+func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListProvidersResponse) GetProviders() []string {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+type GetProviderRegionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProviderRegionsRequest) Reset() {
+	*x = GetProviderRegionsRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProviderRegionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProviderRegionsRequest) ProtoMessage() {}
+
+func (x *GetProviderRegionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProviderRegionsRequest.ProtoReflect.Descriptor instead.
+func (*GetProviderRegionsRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetProviderRegionsRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type GetProviderRegionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Regions       []string               `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProviderRegionsResponse) Reset() {
+	*x = GetProviderRegionsResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProviderRegionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProviderRegionsResponse) ProtoMessage() {}
+
+func (x *GetProviderRegionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProviderRegionsResponse.ProtoReflect.Descriptor instead.
+func (*GetProviderRegionsResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetProviderRegionsResponse) GetRegions() []string {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+type GetProviderMachineTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProviderMachineTypesRequest) Reset() {
+	*x = GetProviderMachineTypesRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProviderMachineTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProviderMachineTypesRequest) ProtoMessage() {}
+
+func (x *GetProviderMachineTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProviderMachineTypesRequest.ProtoReflect.Descriptor instead.
+func (*GetProviderMachineTypesRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetProviderMachineTypesRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *GetProviderMachineTypesRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type GetProviderMachineTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MachineTypes  []*MachineType         `protobuf:"bytes,1,rep,name=machine_types,json=machineTypes,proto3" json:"machine_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProviderMachineTypesResponse) Reset() {
+	*x = GetProviderMachineTypesResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProviderMachineTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProviderMachineTypesResponse) ProtoMessage() {}
+
+func (x *GetProviderMachineTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProviderMachineTypesResponse.ProtoReflect.Descriptor instead.
+func (*GetProviderMachineTypesResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetProviderMachineTypesResponse) GetMachineTypes() []*MachineType {
+	if x != nil {
+		return x.MachineTypes
+	}
+	return nil
+}
+
+type MachineType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cpu           string                 `protobuf:"bytes,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory        string                 `protobuf:"bytes,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	Gpu           string                 `protobuf:"bytes,4,opt,name=gpu,proto3" json:"gpu,omitempty"`
+	PricePerHour  float64                `protobuf:"fixed64,5,opt,name=price_per_hour,json=pricePerHour,proto3" json:"price_per_hour,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MachineType) Reset() {
+	*x = MachineType{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MachineType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachineType) ProtoMessage() {}
+
+func (x *MachineType) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachineType.ProtoReflect.Descriptor instead.
+func (*MachineType) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *MachineType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MachineType) GetCpu() string {
+	if x != nil {
+		return x.Cpu
+	}
+	return ""
+}
+
+func (x *MachineType) GetMemory() string {
+	if x != nil {
+		return x.Memory
+	}
+	return ""
+}
+
+func (x *MachineType) GetGpu() string {
+	if x != nil {
+		return x.Gpu
+	}
+	return ""
+}
+
+func (x *MachineType) GetPricePerHour() float64 {
+	if x != nil {
+		return x.PricePerHour
+	}
+	return 0
+}
+
+// Scheduler messages
+type GetSchedulerStatusResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Status          string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ProvidersCount  int32                  `protobuf:"varint,2,opt,name=providers_count,json=providersCount,proto3" json:"providers_count,omitempty"`
+	SchedulerStatus string                 `protobuf:"bytes,3,opt,name=scheduler_status,json=schedulerStatus,proto3" json:"scheduler_status,omitempty"`
+	SchedulerError  string                 `protobuf:"bytes,4,opt,name=scheduler_error,json=schedulerError,proto3" json:"scheduler_error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetSchedulerStatusResponse) Reset() {
+	*x = GetSchedulerStatusResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSchedulerStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulerStatusResponse) ProtoMessage() {}
+
+func (x *GetSchedulerStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulerStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSchedulerStatusResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetSchedulerStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetSchedulerStatusResponse) GetProvidersCount() int32 {
+	if x != nil {
+		return x.ProvidersCount
+	}
+	return 0
+}
+
+func (x *GetSchedulerStatusResponse) GetSchedulerStatus() string {
+	if x != nil {
+		return x.SchedulerStatus
+	}
+	return ""
+}
+
+func (x *GetSchedulerStatusResponse) GetSchedulerError() string {
+	if x != nil {
+		return x.SchedulerError
+	}
+	return ""
+}
+
+type ScheduleWorkloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Spec          *WorkloadSpec          `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	Constraints   *PlacementConstraints  `protobuf:"bytes,2,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleWorkloadRequest) Reset() {
+	*x = ScheduleWorkloadRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleWorkloadRequest) ProtoMessage() {}
+
+func (x *ScheduleWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ScheduleWorkloadRequest) GetSpec() *WorkloadSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *ScheduleWorkloadRequest) GetConstraints() *PlacementConstraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+type ScheduleWorkloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Zone          string                 `protobuf:"bytes,3,opt,name=zone,proto3" json:"zone,omitempty"`
+	NodeId        string                 `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	EstimatedCost float64                `protobuf:"fixed64,5,opt,name=estimated_cost,json=estimatedCost,proto3" json:"estimated_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleWorkloadResponse) Reset() {
+	*x = ScheduleWorkloadResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleWorkloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleWorkloadResponse) ProtoMessage() {}
+
+func (x *ScheduleWorkloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleWorkloadResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleWorkloadResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ScheduleWorkloadResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ScheduleWorkloadResponse) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ScheduleWorkloadResponse) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *ScheduleWorkloadResponse) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ScheduleWorkloadResponse) GetEstimatedCost() float64 {
+	if x != nil {
+		return x.EstimatedCost
+	}
+	return 0
+}
+
+type GetRecommendationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Spec          *WorkloadSpec          `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	Constraints   *PlacementConstraints  `protobuf:"bytes,2,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsRequest) Reset() {
+	*x = GetRecommendationsRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsRequest) ProtoMessage() {}
+
+func (x *GetRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetRecommendationsRequest) GetSpec() *WorkloadSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *GetRecommendationsRequest) GetConstraints() *PlacementConstraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+type GetRecommendationsResponse struct {
+	state           protoimpl.MessageState    `protogen:"open.v1"`
+	Recommendations []*ScheduleRecommendation `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsResponse) Reset() {
+	*x = GetRecommendationsResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsResponse) ProtoMessage() {}
+
+func (x *GetRecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetRecommendationsResponse) GetRecommendations() []*ScheduleRecommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+type ScheduleRecommendation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Provider         string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region           string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Zone             string                 `protobuf:"bytes,3,opt,name=zone,proto3" json:"zone,omitempty"`
+	CostPerHour      float64                `protobuf:"fixed64,4,opt,name=cost_per_hour,json=costPerHour,proto3" json:"cost_per_hour,omitempty"`
+	PerformanceScore float64                `protobuf:"fixed64,5,opt,name=performance_score,json=performanceScore,proto3" json:"performance_score,omitempty"`
+	Reason           string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ScheduleRecommendation) Reset() {
+	*x = ScheduleRecommendation{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRecommendation) ProtoMessage() {}
+
+func (x *ScheduleRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRecommendation.ProtoReflect.Descriptor instead.
+func (*ScheduleRecommendation) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ScheduleRecommendation) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ScheduleRecommendation) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ScheduleRecommendation) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *ScheduleRecommendation) GetCostPerHour() float64 {
+	if x != nil {
+		return x.CostPerHour
+	}
+	return 0
+}
+
+func (x *ScheduleRecommendation) GetPerformanceScore() float64 {
+	if x != nil {
+		return x.PerformanceScore
+	}
+	return 0
+}
+
+func (x *ScheduleRecommendation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Explains the placement of an existing workload, or of a spec that was not created
+type ExplainSchedulingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkloadId    string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	Spec          *WorkloadSpec          `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainSchedulingRequest) Reset() {
+	*x = ExplainSchedulingRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainSchedulingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainSchedulingRequest) ProtoMessage() {}
+
+func (x *ExplainSchedulingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainSchedulingRequest.ProtoReflect.Descriptor instead.
+func (*ExplainSchedulingRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ExplainSchedulingRequest) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+func (x *ExplainSchedulingRequest) GetSpec() *WorkloadSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type ExplainSchedulingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Feasible candidates first, best score first
+	Candidates []*SchedulingCandidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// Best feasible candidate; empty when no candidate can run the workload
+	SelectedProvider string `protobuf:"bytes,2,opt,name=selected_provider,json=selectedProvider,proto3" json:"selected_provider,omitempty"`
+	SelectedRegion   string `protobuf:"bytes,3,opt,name=selected_region,json=selectedRegion,proto3" json:"selected_region,omitempty"`
+	// Current status of an existing workload
+	Status        *WorkloadStatus `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainSchedulingResponse) Reset() {
+	*x = ExplainSchedulingResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainSchedulingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainSchedulingResponse) ProtoMessage() {}
+
+func (x *ExplainSchedulingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainSchedulingResponse.ProtoReflect.Descriptor instead.
+func (*ExplainSchedulingResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ExplainSchedulingResponse) GetCandidates() []*SchedulingCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *ExplainSchedulingResponse) GetSelectedProvider() string {
+	if x != nil {
+		return x.SelectedProvider
+	}
+	return ""
+}
+
+func (x *ExplainSchedulingResponse) GetSelectedRegion() string {
+	if x != nil {
+		return x.SelectedRegion
+	}
+	return ""
+}
+
+func (x *ExplainSchedulingResponse) GetStatus() *WorkloadStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type SchedulingCandidate struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Provider    string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	MachineType string                 `protobuf:"bytes,3,opt,name=machine_type,json=machineType,proto3" json:"machine_type,omitempty"`
+	NodeId      string                 `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Feasible    bool                   `protobuf:"varint,5,opt,name=feasible,proto3" json:"feasible,omitempty"`
+	// Filter plugin or step that rejected the candidate, e.g. "health", "pricing", "gpu", "budget"
+	FilteredBy      string            `protobuf:"bytes,6,opt,name=filtered_by,json=filteredBy,proto3" json:"filtered_by,omitempty"`
+	Reason          string            `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Score           float64           `protobuf:"fixed64,8,opt,name=score,proto3" json:"score,omitempty"`
+	Scores          []*ScoreComponent `protobuf:"bytes,9,rep,name=scores,proto3" json:"scores,omitempty"`
+	CostPerHour     float64           `protobuf:"fixed64,10,opt,name=cost_per_hour,json=costPerHour,proto3" json:"cost_per_hour,omitempty"`
+	CostConfidence  float64           `protobuf:"fixed64,11,opt,name=cost_confidence,json=costConfidence,proto3" json:"cost_confidence,omitempty"`
+	CostAssumptions []string          `protobuf:"bytes,12,rep,name=cost_assumptions,json=costAssumptions,proto3" json:"cost_assumptions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SchedulingCandidate) Reset() {
+	*x = SchedulingCandidate{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulingCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulingCandidate) ProtoMessage() {}
+
+func (x *SchedulingCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulingCandidate.ProtoReflect.Descriptor instead.
+func (*SchedulingCandidate) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SchedulingCandidate) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *SchedulingCandidate) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *SchedulingCandidate) GetMachineType() string {
+	if x != nil {
+		return x.MachineType
+	}
+	return ""
+}
+
+func (x *SchedulingCandidate) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *SchedulingCandidate) GetFeasible() bool {
+	if x != nil {
+		return x.Feasible
+	}
+	return false
+}
+
+func (x *SchedulingCandidate) GetFilteredBy() string {
+	if x != nil {
+		return x.FilteredBy
+	}
+	return ""
+}
+
+func (x *SchedulingCandidate) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SchedulingCandidate) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SchedulingCandidate) GetScores() []*ScoreComponent {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *SchedulingCandidate) GetCostPerHour() float64 {
+	if x != nil {
+		return x.CostPerHour
+	}
+	return 0
+}
+
+func (x *SchedulingCandidate) GetCostConfidence() float64 {
+	if x != nil {
+		return x.CostConfidence
+	}
+	return 0
+}
+
+func (x *SchedulingCandidate) GetCostAssumptions() []string {
+	if x != nil {
+		return x.CostAssumptions
+	}
+	return nil
+}
+
+type ScoreComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plugin        string                 `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Weight        float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreComponent) Reset() {
+	*x = ScoreComponent{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreComponent) ProtoMessage() {}
+
+func (x *ScoreComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreComponent.ProtoReflect.Descriptor instead.
+func (*ScoreComponent) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ScoreComponent) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *ScoreComponent) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ScoreComponent) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ScoreComponent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Lists pending workloads in the order they will be scheduled
+type ListSchedulingQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulingQueueRequest) Reset() {
+	*x = ListSchedulingQueueRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulingQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulingQueueRequest) ProtoMessage() {}
+
+func (x *ListSchedulingQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulingQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulingQueueRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListSchedulingQueueRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListSchedulingQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workloads     []*QueuedWorkload      `protobuf:"bytes,1,rep,name=workloads,proto3" json:"workloads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulingQueueResponse) Reset() {
+	*x = ListSchedulingQueueResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulingQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulingQueueResponse) ProtoMessage() {}
+
+func (x *ListSchedulingQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulingQueueResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulingQueueResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListSchedulingQueueResponse) GetWorkloads() []*QueuedWorkload {
+	if x != nil {
+		return x.Workloads
+	}
+	return nil
+}
+
+type QueuedWorkload struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	WorkloadId string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace  string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Priority   int32                  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// 1-based position in the whole queue
+	Position    int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	AgeSeconds  int64                  `protobuf:"varint,6,opt,name=age_seconds,json=ageSeconds,proto3" json:"age_seconds,omitempty"`
+	Attempts    int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttempt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_attempt,json=nextAttempt,proto3" json:"next_attempt,omitempty"`
+	LastError   string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// No provider could run the workload; retried early when capacity changes
+	Unschedulable bool `protobuf:"varint,10,opt,name=unschedulable,proto3" json:"unschedulable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueuedWorkload) Reset() {
+	*x = QueuedWorkload{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueuedWorkload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedWorkload) ProtoMessage() {}
+
+func (x *QueuedWorkload) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuedWorkload.ProtoReflect.Descriptor instead.
+func (*QueuedWorkload) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *QueuedWorkload) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+func (x *QueuedWorkload) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QueuedWorkload) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *QueuedWorkload) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *QueuedWorkload) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *QueuedWorkload) GetAgeSeconds() int64 {
+	if x != nil {
+		return x.AgeSeconds
+	}
+	return 0
+}
+
+func (x *QueuedWorkload) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *QueuedWorkload) GetNextAttempt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttempt
+	}
+	return nil
+}
+
+func (x *QueuedWorkload) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *QueuedWorkload) GetUnschedulable() bool {
+	if x != nil {
+		return x.Unschedulable
+	}
+	return false
+}
+
+type GetSchedulerStatsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TotalWorkloads      int32                  `protobuf:"varint,1,opt,name=total_workloads,json=totalWorkloads,proto3" json:"total_workloads,omitempty"`
+	RunningWorkloads    int32                  `protobuf:"varint,2,opt,name=running_workloads,json=runningWorkloads,proto3" json:"running_workloads,omitempty"`
+	PendingWorkloads    int32                  `protobuf:"varint,3,opt,name=pending_workloads,json=pendingWorkloads,proto3" json:"pending_workloads,omitempty"`
+	FailedWorkloads     int32                  `protobuf:"varint,4,opt,name=failed_workloads,json=failedWorkloads,proto3" json:"failed_workloads,omitempty"`
+	WorkloadsByProvider map[string]int32       `protobuf:"bytes,5,rep,name=workloads_by_provider,json=workloadsByProvider,proto3" json:"workloads_by_provider,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	TotalCostPerHour    float64                `protobuf:"fixed64,6,opt,name=total_cost_per_hour,json=totalCostPerHour,proto3" json:"total_cost_per_hour,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetSchedulerStatsResponse) Reset() {
+	*x = GetSchedulerStatsResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSchedulerStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulerStatsResponse) ProtoMessage() {}
+
+func (x *GetSchedulerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulerStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetSchedulerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetSchedulerStatsResponse) GetTotalWorkloads() int32 {
+	if x != nil {
+		return x.TotalWorkloads
+	}
+	return 0
+}
+
+func (x *GetSchedulerStatsResponse) GetRunningWorkloads() int32 {
+	if x != nil {
+		return x.RunningWorkloads
+	}
+	return 0
+}
+
+func (x *GetSchedulerStatsResponse) GetPendingWorkloads() int32 {
+	if x != nil {
+		return x.PendingWorkloads
+	}
+	return 0
+}
+
+func (x *GetSchedulerStatsResponse) GetFailedWorkloads() int32 {
+	if x != nil {
+		return x.FailedWorkloads
+	}
+	return 0
+}
+
+func (x *GetSchedulerStatsResponse) GetWorkloadsByProvider() map[string]int32 {
+	if x != nil {
+		return x.WorkloadsByProvider
+	}
+	return nil
+}
+
+func (x *GetSchedulerStatsResponse) GetTotalCostPerHour() float64 {
+	if x != nil {
+		return x.TotalCostPerHour
+	}
+	return 0
+}
+
+type PlacementConstraints struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Provider       string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region         string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Zone           string                 `protobuf:"bytes,3,opt,name=zone,proto3" json:"zone,omitempty"`
+	NodeLabels     map[string]string      `protobuf:"bytes,4,rep,name=node_labels,json=nodeLabels,proto3" json:"node_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Tolerations    []*Toleration          `protobuf:"bytes,5,rep,name=tolerations,proto3" json:"tolerations,omitempty"`
+	MaxCostPerHour float64                `protobuf:"fixed64,6,opt,name=max_cost_per_hour,json=maxCostPerHour,proto3" json:"max_cost_per_hour,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlacementConstraints) Reset() {
+	*x = PlacementConstraints{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacementConstraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementConstraints) ProtoMessage() {}
+
+func (x *PlacementConstraints) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementConstraints.ProtoReflect.Descriptor instead.
+func (*PlacementConstraints) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *PlacementConstraints) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PlacementConstraints) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *PlacementConstraints) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *PlacementConstraints) GetNodeLabels() map[string]string {
+	if x != nil {
+		return x.NodeLabels
+	}
+	return nil
+}
+
+func (x *PlacementConstraints) GetTolerations() []*Toleration {
+	if x != nil {
+		return x.Tolerations
+	}
+	return nil
+}
+
+func (x *PlacementConstraints) GetMaxCostPerHour() float64 {
+	if x != nil {
+		return x.MaxCostPerHour
+	}
+	return 0
+}
+
+// Mints an API token. Principals mint their own tokens; minting for another
+// principal needs the cluster admin role.
+type CreateTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Defaults to the calling principal
+	Principal string `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	// Never expires when 0
+	TtlSeconds    int64 `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTokenRequest) Reset() {
+	*x = CreateTokenRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTokenRequest) ProtoMessage() {}
+
+func (x *CreateTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateTokenRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CreateTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTokenRequest) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *CreateTokenRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type CreateTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token *Token                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The token itself, only returned once
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTokenResponse) Reset() {
+	*x = CreateTokenResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTokenResponse) ProtoMessage() {}
+
+func (x *CreateTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateTokenResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CreateTokenResponse) GetToken() *Token {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *CreateTokenResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// Lists the tokens of a principal, the caller's own by default
+type ListTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Principal     string                 `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTokensRequest) Reset() {
+	*x = ListTokensRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTokensRequest) ProtoMessage() {}
+
+func (x *ListTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListTokensRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListTokensRequest) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+type ListTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*Token               `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTokensResponse) Reset() {
+	*x = ListTokensResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTokensResponse) ProtoMessage() {}
+
+func (x *ListTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListTokensResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListTokensResponse) GetTokens() []*Token {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type RevokeTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTokenRequest) Reset() {
+	*x = RevokeTokenRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTokenRequest) ProtoMessage() {}
+
+func (x *RevokeTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTokenRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RevokeTokenRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Token struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Principal     string                 `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Token) Reset() {
+	*x = Token{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Token) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Token) ProtoMessage() {}
+
+func (x *Token) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Token.ProtoReflect.Descriptor instead.
+func (*Token) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *Token) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Token) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Token) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *Token) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Token) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Token) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+// Grants a principal a role in a namespace, or in every namespace with "*"
+type CreateRoleBindingRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Principal string                 `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Namespace string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// viewer, editor or admin
+	Role          string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoleBindingRequest) Reset() {
+	*x = CreateRoleBindingRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleBindingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleBindingRequest) ProtoMessage() {}
+
+func (x *CreateRoleBindingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleBindingRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoleBindingRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CreateRoleBindingRequest) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *CreateRoleBindingRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CreateRoleBindingRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type CreateRoleBindingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleBinding   *RoleBinding           `protobuf:"bytes,1,opt,name=role_binding,json=roleBinding,proto3" json:"role_binding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoleBindingResponse) Reset() {
+	*x = CreateRoleBindingResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleBindingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleBindingResponse) ProtoMessage() {}
+
+func (x *CreateRoleBindingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleBindingResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoleBindingResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CreateRoleBindingResponse) GetRoleBinding() *RoleBinding {
+	if x != nil {
+		return x.RoleBinding
+	}
+	return nil
+}
+
+// Lists the role bindings of a namespace, the cluster-wide ones with "*" and all
+// of them when empty
+type ListRoleBindingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Principal     string                 `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoleBindingsRequest) Reset() {
+	*x = ListRoleBindingsRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleBindingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleBindingsRequest) ProtoMessage() {}
+
+func (x *ListRoleBindingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleBindingsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoleBindingsRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListRoleBindingsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListRoleBindingsRequest) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+type ListRoleBindingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleBindings  []*RoleBinding         `protobuf:"bytes,1,rep,name=role_bindings,json=roleBindings,proto3" json:"role_bindings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoleBindingsResponse) Reset() {
+	*x = ListRoleBindingsResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleBindingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleBindingsResponse) ProtoMessage() {}
+
+func (x *ListRoleBindingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleBindingsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoleBindingsResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListRoleBindingsResponse) GetRoleBindings() []*RoleBinding {
+	if x != nil {
+		return x.RoleBindings
+	}
+	return nil
+}
+
+type DeleteRoleBindingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleBindingRequest) Reset() {
+	*x = DeleteRoleBindingRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleBindingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleBindingRequest) ProtoMessage() {}
+
+func (x *DeleteRoleBindingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleBindingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleBindingRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *DeleteRoleBindingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RoleBinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Principal     string                 `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleBinding) Reset() {
+	*x = RoleBinding{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBinding) ProtoMessage() {}
+
+func (x *RoleBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBinding.ProtoReflect.Descriptor instead.
+func (*RoleBinding) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *RoleBinding) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Health check
+type HealthCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Service       string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckResponse) ProtoMessage() {}
+
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *HealthCheckResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// Node messages
+type RegisterNodeRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	NodeId   string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Region   string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Zone     string                 `protobuf:"bytes,4,opt,name=zone,proto3" json:"zone,omitempty"`
+	Labels   map[string]string      `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Capacity *NodeResources         `protobuf:"bytes,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Address  string                 `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	Version  string                 `protobuf:"bytes,8,opt,name=version,proto3" json:"version,omitempty"`
+	Taints   []*NodeTaint           `protobuf:"bytes,9,rep,name=taints,proto3" json:"taints,omitempty"`
+	// Port of the agent service on address, 0 when not served
+	AgentPort     int32 `protobuf:"varint,10,opt,name=agent_port,json=agentPort,proto3" json:"agent_port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterNodeRequest) Reset() {
+	*x = RegisterNodeRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterNodeRequest) ProtoMessage() {}
+
+func (x *RegisterNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterNodeRequest.ProtoReflect.Descriptor instead.
+func (*RegisterNodeRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RegisterNodeRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *RegisterNodeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterNodeRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *RegisterNodeRequest) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *RegisterNodeRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *RegisterNodeRequest) GetCapacity() *NodeResources {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+func (x *RegisterNodeRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RegisterNodeRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RegisterNodeRequest) GetTaints() []*NodeTaint {
+	if x != nil {
+		return x.Taints
+	}
+	return nil
+}
+
+func (x *RegisterNodeRequest) GetAgentPort() int32 {
+	if x != nil {
+		return x.AgentPort
+	}
+	return 0
+}
+
+type RegisterNodeResponse struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	NodeId                   string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	HeartbeatIntervalSeconds int32                  `protobuf:"varint,2,opt,name=heartbeat_interval_seconds,json=heartbeatIntervalSeconds,proto3" json:"heartbeat_interval_seconds,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *RegisterNodeResponse) Reset() {
+	*x = RegisterNodeResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterNodeResponse) ProtoMessage() {}
+
+func (x *RegisterNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterNodeResponse.ProtoReflect.Descriptor instead.
+func (*RegisterNodeResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *RegisterNodeResponse) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *RegisterNodeResponse) GetHeartbeatIntervalSeconds() int32 {
+	if x != nil {
+		return x.HeartbeatIntervalSeconds
+	}
+	return 0
+}
+
+type UnregisterNodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterNodeRequest) Reset() {
+	*x = UnregisterNodeRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterNodeRequest) ProtoMessage() {}
+
+func (x *UnregisterNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterNodeRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterNodeRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *UnregisterNodeRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type HeartbeatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	WorkloadCount int32                  `protobuf:"varint,3,opt,name=workload_count,json=workloadCount,proto3" json:"workload_count,omitempty"`
+	WorkloadIds   []string               `protobuf:"bytes,4,rep,name=workload_ids,json=workloadIds,proto3" json:"workload_ids,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatRequest) ProtoMessage() {}
+
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *HeartbeatRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *HeartbeatRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HeartbeatRequest) GetWorkloadCount() int32 {
+	if x != nil {
+		return x.WorkloadCount
+	}
+	return 0
+}
+
+func (x *HeartbeatRequest) GetWorkloadIds() []string {
+	if x != nil {
+		return x.WorkloadIds
+	}
+	return nil
+}
+
+func (x *HeartbeatRequest) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type HeartbeatResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// False when weaver does not know the node and it must register again
+	Registered bool `protobuf:"varint,1,opt,name=registered,proto3" json:"registered,omitempty"`
+	// Workloads the node reported but no longer owns, e.g. after they were
+	// rescheduled while the node was unreachable. The node must stop them.
+	FencedWorkloadIds []string `protobuf:"bytes,2,rep,name=fenced_workload_ids,json=fencedWorkloadIds,proto3" json:"fenced_workload_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *HeartbeatResponse) Reset() {
+	*x = HeartbeatResponse{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResponse) ProtoMessage() {}
+
+func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *HeartbeatResponse) GetRegistered() bool {
+	if x != nil {
+		return x.Registered
+	}
+	return false
+}
+
+func (x *HeartbeatResponse) GetFencedWorkloadIds() []string {
+	if x != nil {
+		return x.FencedWorkloadIds
+	}
+	return nil
+}
+
+type WatchAssignmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAssignmentsRequest) Reset() {
+	*x = WatchAssignmentsRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAssignmentsRequest) ProtoMessage() {}
+
+func (x *WatchAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*WatchAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *WatchAssignmentsRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+// Full set of workloads a node should be running
+type WorkloadAssignments struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workloads     []*WorkloadAssignment  `protobuf:"bytes,1,rep,name=workloads,proto3" json:"workloads,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkloadAssignments) Reset() {
+	*x = WorkloadAssignments{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadAssignments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadAssignments) ProtoMessage() {}
+
+func (x *WorkloadAssignments) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadAssignments.ProtoReflect.Descriptor instead.
+func (*WorkloadAssignments) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *WorkloadAssignments) GetWorkloads() []*WorkloadAssignment {
+	if x != nil {
+		return x.Workloads
+	}
+	return nil
+}
+
+func (x *WorkloadAssignments) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type WorkloadAssignment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Spec      *WorkloadSpec          `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+	// Resolved secret values, never stored by weaver
+	SecretEnv     map[string]string `protobuf:"bytes,5,rep,name=secret_env,json=secretEnv,proto3" json:"secret_env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SecretFiles   []*SecretFile     `protobuf:"bytes,6,rep,name=secret_files,json=secretFiles,proto3" json:"secret_files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkloadAssignment) Reset() {
+	*x = WorkloadAssignment{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadAssignment) ProtoMessage() {}
+
+func (x *WorkloadAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadAssignment.ProtoReflect.Descriptor instead.
+func (*WorkloadAssignment) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *WorkloadAssignment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WorkloadAssignment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkloadAssignment) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WorkloadAssignment) GetSpec() *WorkloadSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *WorkloadAssignment) GetSecretEnv() map[string]string {
+	if x != nil {
+		return x.SecretEnv
+	}
+	return nil
+}
+
+func (x *WorkloadAssignment) GetSecretFiles() []*SecretFile {
+	if x != nil {
+		return x.SecretFiles
+	}
+	return nil
+}
+
+type SecretFile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Absolute path of the file inside the container
+	Path          string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Value         []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretFile) Reset() {
+	*x = SecretFile{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretFile) ProtoMessage() {}
+
+func (x *SecretFile) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretFile.ProtoReflect.Descriptor instead.
+func (*SecretFile) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *SecretFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SecretFile) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type ReportWorkloadStatusRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NodeId      string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	WorkloadId  string                 `protobuf:"bytes,2,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	Status      string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ContainerId string                 `protobuf:"bytes,4,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Message     string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	ExitCode    int32                  `protobuf:"varint,6,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Timestamp   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Times the node restarted the container under the workload's restart policy
+	RestartCount  int32  `protobuf:"varint,8,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	Reason        string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportWorkloadStatusRequest) Reset() {
+	*x = ReportWorkloadStatusRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportWorkloadStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportWorkloadStatusRequest) ProtoMessage() {}
+
+func (x *ReportWorkloadStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportWorkloadStatusRequest.ProtoReflect.Descriptor instead.
+func (*ReportWorkloadStatusRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ReportWorkloadStatusRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ReportWorkloadStatusRequest) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+func (x *ReportWorkloadStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReportWorkloadStatusRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ReportWorkloadStatusRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReportWorkloadStatusRequest) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ReportWorkloadStatusRequest) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ReportWorkloadStatusRequest) GetRestartCount() int32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *ReportWorkloadStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type NodeTaint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Effect        string                 `protobuf:"bytes,3,opt,name=effect,proto3" json:"effect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeTaint) Reset() {
+	*x = NodeTaint{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeTaint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeTaint) ProtoMessage() {}
+
+func (x *NodeTaint) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeTaint.ProtoReflect.Descriptor instead.
+func (*NodeTaint) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *NodeTaint) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *NodeTaint) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *NodeTaint) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+type NodeResources struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cpu           string                 `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory        string                 `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	Gpu           string                 `protobuf:"bytes,3,opt,name=gpu,proto3" json:"gpu,omitempty"`
+	Disk          string                 `protobuf:"bytes,4,opt,name=disk,proto3" json:"disk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeResources) Reset() {
+	*x = NodeResources{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeResources) ProtoMessage() {}
+
+func (x *NodeResources) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeResources.ProtoReflect.Descriptor instead.
+func (*NodeResources) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *NodeResources) GetCpu() string {
+	if x != nil {
+		return x.Cpu
+	}
+	return ""
+}
+
+func (x *NodeResources) GetMemory() string {
+	if x != nil {
+		return x.Memory
+	}
+	return ""
+}
+
+func (x *NodeResources) GetGpu() string {
+	if x != nil {
+		return x.Gpu
+	}
+	return ""
+}
+
+func (x *NodeResources) GetDisk() string {
+	if x != nil {
+		return x.Disk
+	}
+	return ""
+}
+
+// Agent messages
+type AgentLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Container     string                 `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"`
+	Follow        bool                   `protobuf:"varint,4,opt,name=follow,proto3" json:"follow,omitempty"`
+	TailLines     int64                  `protobuf:"varint,5,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentLogsRequest) Reset() {
+	*x = AgentLogsRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentLogsRequest) ProtoMessage() {}
+
+func (x *AgentLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentLogsRequest.ProtoReflect.Descriptor instead.
+func (*AgentLogsRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *AgentLogsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AgentLogsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AgentLogsRequest) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *AgentLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *AgentLogsRequest) GetTailLines() int64 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
+}
+
+func (x *AgentLogsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+// Namespace and name are only read from the first request
+type AgentExecRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Request       *ExecRequest           `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentExecRequest) Reset() {
+	*x = AgentExecRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentExecRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentExecRequest) ProtoMessage() {}
+
+func (x *AgentExecRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentExecRequest.ProtoReflect.Descriptor instead.
+func (*AgentExecRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *AgentExecRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AgentExecRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AgentExecRequest) GetRequest() *ExecRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// Namespace and name are only read from the first request
+type AgentPortForwardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Request       *PortForwardRequest    `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgentPortForwardRequest) Reset() {
+	*x = AgentPortForwardRequest{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentPortForwardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentPortForwardRequest) ProtoMessage() {}
+
+func (x *AgentPortForwardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentPortForwardRequest.ProtoReflect.Descriptor instead.
+func (*AgentPortForwardRequest) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *AgentPortForwardRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AgentPortForwardRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AgentPortForwardRequest) GetRequest() *PortForwardRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// Core types
+type Workload struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace   string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Labels      map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations map[string]string      `protobuf:"bytes,5,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Spec        *WorkloadSpec          `protobuf:"bytes,6,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status      *WorkloadStatus        `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Cleanup still pending before a deleted workload is removed
+	Finalizers    []string `protobuf:"bytes,11,rep,name=finalizers,proto3" json:"finalizers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Workload) Reset() {
+	*x = Workload{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Workload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Workload) ProtoMessage() {}
+
+func (x *Workload) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Workload.ProtoReflect.Descriptor instead.
+func (*Workload) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *Workload) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Workload) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Workload) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Workload) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Workload) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Workload) GetSpec() *WorkloadSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Workload) GetStatus() *WorkloadStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *Workload) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Workload) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Workload) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Workload) GetFinalizers() []string {
+	if x != nil {
+		return x.Finalizers
+	}
+	return nil
+}
+
+type WorkloadSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         string                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Command       []string               `protobuf:"bytes,2,rep,name=command,proto3" json:"command,omitempty"`
+	Args          []string               `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	Env           map[string]string      `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Resources     *ResourceRequests      `protobuf:"bytes,5,opt,name=resources,proto3" json:"resources,omitempty"`
+	Volumes       []*VolumeMount         `protobuf:"bytes,6,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	Ports         []*Port                `protobuf:"bytes,7,rep,name=ports,proto3" json:"ports,omitempty"`
+	Sidecars      []*SidecarSpec         `protobuf:"bytes,8,rep,name=sidecars,proto3" json:"sidecars,omitempty"`
+	RestartPolicy string                 `protobuf:"bytes,9,opt,name=restart_policy,json=restartPolicy,proto3" json:"restart_policy,omitempty"`
+	Placement     *PlacementSpec         `protobuf:"bytes,10,opt,name=placement,proto3" json:"placement,omitempty"`
+	// Higher priority workloads are scheduled first
+	Priority int32 `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Secret-backed environment, resolved by the provider at provision time
+	EnvFrom      []*EnvFromSource         `protobuf:"bytes,12,rep,name=env_from,json=envFrom,proto3" json:"env_from,omitempty"`
+	EnvValueFrom map[string]*EnvVarSource `protobuf:"bytes,13,rep,name=env_value_from,json=envValueFrom,proto3" json:"env_value_from,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// "Service" (default) or "Job"; jobs run to completion
+	Kind          string   `protobuf:"bytes,14,opt,name=kind,proto3" json:"kind,omitempty"`
+	Job           *JobSpec `protobuf:"bytes,15,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkloadSpec) Reset() {
+	*x = WorkloadSpec{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadSpec) ProtoMessage() {}
+
+func (x *WorkloadSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadSpec.ProtoReflect.Descriptor instead.
+func (*WorkloadSpec) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *WorkloadSpec) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *WorkloadSpec) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *WorkloadSpec) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *WorkloadSpec) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *WorkloadSpec) GetResources() *ResourceRequests {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *WorkloadSpec) GetVolumes() []*VolumeMount {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *WorkloadSpec) GetPorts() []*Port {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *WorkloadSpec) GetSidecars() []*SidecarSpec {
+	if x != nil {
+		return x.Sidecars
+	}
+	return nil
+}
+
+func (x *WorkloadSpec) GetRestartPolicy() string {
+	if x != nil {
+		return x.RestartPolicy
+	}
+	return ""
+}
+
+func (x *WorkloadSpec) GetPlacement() *PlacementSpec {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *WorkloadSpec) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *WorkloadSpec) GetEnvFrom() []*EnvFromSource {
+	if x != nil {
+		return x.EnvFrom
+	}
+	return nil
+}
+
+func (x *WorkloadSpec) GetEnvValueFrom() map[string]*EnvVarSource {
+	if x != nil {
+		return x.EnvValueFrom
+	}
+	return nil
+}
+
+func (x *WorkloadSpec) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *WorkloadSpec) GetJob() *JobSpec {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type JobSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Successful runs needed, default 1
+	Completions int32 `protobuf:"varint,1,opt,name=completions,proto3" json:"completions,omitempty"`
+	// Runs at a time, default 1
+	Parallelism int32 `protobuf:"varint,2,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	// Failed runs tolerated, default 6
+	BackoffLimit *int32 `protobuf:"varint,3,opt,name=backoff_limit,json=backoffLimit,proto3,oneof" json:"backoff_limit,omitempty"`
+	// Bounds how long the job may run in total, retries included
+	ActiveDeadlineSeconds int64 `protobuf:"varint,4,opt,name=active_deadline_seconds,json=activeDeadlineSeconds,proto3" json:"active_deadline_seconds,omitempty"`
+	// Deletes the job that long after it finished; unset keeps it
+	TtlSecondsAfterFinished *int32 `protobuf:"varint,5,opt,name=ttl_seconds_after_finished,json=ttlSecondsAfterFinished,proto3,oneof" json:"ttl_seconds_after_finished,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *JobSpec) Reset() {
+	*x = JobSpec{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobSpec) ProtoMessage() {}
+
+func (x *JobSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobSpec.ProtoReflect.Descriptor instead.
+func (*JobSpec) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *JobSpec) GetCompletions() int32 {
+	if x != nil {
+		return x.Completions
+	}
+	return 0
+}
+
+func (x *JobSpec) GetParallelism() int32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *JobSpec) GetBackoffLimit() int32 {
+	if x != nil && x.BackoffLimit != nil {
+		return *x.BackoffLimit
+	}
+	return 0
+}
+
+func (x *JobSpec) GetActiveDeadlineSeconds() int64 {
+	if x != nil {
+		return x.ActiveDeadlineSeconds
+	}
+	return 0
+}
+
+func (x *JobSpec) GetTtlSecondsAfterFinished() int32 {
+	if x != nil && x.TtlSecondsAfterFinished != nil {
+		return *x.TtlSecondsAfterFinished
+	}
+	return 0
+}
+
+type SecretReference struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// If empty, all keys are used
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretReference) Reset() {
+	*x = SecretReference{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretReference) ProtoMessage() {}
+
+func (x *SecretReference) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretReference.ProtoReflect.Descriptor instead.
+func (*SecretReference) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *SecretReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretReference) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type EnvFromSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecretRef     *SecretReference       `protobuf:"bytes,1,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref,omitempty"`
+	Prefix        string                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnvFromSource) Reset() {
+	*x = EnvFromSource{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvFromSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvFromSource) ProtoMessage() {}
+
+func (x *EnvFromSource) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvFromSource.ProtoReflect.Descriptor instead.
+func (*EnvFromSource) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *EnvFromSource) GetSecretRef() *SecretReference {
+	if x != nil {
+		return x.SecretRef
+	}
+	return nil
+}
+
+func (x *EnvFromSource) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type EnvVarSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecretKeyRef  *SecretReference       `protobuf:"bytes,1,opt,name=secret_key_ref,json=secretKeyRef,proto3" json:"secret_key_ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnvVarSource) Reset() {
+	*x = EnvVarSource{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvVarSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvVarSource) ProtoMessage() {}
+
+func (x *EnvVarSource) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvVarSource.ProtoReflect.Descriptor instead.
+func (*EnvVarSource) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *EnvVarSource) GetSecretKeyRef() *SecretReference {
+	if x != nil {
+		return x.SecretKeyRef
+	}
+	return nil
+}
+
+type ResourceRequests struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cpu           string                 `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory        string                 `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	Gpu           string                 `protobuf:"bytes,3,opt,name=gpu,proto3" json:"gpu,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceRequests) Reset() {
+	*x = ResourceRequests{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceRequests) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceRequests) ProtoMessage() {}
+
+func (x *ResourceRequests) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceRequests.ProtoReflect.Descriptor instead.
+func (*ResourceRequests) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ResourceRequests) GetCpu() string {
+	if x != nil {
+		return x.Cpu
+	}
+	return ""
+}
+
+func (x *ResourceRequests) GetMemory() string {
+	if x != nil {
+		return x.Memory
+	}
+	return ""
+}
+
+func (x *ResourceRequests) GetGpu() string {
+	if x != nil {
+		return x.Gpu
+	}
+	return ""
+}
+
+type VolumeMount struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MountPath string                 `protobuf:"bytes,2,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	ReadOnly  bool                   `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	ContentId string                 `protobuf:"bytes,4,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	Size      string                 `protobuf:"bytes,5,opt,name=size,proto3" json:"size,omitempty"`
+	// Mounts the secret's keys as files under mount_path
+	Secret        *SecretReference `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeMount) Reset() {
+	*x = VolumeMount{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeMount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeMount) ProtoMessage() {}
+
+func (x *VolumeMount) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeMount.ProtoReflect.Descriptor instead.
+func (*VolumeMount) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *VolumeMount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeMount) GetMountPath() string {
+	if x != nil {
+		return x.MountPath
+	}
+	return ""
+}
+
+func (x *VolumeMount) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *VolumeMount) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *VolumeMount) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *VolumeMount) GetSecret() *SecretReference {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type Port struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContainerPort int32                  `protobuf:"varint,2,opt,name=container_port,json=containerPort,proto3" json:"container_port,omitempty"`
+	Protocol      string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Port) Reset() {
+	*x = Port{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Port) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Port) ProtoMessage() {}
+
+func (x *Port) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Port.ProtoReflect.Descriptor instead.
+func (*Port) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *Port) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Port) GetContainerPort() int32 {
+	if x != nil {
+		return x.ContainerPort
+	}
+	return 0
+}
+
+func (x *Port) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+type SidecarSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	Command       []string               `protobuf:"bytes,3,rep,name=command,proto3" json:"command,omitempty"`
+	Args          []string               `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	Env           map[string]string      `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SidecarSpec) Reset() {
+	*x = SidecarSpec{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SidecarSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidecarSpec) ProtoMessage() {}
+
+func (x *SidecarSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidecarSpec.ProtoReflect.Descriptor instead.
+func (*SidecarSpec) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *SidecarSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SidecarSpec) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *SidecarSpec) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *SidecarSpec) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *SidecarSpec) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+type PlacementSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Zone          string                 `protobuf:"bytes,3,opt,name=zone,proto3" json:"zone,omitempty"`
+	NodeLabels    map[string]string      `protobuf:"bytes,4,rep,name=node_labels,json=nodeLabels,proto3" json:"node_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Tolerations   []*Toleration          `protobuf:"bytes,5,rep,name=tolerations,proto3" json:"tolerations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlacementSpec) Reset() {
+	*x = PlacementSpec{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacementSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementSpec) ProtoMessage() {}
+
+func (x *PlacementSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementSpec.ProtoReflect.Descriptor instead.
+func (*PlacementSpec) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *PlacementSpec) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PlacementSpec) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *PlacementSpec) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *PlacementSpec) GetNodeLabels() map[string]string {
+	if x != nil {
+		return x.NodeLabels
+	}
+	return nil
+}
+
+func (x *PlacementSpec) GetTolerations() []*Toleration {
+	if x != nil {
+		return x.Tolerations
+	}
+	return nil
+}
+
+type Toleration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Effect        string                 `protobuf:"bytes,4,opt,name=effect,proto3" json:"effect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Toleration) Reset() {
+	*x = Toleration{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Toleration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Toleration) ProtoMessage() {}
+
+func (x *Toleration) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Toleration.ProtoReflect.Descriptor instead.
+func (*Toleration) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *Toleration) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Toleration) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Toleration) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Toleration) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+type WorkloadStatus struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Phase        string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Message      string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Reason       string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	StartTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	FinishTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
+	RestartCount int32                  `protobuf:"varint,6,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	NodeId       string                 `protobuf:"bytes,7,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Provider     string                 `protobuf:"bytes,8,opt,name=provider,proto3" json:"provider,omitempty"`
+	TailscaleIp  string                 `protobuf:"bytes,9,opt,name=tailscale_ip,json=tailscaleIp,proto3" json:"tailscale_ip,omitempty"`
+	ContainerId  string                 `protobuf:"bytes,10,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	SnapshotId   string                 `protobuf:"bytes,11,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	LastSnapshot *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_snapshot,json=lastSnapshot,proto3" json:"last_snapshot,omitempty"`
+	ProviderRef  *ProviderReference     `protobuf:"bytes,13,opt,name=provider_ref,json=providerRef,proto3" json:"provider_ref,omitempty"`
+	// Exit code of the last exited container
+	ExitCode int32 `protobuf:"varint,14,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	// Runs of a job
+	Job           *JobStatus `protobuf:"bytes,15,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkloadStatus) Reset() {
+	*x = WorkloadStatus{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadStatus) ProtoMessage() {}
+
+func (x *WorkloadStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadStatus.ProtoReflect.Descriptor instead.
+func (*WorkloadStatus) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *WorkloadStatus) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *WorkloadStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WorkloadStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *WorkloadStatus) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *WorkloadStatus) GetFinishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishTime
+	}
+	return nil
+}
+
+func (x *WorkloadStatus) GetRestartCount() int32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *WorkloadStatus) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *WorkloadStatus) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *WorkloadStatus) GetTailscaleIp() string {
+	if x != nil {
+		return x.TailscaleIp
+	}
+	return ""
+}
+
+func (x *WorkloadStatus) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *WorkloadStatus) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *WorkloadStatus) GetLastSnapshot() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSnapshot
+	}
+	return nil
+}
+
+func (x *WorkloadStatus) GetProviderRef() *ProviderReference {
+	if x != nil {
+		return x.ProviderRef
+	}
+	return nil
+}
+
+func (x *WorkloadStatus) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *WorkloadStatus) GetJob() *JobStatus {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type JobStatus struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Active         int32                  `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	Succeeded      int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed         int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	CompletionTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+	// When a job waiting to be scheduled again runs next
+	RetryAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=retry_at,json=retryAt,proto3" json:"retry_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobStatus) Reset() {
+	*x = JobStatus{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobStatus) ProtoMessage() {}
+
+func (x *JobStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobStatus.ProtoReflect.Descriptor instead.
+func (*JobStatus) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *JobStatus) GetActive() int32 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *JobStatus) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *JobStatus) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *JobStatus) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *JobStatus) GetCompletionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletionTime
+	}
+	return nil
+}
+
+func (x *JobStatus) GetRetryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetryAt
+	}
+	return nil
+}
+
+type ProviderReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExternalId    string                 `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProviderReference) Reset() {
+	*x = ProviderReference{}
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderReference) ProtoMessage() {}
+
+func (x *ProviderReference) ProtoReflect() protoreflect.Message {
+	mi := &file_weaver_proto_weaver_weaver_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderReference.ProtoReflect.Descriptor instead.
+func (*ProviderReference) Descriptor() ([]byte, []int) {
+	return file_weaver_proto_weaver_weaver_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ProviderReference) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *ProviderReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProviderReference) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+var File_weaver_proto_weaver_weaver_proto protoreflect.FileDescriptor
+
+const file_weaver_proto_weaver_weaver_proto_rawDesc = "" +
+	"\n" +
+	" weaver/proto/weaver/weaver.proto\x12\x06weaver\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x83\x03\n" +
+	"\x15CreateWorkloadRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12(\n" +
+	"\x04spec\x18\x03 \x01(\v2\x14.weaver.WorkloadSpecR\x04spec\x12A\n" +
+	"\x06labels\x18\x04 \x03(\v2).weaver.CreateWorkloadRequest.LabelsEntryR\x06labels\x12P\n" +
+	"\vannotations\x18\x05 \x03(\v2..weaver.CreateWorkloadRequest.AnnotationsEntryR\vannotations\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc5\x01\n" +
+	"\x16CreateWorkloadResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12.\n" +
+	"\x06status\x18\x04 \x01(\v2\x16.weaver.WorkloadStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"$\n" +
+	"\x12GetWorkloadRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
+	"\x13GetWorkloadResponse\x12,\n" +
+	"\bworkload\x18\x01 \x01(\v2\x10.weaver.WorkloadR\bworkload\"\xce\x02\n" +
+	"\x14ListWorkloadsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12V\n" +
+	"\x0elabel_selector\x18\x02 \x03(\v2/.weaver.ListWorkloadsRequest.LabelSelectorEntryR\rlabelSelector\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12%\n" +
+	"\x0econtinue_token\x18\x04 \x01(\tR\rcontinueToken\x12\x1a\n" +
+	"\bselector\x18\x05 \x01(\tR\bselector\x12%\n" +
+	"\x0efield_selector\x18\x06 \x01(\tR\rfieldSelector\x1a@\n" +
+	"\x12LabelSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x84\x01\n" +
+	"\x15ListWorkloadsResponse\x12.\n" +
+	"\tworkloads\x18\x01 \x03(\v2\x10.weaver.WorkloadR\tworkloads\x12%\n" +
+	"\x0econtinue_token\x18\x02 \x01(\tR\rcontinueToken\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\"\xe1\x02\n" +
+	"\x15UpdateWorkloadRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x04spec\x18\x02 \x01(\v2\x14.weaver.WorkloadSpecR\x04spec\x12A\n" +
+	"\x06labels\x18\x03 \x03(\v2).weaver.UpdateWorkloadRequest.LabelsEntryR\x06labels\x12P\n" +
+	"\vannotations\x18\x04 \x03(\v2..weaver.UpdateWorkloadRequest.AnnotationsEntryR\vannotations\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"|\n" +
+	"\x16UpdateWorkloadResponse\x12,\n" +
+	"\bworkload\x18\x01 \x01(\v2\x10.weaver.WorkloadR\bworkload\x12\x1a\n" +
+	"\bstrategy\x18\x02 \x01(\tR\bstrategy\x12\x18\n" +
+	"\achanges\x18\x03 \x03(\tR\achanges\"\xbe\x02\n" +
+	"\x15WatchWorkloadsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12W\n" +
+	"\x0elabel_selector\x18\x02 \x03(\v20.weaver.WatchWorkloadsRequest.LabelSelectorEntryR\rlabelSelector\x12)\n" +
+	"\x10resource_version\x18\x03 \x01(\x04R\x0fresourceVersion\x12\x1a\n" +
+	"\bselector\x18\x04 \x01(\tR\bselector\x12%\n" +
+	"\x0efield_selector\x18\x05 \x01(\tR\rfieldSelector\x1a@\n" +
+	"\x12LabelSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"|\n" +
+	"\rWorkloadEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12,\n" +
+	"\bworkload\x18\x02 \x01(\v2\x10.weaver.WorkloadR\bworkload\x12)\n" +
+	"\x10resource_version\x18\x03 \x01(\x04R\x0fresourceVersion\"\xb2\x01\n" +
+	"\x19StreamWorkloadLogsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tcontainer\x18\x02 \x01(\tR\tcontainer\x12\x16\n" +
+	"\x06follow\x18\x03 \x01(\bR\x06follow\x12\x1d\n" +
+	"\n" +
+	"tail_lines\x18\x04 \x01(\x03R\ttailLines\x120\n" +
+	"\x05since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"x\n" +
+	"\x10WorkloadLogEntry\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\tR\x04line\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n" +
+	"\x06stream\x18\x03 \x01(\tR\x06stream\"\xae\x01\n" +
+	"\vExecRequest\x12)\n" +
+	"\x05start\x18\x01 \x01(\v2\x11.weaver.ExecStartH\x00R\x05start\x12\x16\n" +
+	"\x05stdin\x18\x02 \x01(\fH\x00R\x05stdin\x12.\n" +
+	"\x06resize\x18\x03 \x01(\v2\x14.weaver.TerminalSizeH\x00R\x06resize\x12!\n" +
+	"\vclose_stdin\x18\x04 \x01(\bH\x00R\n" +
+	"closeStdinB\t\n" +
+	"\apayload\"{\n" +
+	"\tExecStart\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tcontainer\x18\x02 \x01(\tR\tcontainer\x12\x18\n" +
+	"\acommand\x18\x03 \x03(\tR\acommand\x12\x10\n" +
+	"\x03tty\x18\x04 \x01(\bR\x03tty\x12\x14\n" +
+	"\x05stdin\x18\x05 \x01(\bR\x05stdin\"<\n" +
+	"\fTerminalSize\x12\x14\n" +
+	"\x05width\x18\x01 \x01(\rR\x05width\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\rR\x06height\"u\n" +
+	"\fExecResponse\x12\x18\n" +
+	"\x06stdout\x18\x01 \x01(\fH\x00R\x06stdout\x12\x18\n" +
+	"\x06stderr\x18\x02 \x01(\fH\x00R\x06stderr\x12&\n" +
+	"\x04exit\x18\x03 \x01(\v2\x10.weaver.ExecExitH\x00R\x04exitB\t\n" +
+	"\apayload\"'\n" +
+	"\bExecExit\x12\x1b\n" +
+	"\texit_code\x18\x01 \x01(\x05R\bexitCode\"g\n" +
+	"\x12PortForwardRequest\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x18.weaver.PortForwardStartH\x00R\x05start\x12\x14\n" +
+	"\x04data\x18\x02 \x01(\fH\x00R\x04dataB\t\n" +
+	"\apayload\"6\n" +
+	"\x10PortForwardStart\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\rR\x04port\")\n" +
+	"\x13PortForwardResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"=\n" +
+	"\x15DeleteWorkloadRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"\xe9\x02\n" +
+	"\x16CreateNamespaceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12)\n" +
+	"\x04spec\x18\x02 \x01(\v2\x15.weaver.NamespaceSpecR\x04spec\x12B\n" +
+	"\x06labels\x18\x03 \x03(\v2*.weaver.CreateNamespaceRequest.LabelsEntryR\x06labels\x12Q\n" +
+	"\vannotations\x18\x04 \x03(\v2/.weaver.CreateNamespaceRequest.AnnotationsEntryR\vannotations\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"J\n" +
+	"\x17CreateNamespaceResponse\x12/\n" +
+	"\tnamespace\x18\x01 \x01(\v2\x11.weaver.NamespaceR\tnamespace\")\n" +
+	"\x13GetNamespaceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"G\n" +
+	"\x14GetNamespaceResponse\x12/\n" +
+	"\tnamespace\x18\x01 \x01(\v2\x11.weaver.NamespaceR\tnamespace\"\xb2\x01\n" +
+	"\x15ListNamespacesRequest\x12W\n" +
+	"\x0elabel_selector\x18\x01 \x03(\v20.weaver.ListNamespacesRequest.LabelSelectorEntryR\rlabelSelector\x1a@\n" +
+	"\x12LabelSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"K\n" +
+	"\x16ListNamespacesResponse\x121\n" +
+	"\n" +
+	"namespaces\x18\x01 \x03(\v2\x11.weaver.NamespaceR\n" +
+	"namespaces\"\xe9\x02\n" +
+	"\x16UpdateNamespaceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12)\n" +
+	"\x04spec\x18\x02 \x01(\v2\x15.weaver.NamespaceSpecR\x04spec\x12B\n" +
+	"\x06labels\x18\x03 \x03(\v2*.weaver.UpdateNamespaceRequest.LabelsEntryR\x06labels\x12Q\n" +
+	"\vannotations\x18\x04 \x03(\v2/.weaver.UpdateNamespaceRequest.AnnotationsEntryR\vannotations\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"J\n" +
+	"\x17UpdateNamespaceResponse\x12/\n" +
+	"\tnamespace\x18\x01 \x01(\v2\x11.weaver.NamespaceR\tnamespace\",\n" +
+	"\x16DeleteNamespaceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xf9\x03\n" +
+	"\tNamespace\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x125\n" +
+	"\x06labels\x18\x03 \x03(\v2\x1d.weaver.Namespace.LabelsEntryR\x06labels\x12D\n" +
+	"\vannotations\x18\x04 \x03(\v2\".weaver.Namespace.AnnotationsEntryR\vannotations\x12)\n" +
+	"\x04spec\x18\x05 \x01(\v2\x15.weaver.NamespaceSpecR\x04spec\x12/\n" +
+	"\x06status\x18\x06 \x01(\v2\x17.weaver.NamespaceStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc1\x01\n" +
+	"\rNamespaceSpec\x12.\n" +
+	"\x06quotas\x18\x01 \x01(\v2\x16.weaver.ResourceQuotasR\x06quotas\x12<\n" +
+	"\x0enetwork_policy\x18\x02 \x01(\v2\x15.weaver.NetworkPolicyR\rnetworkPolicy\x12B\n" +
+	"\x11default_placement\x18\x03 \x01(\v2\x15.weaver.PlacementSpecR\x10defaultPlacement\"\xa7\x01\n" +
+	"\x0eResourceQuotas\x12#\n" +
+	"\rmax_workloads\x18\x01 \x01(\x05R\fmaxWorkloads\x12\x17\n" +
+	"\amax_cpu\x18\x02 \x01(\tR\x06maxCpu\x12\x1d\n" +
+	"\n" +
+	"max_memory\x18\x03 \x01(\tR\tmaxMemory\x12\x17\n" +
+	"\amax_gpu\x18\x04 \x01(\tR\x06maxGpu\x12\x1f\n" +
+	"\vmax_storage\x18\x05 \x01(\tR\n" +
+	"maxStorage\"\x89\x01\n" +
+	"\rNetworkPolicy\x12\x1c\n" +
+	"\tisolation\x18\x01 \x01(\tR\tisolation\x12-\n" +
+	"\aingress\x18\x02 \x03(\v2\x13.weaver.NetworkRuleR\aingress\x12+\n" +
+	"\x06egress\x18\x03 \x03(\v2\x13.weaver.NetworkRuleR\x06egress\"\x9d\x01\n" +
+	"\vNetworkRule\x12'\n" +
+	"\x04from\x18\x01 \x03(\v2\x13.weaver.NetworkPeerR\x04from\x12#\n" +
+	"\x02to\x18\x02 \x03(\v2\x13.weaver.NetworkPeerR\x02to\x12\"\n" +
+	"\x05ports\x18\x03 \x03(\v2\f.weaver.PortR\x05ports\x12\x1c\n" +
+	"\tprotocols\x18\x04 \x03(\tR\tprotocols\"\xf7\x02\n" +
+	"\vNetworkPeer\x12Y\n" +
+	"\x12namespace_selector\x18\x01 \x03(\v2*.weaver.NetworkPeer.NamespaceSelectorEntryR\x11namespaceSelector\x12V\n" +
+	"\x11workload_selector\x18\x02 \x03(\v2).weaver.NetworkPeer.WorkloadSelectorEntryR\x10workloadSelector\x12*\n" +
+	"\bip_block\x18\x03 \x01(\v2\x0f.weaver.IPBlockR\aipBlock\x1aD\n" +
+	"\x16NamespaceSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aC\n" +
+	"\x15WorkloadSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"5\n" +
+	"\aIPBlock\x12\x12\n" +
+	"\x04cidr\x18\x01 \x01(\tR\x04cidr\x12\x16\n" +
+	"\x06except\x18\x02 \x03(\tR\x06except\"\xab\x01\n" +
+	"\x0fNamespaceStatus\x12\x14\n" +
+	"\x05phase\x18\x01 \x01(\tR\x05phase\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12+\n" +
+	"\x05usage\x18\x04 \x01(\v2\x15.weaver.ResourceUsageR\x05usage\x12#\n" +
+	"\rtailscale_tag\x18\x05 \x01(\tR\ftailscaleTag\"\x83\x01\n" +
+	"\rResourceUsage\x12\x1c\n" +
+	"\tworkloads\x18\x01 \x01(\x05R\tworkloads\x12\x10\n" +
+	"\x03cpu\x18\x02 \x01(\tR\x03cpu\x12\x16\n" +
+	"\x06memory\x18\x03 \x01(\tR\x06memory\x12\x10\n" +
+	"\x03gpu\x18\x04 \x01(\tR\x03gpu\x12\x18\n" +
+	"\astorage\x18\x05 \x01(\tR\astorage\"\x8d\x02\n" +
+	"\x13CreateSecretRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x129\n" +
+	"\x04data\x18\x04 \x03(\v2%.weaver.CreateSecretRequest.DataEntryR\x04data\x12<\n" +
+	"\fexternal_ref\x18\x05 \x01(\v2\x19.weaver.ExternalSecretRefR\vexternalRef\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\">\n" +
+	"\x14CreateSecretResponse\x12&\n" +
+	"\x06secret\x18\x01 \x01(\v2\x0e.weaver.SecretR\x06secret\"\\\n" +
+	"\x10GetSecretRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06reveal\x18\x03 \x01(\bR\x06reveal\";\n" +
+	"\x11GetSecretResponse\x12&\n" +
+	"\x06secret\x18\x01 \x01(\v2\x0e.weaver.SecretR\x06secret\"^\n" +
+	"\x12ListSecretsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06reveal\x18\x03 \x01(\bR\x06reveal\"?\n" +
+	"\x13ListSecretsResponse\x12(\n" +
+	"\asecrets\x18\x01 \x03(\v2\x0e.weaver.SecretR\asecrets\"\x8d\x02\n" +
+	"\x13UpdateSecretRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x129\n" +
+	"\x04data\x18\x04 \x03(\v2%.weaver.UpdateSecretRequest.DataEntryR\x04data\x12<\n" +
+	"\fexternal_ref\x18\x05 \x01(\v2\x19.weaver.ExternalSecretRefR\vexternalRef\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\">\n" +
+	"\x14UpdateSecretResponse\x12&\n" +
+	"\x06secret\x18\x01 \x01(\v2\x0e.weaver.SecretR\x06secret\"G\n" +
+	"\x13DeleteSecretRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"E\n" +
+	"\x11SyncSecretRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"<\n" +
+	"\x12SyncSecretResponse\x12&\n" +
+	"\x06secret\x18\x01 \x01(\v2\x0e.weaver.SecretR\x06secret\"\xd7\x03\n" +
+	"\x06Secret\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x12\n" +
+	"\x04keys\x18\x05 \x03(\tR\x04keys\x12,\n" +
+	"\x04data\x18\x06 \x03(\v2\x18.weaver.Secret.DataEntryR\x04data\x12<\n" +
+	"\fexternal_ref\x18\a \x01(\v2\x19.weaver.ExternalSecretRefR\vexternalRef\x12,\n" +
+	"\x06status\x18\b \x01(\v2\x14.weaver.SecretStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\bredacted\x18\v \x01(\bR\bredacted\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\xcf\x01\n" +
+	"\x11ExternalSecretRef\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x127\n" +
+	"\x04auth\x18\x04 \x03(\v2#.weaver.ExternalSecretRef.AuthEntryR\x04auth\x1a7\n" +
+	"\tAuthEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd1\x01\n" +
+	"\fSecretStatus\x12\x14\n" +
+	"\x05phase\x18\x01 \x01(\tR\x05phase\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x127\n" +
+	"\tlast_sync\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\blastSync\x12\x1d\n" +
+	"\n" +
+	"sync_error\x18\x05 \x01(\tR\tsyncError\x12!\n" +
+	"\fsync_version\x18\x06 \x01(\tR\vsyncVersion\"\x93\x03\n" +
+	"\x19CreateCronWorkloadRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12,\n" +
+	"\x04spec\x18\x03 \x01(\v2\x18.weaver.CronWorkloadSpecR\x04spec\x12E\n" +
+	"\x06labels\x18\x04 \x03(\v2-.weaver.CreateCronWorkloadRequest.LabelsEntryR\x06labels\x12T\n" +
+	"\vannotations\x18\x05 \x03(\v22.weaver.CreateCronWorkloadRequest.AnnotationsEntryR\vannotations\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"W\n" +
+	"\x1aCreateCronWorkloadResponse\x129\n" +
+	"\rcron_workload\x18\x01 \x01(\v2\x14.weaver.CronWorkloadR\fcronWorkload\"J\n" +
+	"\x16GetCronWorkloadRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"T\n" +
+	"\x17GetCronWorkloadResponse\x129\n" +
+	"\rcron_workload\x18\x01 \x01(\v2\x14.weaver.CronWorkloadR\fcronWorkload\"\xd6\x01\n" +
+	"\x18ListCronWorkloadsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12Z\n" +
+	"\x0elabel_selector\x18\x02 \x03(\v23.weaver.ListCronWorkloadsRequest.LabelSelectorEntryR\rlabelSelector\x1a@\n" +
+	"\x12LabelSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"X\n" +
+	"\x19ListCronWorkloadsResponse\x12;\n" +
+	"\x0ecron_workloads\x18\x01 \x03(\v2\x14.weaver.CronWorkloadR\rcronWorkloads\"\x93\x03\n" +
+	"\x19UpdateCronWorkloadRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12,\n" +
+	"\x04spec\x18\x03 \x01(\v2\x18.weaver.CronWorkloadSpecR\x04spec\x12E\n" +
+	"\x06labels\x18\x04 \x03(\v2-.weaver.UpdateCronWorkloadRequest.LabelsEntryR\x06labels\x12T\n" +
+	"\vannotations\x18\x05 \x03(\v22.weaver.UpdateCronWorkloadRequest.AnnotationsEntryR\vannotations\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"W\n" +
+	"\x1aUpdateCronWorkloadResponse\x129\n" +
+	"\rcron_workload\x18\x01 \x01(\v2\x14.weaver.CronWorkloadR\fcronWorkload\"M\n" +
+	"\x19DeleteCronWorkloadRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xa6\x04\n" +
+	"\fCronWorkload\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x128\n" +
+	"\x06labels\x18\x04 \x03(\v2 .weaver.CronWorkload.LabelsEntryR\x06labels\x12G\n" +
+	"\vannotations\x18\x05 \x03(\v2%.weaver.CronWorkload.AnnotationsEntryR\vannotations\x12,\n" +
+	"\x04spec\x18\x06 \x01(\v2\x18.weaver.CronWorkloadSpecR\x04spec\x122\n" +
+	"\x06status\x18\a \x01(\v2\x1a.weaver.CronWorkloadStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf1\x03\n" +
+	"\x10CronWorkloadSpec\x12\x1a\n" +
+	"\bschedule\x18\x01 \x01(\tR\bschedule\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\x124\n" +
+	"\btemplate\x18\x03 \x01(\v2\x18.weaver.WorkloadTemplateR\btemplate\x12-\n" +
+	"\x12concurrency_policy\x18\x04 \x01(\tR\x11concurrencyPolicy\x12?\n" +
+	"\x19starting_deadline_seconds\x18\x05 \x01(\x03H\x00R\x17startingDeadlineSeconds\x88\x01\x01\x12F\n" +
+	"\x1dsuccessful_runs_history_limit\x18\x06 \x01(\x05H\x01R\x1asuccessfulRunsHistoryLimit\x88\x01\x01\x12>\n" +
+	"\x19failed_runs_history_limit\x18\a \x01(\x05H\x02R\x16failedRunsHistoryLimit\x88\x01\x01\x12\x18\n" +
+	"\asuspend\x18\b \x01(\bR\asuspendB\x1c\n" +
+	"\x1a_starting_deadline_secondsB \n" +
+	"\x1e_successful_runs_history_limitB\x1c\n" +
+	"\x1a_failed_runs_history_limit\"\xc2\x02\n" +
+	"\x10WorkloadTemplate\x12<\n" +
+	"\x06labels\x18\x01 \x03(\v2$.weaver.WorkloadTemplate.LabelsEntryR\x06labels\x12K\n" +
+	"\vannotations\x18\x02 \x03(\v2).weaver.WorkloadTemplate.AnnotationsEntryR\vannotations\x12(\n" +
+	"\x04spec\x18\x03 \x01(\v2\x14.weaver.WorkloadSpecR\x04spec\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8e\x02\n" +
+	"\x12CronWorkloadStatus\x12\x16\n" +
+	"\x06active\x18\x01 \x03(\tR\x06active\x12H\n" +
+	"\x12last_schedule_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10lastScheduleTime\x12L\n" +
+	"\x14last_successful_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x12lastSuccessfulTime\x12H\n" +
+	"\x12next_schedule_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x10nextScheduleTime\"5\n" +
+	"\x15ListProvidersResponse\x12\x1c\n" +
+	"\tproviders\x18\x01 \x03(\tR\tproviders\"7\n" +
+	"\x19GetProviderRegionsRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\"6\n" +
+	"\x1aGetProviderRegionsResponse\x12\x18\n" +
+	"\aregions\x18\x01 \x03(\tR\aregions\"T\n" +
+	"\x1eGetProviderMachineTypesRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\"[\n" +
+	"\x1fGetProviderMachineTypesResponse\x128\n" +
+	"\rmachine_types\x18\x01 \x03(\v2\x13.weaver.MachineTypeR\fmachineTypes\"\x83\x01\n" +
+	"\vMachineType\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03cpu\x18\x02 \x01(\tR\x03cpu\x12\x16\n" +
+	"\x06memory\x18\x03 \x01(\tR\x06memory\x12\x10\n" +
+	"\x03gpu\x18\x04 \x01(\tR\x03gpu\x12$\n" +
+	"\x0eprice_per_hour\x18\x05 \x01(\x01R\fpricePerHour\"\xb1\x01\n" +
+	"\x1aGetSchedulerStatusResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12'\n" +
+	"\x0fproviders_count\x18\x02 \x01(\x05R\x0eprovidersCount\x12)\n" +
+	"\x10scheduler_status\x18\x03 \x01(\tR\x0fschedulerStatus\x12'\n" +
+	"\x0fscheduler_error\x18\x04 \x01(\tR\x0eschedulerError\"\x83\x01\n" +
+	"\x17ScheduleWorkloadRequest\x12(\n" +
+	"\x04spec\x18\x01 \x01(\v2\x14.weaver.WorkloadSpecR\x04spec\x12>\n" +
+	"\vconstraints\x18\x02 \x01(\v2\x1c.weaver.PlacementConstraintsR\vconstraints\"\xa2\x01\n" +
+	"\x18ScheduleWorkloadResponse\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x12\n" +
+	"\x04zone\x18\x03 \x01(\tR\x04zone\x12\x17\n" +
+	"\anode_id\x18\x04 \x01(\tR\x06nodeId\x12%\n" +
+	"\x0eestimated_cost\x18\x05 \x01(\x01R\restimatedCost\"\x85\x01\n" +
+	"\x19GetRecommendationsRequest\x12(\n" +
+	"\x04spec\x18\x01 \x01(\v2\x14.weaver.WorkloadSpecR\x04spec\x12>\n" +
+	"\vconstraints\x18\x02 \x01(\v2\x1c.weaver.PlacementConstraintsR\vconstraints\"f\n" +
+	"\x1aGetRecommendationsResponse\x12H\n" +
+	"\x0frecommendations\x18\x01 \x03(\v2\x1e.weaver.ScheduleRecommendationR\x0frecommendations\"\xc9\x01\n" +
+	"\x16ScheduleRecommendation\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x12\n" +
+	"\x04zone\x18\x03 \x01(\tR\x04zone\x12\"\n" +
+	"\rcost_per_hour\x18\x04 \x01(\x01R\vcostPerHour\x12+\n" +
+	"\x11performance_score\x18\x05 \x01(\x01R\x10performanceScore\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"e\n" +
+	"\x18ExplainSchedulingRequest\x12\x1f\n" +
+	"\vworkload_id\x18\x01 \x01(\tR\n" +
+	"workloadId\x12(\n" +
+	"\x04spec\x18\x02 \x01(\v2\x14.weaver.WorkloadSpecR\x04spec\"\xde\x01\n" +
+	"\x19ExplainSchedulingResponse\x12;\n" +
+	"\n" +
+	"candidates\x18\x01 \x03(\v2\x1b.weaver.SchedulingCandidateR\n" +
+	"candidates\x12+\n" +
+	"\x11selected_provider\x18\x02 \x01(\tR\x10selectedProvider\x12'\n" +
+	"\x0fselected_region\x18\x03 \x01(\tR\x0eselectedRegion\x12.\n" +
+	"\x06status\x18\x04 \x01(\v2\x16.weaver.WorkloadStatusR\x06status\"\x98\x03\n" +
+	"\x13SchedulingCandidate\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12!\n" +
+	"\fmachine_type\x18\x03 \x01(\tR\vmachineType\x12\x17\n" +
+	"\anode_id\x18\x04 \x01(\tR\x06nodeId\x12\x1a\n" +
+	"\bfeasible\x18\x05 \x01(\bR\bfeasible\x12\x1f\n" +
+	"\vfiltered_by\x18\x06 \x01(\tR\n" +
+	"filteredBy\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x14\n" +
+	"\x05score\x18\b \x01(\x01R\x05score\x12.\n" +
+	"\x06scores\x18\t \x03(\v2\x16.weaver.ScoreComponentR\x06scores\x12\"\n" +
+	"\rcost_per_hour\x18\n" +
+	" \x01(\x01R\vcostPerHour\x12'\n" +
+	"\x0fcost_confidence\x18\v \x01(\x01R\x0ecostConfidence\x12)\n" +
+	"\x10cost_assumptions\x18\f \x03(\tR\x0fcostAssumptions\"n\n" +
+	"\x0eScoreComponent\x12\x16\n" +
+	"\x06plugin\x18\x01 \x01(\tR\x06plugin\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\":\n" +
+	"\x1aListSchedulingQueueRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"S\n" +
+	"\x1bListSchedulingQueueResponse\x124\n" +
+	"\tworkloads\x18\x01 \x03(\v2\x16.weaver.QueuedWorkloadR\tworkloads\"\xdc\x02\n" +
+	"\x0eQueuedWorkload\x12\x1f\n" +
+	"\vworkload_id\x18\x01 \x01(\tR\n" +
+	"workloadId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\x05R\bpriority\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\x12\x1f\n" +
+	"\vage_seconds\x18\x06 \x01(\x03R\n" +
+	"ageSeconds\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12=\n" +
+	"\fnext_attempt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vnextAttempt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12$\n" +
+	"\runschedulable\x18\n" +
+	" \x01(\bR\runschedulable\"\xb0\x03\n" +
+	"\x19GetSchedulerStatsResponse\x12'\n" +
+	"\x0ftotal_workloads\x18\x01 \x01(\x05R\x0etotalWorkloads\x12+\n" +
+	"\x11running_workloads\x18\x02 \x01(\x05R\x10runningWorkloads\x12+\n" +
+	"\x11pending_workloads\x18\x03 \x01(\x05R\x10pendingWorkloads\x12)\n" +
+	"\x10failed_workloads\x18\x04 \x01(\x05R\x0ffailedWorkloads\x12n\n" +
+	"\x15workloads_by_provider\x18\x05 \x03(\v2:.weaver.GetSchedulerStatsResponse.WorkloadsByProviderEntryR\x13workloadsByProvider\x12-\n" +
+	"\x13total_cost_per_hour\x18\x06 \x01(\x01R\x10totalCostPerHour\x1aF\n" +
+	"\x18WorkloadsByProviderEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xcd\x02\n" +
+	"\x14PlacementConstraints\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x12\n" +
+	"\x04zone\x18\x03 \x01(\tR\x04zone\x12M\n" +
+	"\vnode_labels\x18\x04 \x03(\v2,.weaver.PlacementConstraints.NodeLabelsEntryR\n" +
+	"nodeLabels\x124\n" +
+	"\vtolerations\x18\x05 \x03(\v2\x12.weaver.TolerationR\vtolerations\x12)\n" +
+	"\x11max_cost_per_hour\x18\x06 \x01(\x01R\x0emaxCostPerHour\x1a=\n" +
+	"\x0fNodeLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"g\n" +
+	"\x12CreateTokenRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tprincipal\x18\x02 \x01(\tR\tprincipal\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x03R\n" +
+	"ttlSeconds\"R\n" +
+	"\x13CreateTokenResponse\x12#\n" +
+	"\x05token\x18\x01 \x01(\v2\r.weaver.TokenR\x05token\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"1\n" +
+	"\x11ListTokensRequest\x12\x1c\n" +
+	"\tprincipal\x18\x01 \x01(\tR\tprincipal\";\n" +
+	"\x12ListTokensResponse\x12%\n" +
+	"\x06tokens\x18\x01 \x03(\v2\r.weaver.TokenR\x06tokens\"$\n" +
+	"\x12RevokeTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xfa\x01\n" +
+	"\x05Token\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tprincipal\x18\x03 \x01(\tR\tprincipal\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\"j\n" +
+	"\x18CreateRoleBindingRequest\x12\x1c\n" +
+	"\tprincipal\x18\x01 \x01(\tR\tprincipal\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"S\n" +
+	"\x19CreateRoleBindingResponse\x126\n" +
+	"\frole_binding\x18\x01 \x01(\v2\x13.weaver.RoleBindingR\vroleBinding\"U\n" +
+	"\x17ListRoleBindingsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1c\n" +
+	"\tprincipal\x18\x02 \x01(\tR\tprincipal\"T\n" +
+	"\x18ListRoleBindingsResponse\x128\n" +
+	"\rrole_bindings\x18\x01 \x03(\v2\x13.weaver.RoleBindingR\froleBindings\"*\n" +
+	"\x18DeleteRoleBindingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa8\x01\n" +
+	"\vRoleBinding\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tprincipal\x18\x02 \x01(\tR\tprincipal\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x81\x01\n" +
+	"\x13HealthCheckResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\aservice\x18\x02 \x01(\tR\aservice\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x9b\x03\n" +
+	"\x13RegisterNodeRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x12\n" +
+	"\x04zone\x18\x04 \x01(\tR\x04zone\x12?\n" +
+	"\x06labels\x18\x05 \x03(\v2'.weaver.RegisterNodeRequest.LabelsEntryR\x06labels\x121\n" +
+	"\bcapacity\x18\x06 \x01(\v2\x15.weaver.NodeResourcesR\bcapacity\x12\x18\n" +
+	"\aaddress\x18\a \x01(\tR\aaddress\x12\x18\n" +
+	"\aversion\x18\b \x01(\tR\aversion\x12)\n" +
+	"\x06taints\x18\t \x03(\v2\x11.weaver.NodeTaintR\x06taints\x12\x1d\n" +
+	"\n" +
+	"agent_port\x18\n" +
+	" \x01(\x05R\tagentPort\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"m\n" +
+	"\x14RegisterNodeResponse\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12<\n" +
+	"\x1aheartbeat_interval_seconds\x18\x02 \x01(\x05R\x18heartbeatIntervalSeconds\"0\n" +
+	"\x15UnregisterNodeRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\"\xc7\x01\n" +
+	"\x10HeartbeatRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12%\n" +
+	"\x0eworkload_count\x18\x03 \x01(\x05R\rworkloadCount\x12!\n" +
+	"\fworkload_ids\x18\x04 \x03(\tR\vworkloadIds\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"c\n" +
+	"\x11HeartbeatResponse\x12\x1e\n" +
+	"\n" +
+	"registered\x18\x01 \x01(\bR\n" +
+	"registered\x12.\n" +
+	"\x13fenced_workload_ids\x18\x02 \x03(\tR\x11fencedWorkloadIds\"2\n" +
+	"\x17WatchAssignmentsRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\"\x89\x01\n" +
+	"\x13WorkloadAssignments\x128\n" +
+	"\tworkloads\x18\x01 \x03(\v2\x1a.weaver.WorkloadAssignmentR\tworkloads\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xbf\x02\n" +
+	"\x12WorkloadAssignment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12(\n" +
+	"\x04spec\x18\x04 \x01(\v2\x14.weaver.WorkloadSpecR\x04spec\x12H\n" +
+	"\n" +
+	"secret_env\x18\x05 \x03(\v2).weaver.WorkloadAssignment.SecretEnvEntryR\tsecretEnv\x125\n" +
+	"\fsecret_files\x18\x06 \x03(\v2\x12.weaver.SecretFileR\vsecretFiles\x1a<\n" +
+	"\x0eSecretEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"6\n" +
+	"\n" +
+	"SecretFile\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\"\xc0\x02\n" +
+	"\x1bReportWorkloadStatusRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1f\n" +
+	"\vworkload_id\x18\x02 \x01(\tR\n" +
+	"workloadId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12!\n" +
+	"\fcontainer_id\x18\x04 \x01(\tR\vcontainerId\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1b\n" +
+	"\texit_code\x18\x06 \x01(\x05R\bexitCode\x128\n" +
+	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12#\n" +
+	"\rrestart_count\x18\b \x01(\x05R\frestartCount\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\"K\n" +
+	"\tNodeTaint\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x16\n" +
+	"\x06effect\x18\x03 \x01(\tR\x06effect\"_\n" +
+	"\rNodeResources\x12\x10\n" +
+	"\x03cpu\x18\x01 \x01(\tR\x03cpu\x12\x16\n" +
+	"\x06memory\x18\x02 \x01(\tR\x06memory\x12\x10\n" +
+	"\x03gpu\x18\x03 \x01(\tR\x03gpu\x12\x12\n" +
+	"\x04disk\x18\x04 \x01(\tR\x04disk\"\xcb\x01\n" +
+	"\x10AgentLogsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tcontainer\x18\x03 \x01(\tR\tcontainer\x12\x16\n" +
+	"\x06follow\x18\x04 \x01(\bR\x06follow\x12\x1d\n" +
+	"\n" +
+	"tail_lines\x18\x05 \x01(\x03R\ttailLines\x120\n" +
+	"\x05since\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"s\n" +
+	"\x10AgentExecRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12-\n" +
+	"\arequest\x18\x03 \x01(\v2\x13.weaver.ExecRequestR\arequest\"\x81\x01\n" +
+	"\x17AgentPortForwardRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x124\n" +
+	"\arequest\x18\x03 \x01(\v2\x1a.weaver.PortForwardRequestR\arequest\"\xed\x04\n" +
+	"\bWorkload\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x124\n" +
+	"\x06labels\x18\x04 \x03(\v2\x1c.weaver.Workload.LabelsEntryR\x06labels\x12C\n" +
+	"\vannotations\x18\x05 \x03(\v2!.weaver.Workload.AnnotationsEntryR\vannotations\x12(\n" +
+	"\x04spec\x18\x06 \x01(\v2\x14.weaver.WorkloadSpecR\x04spec\x12.\n" +
+	"\x06status\x18\a \x01(\v2\x16.weaver.WorkloadStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1e\n" +
+	"\n" +
+	"finalizers\x18\v \x03(\tR\n" +
+	"finalizers\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xfd\x05\n" +
+	"\fWorkloadSpec\x12\x14\n" +
+	"\x05image\x18\x01 \x01(\tR\x05image\x12\x18\n" +
+	"\acommand\x18\x02 \x03(\tR\acommand\x12\x12\n" +
+	"\x04args\x18\x03 \x03(\tR\x04args\x12/\n" +
+	"\x03env\x18\x04 \x03(\v2\x1d.weaver.WorkloadSpec.EnvEntryR\x03env\x126\n" +
+	"\tresources\x18\x05 \x01(\v2\x18.weaver.ResourceRequestsR\tresources\x12-\n" +
+	"\avolumes\x18\x06 \x03(\v2\x13.weaver.VolumeMountR\avolumes\x12\"\n" +
+	"\x05ports\x18\a \x03(\v2\f.weaver.PortR\x05ports\x12/\n" +
+	"\bsidecars\x18\b \x03(\v2\x13.weaver.SidecarSpecR\bsidecars\x12%\n" +
+	"\x0erestart_policy\x18\t \x01(\tR\rrestartPolicy\x123\n" +
+	"\tplacement\x18\n" +
+	" \x01(\v2\x15.weaver.PlacementSpecR\tplacement\x12\x1a\n" +
+	"\bpriority\x18\v \x01(\x05R\bpriority\x120\n" +
+	"\benv_from\x18\f \x03(\v2\x15.weaver.EnvFromSourceR\aenvFrom\x12L\n" +
+	"\x0eenv_value_from\x18\r \x03(\v2&.weaver.WorkloadSpec.EnvValueFromEntryR\fenvValueFrom\x12\x12\n" +
+	"\x04kind\x18\x0e \x01(\tR\x04kind\x12!\n" +
+	"\x03job\x18\x0f \x01(\v2\x0f.weaver.JobSpecR\x03job\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aU\n" +
+	"\x11EnvValueFromEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.weaver.EnvVarSourceR\x05value:\x028\x01\"\xa2\x02\n" +
+	"\aJobSpec\x12 \n" +
+	"\vcompletions\x18\x01 \x01(\x05R\vcompletions\x12 \n" +
+	"\vparallelism\x18\x02 \x01(\x05R\vparallelism\x12(\n" +
+	"\rbackoff_limit\x18\x03 \x01(\x05H\x00R\fbackoffLimit\x88\x01\x01\x126\n" +
+	"\x17active_deadline_seconds\x18\x04 \x01(\x03R\x15activeDeadlineSeconds\x12@\n" +
+	"\x1attl_seconds_after_finished\x18\x05 \x01(\x05H\x01R\x17ttlSecondsAfterFinished\x88\x01\x01B\x10\n" +
+	"\x0e_backoff_limitB\x1d\n" +
+	"\x1b_ttl_seconds_after_finished\"7\n" +
+	"\x0fSecretReference\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"_\n" +
+	"\rEnvFromSource\x126\n" +
+	"\n" +
+	"secret_ref\x18\x01 \x01(\v2\x17.weaver.SecretReferenceR\tsecretRef\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\"M\n" +
+	"\fEnvVarSource\x12=\n" +
+	"\x0esecret_key_ref\x18\x01 \x01(\v2\x17.weaver.SecretReferenceR\fsecretKeyRef\"N\n" +
+	"\x10ResourceRequests\x12\x10\n" +
+	"\x03cpu\x18\x01 \x01(\tR\x03cpu\x12\x16\n" +
+	"\x06memory\x18\x02 \x01(\tR\x06memory\x12\x10\n" +
+	"\x03gpu\x18\x03 \x01(\tR\x03gpu\"\xc1\x01\n" +
+	"\vVolumeMount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"mount_path\x18\x02 \x01(\tR\tmountPath\x12\x1b\n" +
+	"\tread_only\x18\x03 \x01(\bR\breadOnly\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x04 \x01(\tR\tcontentId\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\tR\x04size\x12/\n" +
+	"\x06secret\x18\x06 \x01(\v2\x17.weaver.SecretReferenceR\x06secret\"]\n" +
+	"\x04Port\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0econtainer_port\x18\x02 \x01(\x05R\rcontainerPort\x12\x1a\n" +
+	"\bprotocol\x18\x03 \x01(\tR\bprotocol\"\xcd\x01\n" +
+	"\vSidecarSpec\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x12\x18\n" +
+	"\acommand\x18\x03 \x03(\tR\acommand\x12\x12\n" +
+	"\x04args\x18\x04 \x03(\tR\x04args\x12.\n" +
+	"\x03env\x18\x05 \x03(\v2\x1c.weaver.SidecarSpec.EnvEntryR\x03env\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x94\x02\n" +
+	"\rPlacementSpec\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x12\n" +
+	"\x04zone\x18\x03 \x01(\tR\x04zone\x12F\n" +
+	"\vnode_labels\x18\x04 \x03(\v2%.weaver.PlacementSpec.NodeLabelsEntryR\n" +
+	"nodeLabels\x124\n" +
+	"\vtolerations\x18\x05 \x03(\v2\x12.weaver.TolerationR\vtolerations\x1a=\n" +
+	"\x0fNodeLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"h\n" +
+	"\n" +
+	"Toleration\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x16\n" +
+	"\x06effect\x18\x04 \x01(\tR\x06effect\"\xd2\x04\n" +
+	"\x0eWorkloadStatus\x12\x14\n" +
+	"\x05phase\x18\x01 \x01(\tR\x05phase\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12;\n" +
+	"\vfinish_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishTime\x12#\n" +
+	"\rrestart_count\x18\x06 \x01(\x05R\frestartCount\x12\x17\n" +
+	"\anode_id\x18\a \x01(\tR\x06nodeId\x12\x1a\n" +
+	"\bprovider\x18\b \x01(\tR\bprovider\x12!\n" +
+	"\ftailscale_ip\x18\t \x01(\tR\vtailscaleIp\x12!\n" +
+	"\fcontainer_id\x18\n" +
+	" \x01(\tR\vcontainerId\x12\x1f\n" +
+	"\vsnapshot_id\x18\v \x01(\tR\n" +
+	"snapshotId\x12?\n" +
+	"\rlast_snapshot\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\flastSnapshot\x12<\n" +
+	"\fprovider_ref\x18\r \x01(\v2\x19.weaver.ProviderReferenceR\vproviderRef\x12\x1b\n" +
+	"\texit_code\x18\x0e \x01(\x05R\bexitCode\x12#\n" +
+	"\x03job\x18\x0f \x01(\v2\x11.weaver.JobStatusR\x03job\"\x90\x02\n" +
+	"\tJobStatus\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\x05R\x06active\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12C\n" +
+	"\x0fcompletion_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0ecompletionTime\x125\n" +
+	"\bretry_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aretryAt\"\xca\x01\n" +
+	"\x11ProviderReference\x12\x1f\n" +
+	"\vexternal_id\x18\x01 \x01(\tR\n" +
+	"externalId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12C\n" +
+	"\bmetadata\x18\x03 \x03(\v2'.weaver.ProviderReference.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xe1\x19\n" +
+	"\rWeaverService\x12O\n" +
+	"\x0eCreateWorkload\x12\x1d.weaver.CreateWorkloadRequest\x1a\x1e.weaver.CreateWorkloadResponse\x12F\n" +
+	"\vGetWorkload\x12\x1a.weaver.GetWorkloadRequest\x1a\x1b.weaver.GetWorkloadResponse\x12L\n" +
+	"\rListWorkloads\x12\x1c.weaver.ListWorkloadsRequest\x1a\x1d.weaver.ListWorkloadsResponse\x12O\n" +
+	"\x0eUpdateWorkload\x12\x1d.weaver.UpdateWorkloadRequest\x1a\x1e.weaver.UpdateWorkloadResponse\x12G\n" +
+	"\x0eDeleteWorkload\x12\x1d.weaver.DeleteWorkloadRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\x0eWatchWorkloads\x12\x1d.weaver.WatchWorkloadsRequest\x1a\x15.weaver.WorkloadEvent0\x01\x12S\n" +
+	"\x12StreamWorkloadLogs\x12!.weaver.StreamWorkloadLogsRequest\x1a\x18.weaver.WorkloadLogEntry0\x01\x12=\n" +
+	"\fExecWorkload\x12\x13.weaver.ExecRequest\x1a\x14.weaver.ExecResponse(\x010\x01\x12J\n" +
+	"\vPortForward\x12\x1a.weaver.PortForwardRequest\x1a\x1b.weaver.PortForwardResponse(\x010\x01\x12R\n" +
+	"\x0fCreateNamespace\x12\x1e.weaver.CreateNamespaceRequest\x1a\x1f.weaver.CreateNamespaceResponse\x12I\n" +
+	"\fGetNamespace\x12\x1b.weaver.GetNamespaceRequest\x1a\x1c.weaver.GetNamespaceResponse\x12O\n" +
+	"\x0eListNamespaces\x12\x1d.weaver.ListNamespacesRequest\x1a\x1e.weaver.ListNamespacesResponse\x12R\n" +
+	"\x0fUpdateNamespace\x12\x1e.weaver.UpdateNamespaceRequest\x1a\x1f.weaver.UpdateNamespaceResponse\x12I\n" +
+	"\x0fDeleteNamespace\x12\x1e.weaver.DeleteNamespaceRequest\x1a\x16.google.protobuf.Empty\x12I\n" +
+	"\fCreateSecret\x12\x1b.weaver.CreateSecretRequest\x1a\x1c.weaver.CreateSecretResponse\x12@\n" +
+	"\tGetSecret\x12\x18.weaver.GetSecretRequest\x1a\x19.weaver.GetSecretResponse\x12F\n" +
+	"\vListSecrets\x12\x1a.weaver.ListSecretsRequest\x1a\x1b.weaver.ListSecretsResponse\x12I\n" +
+	"\fUpdateSecret\x12\x1b.weaver.UpdateSecretRequest\x1a\x1c.weaver.UpdateSecretResponse\x12C\n" +
+	"\fDeleteSecret\x12\x1b.weaver.DeleteSecretRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\n" +
+	"SyncSecret\x12\x19.weaver.SyncSecretRequest\x1a\x1a.weaver.SyncSecretResponse\x12[\n" +
+	"\x12CreateCronWorkload\x12!.weaver.CreateCronWorkloadRequest\x1a\".weaver.CreateCronWorkloadResponse\x12R\n" +
+	"\x0fGetCronWorkload\x12\x1e.weaver.GetCronWorkloadRequest\x1a\x1f.weaver.GetCronWorkloadResponse\x12X\n" +
+	"\x11ListCronWorkloads\x12 .weaver.ListCronWorkloadsRequest\x1a!.weaver.ListCronWorkloadsResponse\x12[\n" +
+	"\x12UpdateCronWorkload\x12!.weaver.UpdateCronWorkloadRequest\x1a\".weaver.UpdateCronWorkloadResponse\x12O\n" +
+	"\x12DeleteCronWorkload\x12!.weaver.DeleteCronWorkloadRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\rListProviders\x12\x16.google.protobuf.Empty\x1a\x1d.weaver.ListProvidersResponse\x12[\n" +
+	"\x12GetProviderRegions\x12!.weaver.GetProviderRegionsRequest\x1a\".weaver.GetProviderRegionsResponse\x12j\n" +
+	"\x17GetProviderMachineTypes\x12&.weaver.GetProviderMachineTypesRequest\x1a'.weaver.GetProviderMachineTypesResponse\x12P\n" +
+	"\x12GetSchedulerStatus\x12\x16.google.protobuf.Empty\x1a\".weaver.GetSchedulerStatusResponse\x12U\n" +
+	"\x10ScheduleWorkload\x12\x1f.weaver.ScheduleWorkloadRequest\x1a .weaver.ScheduleWorkloadResponse\x12[\n" +
+	"\x12GetRecommendations\x12!.weaver.GetRecommendationsRequest\x1a\".weaver.GetRecommendationsResponse\x12X\n" +
+	"\x11ExplainScheduling\x12 .weaver.ExplainSchedulingRequest\x1a!.weaver.ExplainSchedulingResponse\x12^\n" +
+	"\x13ListSchedulingQueue\x12\".weaver.ListSchedulingQueueRequest\x1a#.weaver.ListSchedulingQueueResponse\x12N\n" +
+	"\x11GetSchedulerStats\x12\x16.google.protobuf.Empty\x1a!.weaver.GetSchedulerStatsResponse\x12F\n" +
+	"\vCreateToken\x12\x1a.weaver.CreateTokenRequest\x1a\x1b.weaver.CreateTokenResponse\x12C\n" +
+	"\n" +
+	"ListTokens\x12\x19.weaver.ListTokensRequest\x1a\x1a.weaver.ListTokensResponse\x12A\n" +
+	"\vRevokeToken\x12\x1a.weaver.RevokeTokenRequest\x1a\x16.google.protobuf.Empty\x12X\n" +
+	"\x11CreateRoleBinding\x12 .weaver.CreateRoleBindingRequest\x1a!.weaver.CreateRoleBindingResponse\x12U\n" +
+	"\x10ListRoleBindings\x12\x1f.weaver.ListRoleBindingsRequest\x1a .weaver.ListRoleBindingsResponse\x12M\n" +
+	"\x11DeleteRoleBinding\x12 .weaver.DeleteRoleBindingRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\vHealthCheck\x12\x16.google.protobuf.Empty\x1a\x1b.weaver.HealthCheckResponse2\x8c\x03\n" +
+	"\vNodeService\x12I\n" +
+	"\fRegisterNode\x12\x1b.weaver.RegisterNodeRequest\x1a\x1c.weaver.RegisterNodeResponse\x12G\n" +
+	"\x0eUnregisterNode\x12\x1d.weaver.UnregisterNodeRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\tHeartbeat\x12\x18.weaver.HeartbeatRequest\x1a\x19.weaver.HeartbeatResponse\x12R\n" +
+	"\x10WatchAssignments\x12\x1f.weaver.WatchAssignmentsRequest\x1a\x1b.weaver.WorkloadAssignments0\x01\x12S\n" +
+	"\x14ReportWorkloadStatus\x12#.weaver.ReportWorkloadStatusRequest\x1a\x16.google.protobuf.Empty2\xdf\x01\n" +
+	"\fAgentService\x12B\n" +
+	"\n" +
+	"StreamLogs\x12\x18.weaver.AgentLogsRequest\x1a\x18.weaver.WorkloadLogEntry0\x01\x12:\n" +
+	"\x04Exec\x12\x18.weaver.AgentExecRequest\x1a\x14.weaver.ExecResponse(\x010\x01\x12O\n" +
+	"\vPortForward\x12\x1f.weaver.AgentPortForwardRequest\x1a\x1b.weaver.PortForwardResponse(\x010\x01B5Z3github.com/codecflow/fabric/pkg/proto/weaver;weaverb\x06proto3"
+
+var (
+	file_weaver_proto_weaver_weaver_proto_rawDescOnce sync.Once
+	file_weaver_proto_weaver_weaver_proto_rawDescData []byte
+)
+
+func file_weaver_proto_weaver_weaver_proto_rawDescGZIP() []byte {
+	file_weaver_proto_weaver_weaver_proto_rawDescOnce.Do(func() {
+		file_weaver_proto_weaver_weaver_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_weaver_proto_weaver_weaver_proto_rawDesc), len(file_weaver_proto_weaver_weaver_proto_rawDesc)))
+	})
+	return file_weaver_proto_weaver_weaver_proto_rawDescData
+}
+
+var file_weaver_proto_weaver_weaver_proto_msgTypes = make([]protoimpl.MessageInfo, 169)
+var file_weaver_proto_weaver_weaver_proto_goTypes = []any{
+	(*CreateWorkloadRequest)(nil),           // 0: weaver.CreateWorkloadRequest
+	(*CreateWorkloadResponse)(nil),          // 1: weaver.CreateWorkloadResponse
+	(*GetWorkloadRequest)(nil),              // 2: weaver.GetWorkloadRequest
+	(*GetWorkloadResponse)(nil),             // 3: weaver.GetWorkloadResponse
+	(*ListWorkloadsRequest)(nil),            // 4: weaver.ListWorkloadsRequest
+	(*ListWorkloadsResponse)(nil),           // 5: weaver.ListWorkloadsResponse
+	(*UpdateWorkloadRequest)(nil),           // 6: weaver.UpdateWorkloadRequest
+	(*UpdateWorkloadResponse)(nil),          // 7: weaver.UpdateWorkloadResponse
+	(*WatchWorkloadsRequest)(nil),           // 8: weaver.WatchWorkloadsRequest
+	(*WorkloadEvent)(nil),                   // 9: weaver.WorkloadEvent
+	(*StreamWorkloadLogsRequest)(nil),       // 10: weaver.StreamWorkloadLogsRequest
+	(*WorkloadLogEntry)(nil),                // 11: weaver.WorkloadLogEntry
+	(*ExecRequest)(nil),                     // 12: weaver.ExecRequest
+	(*ExecStart)(nil),                       // 13: weaver.ExecStart
+	(*TerminalSize)(nil),                    // 14: weaver.TerminalSize
+	(*ExecResponse)(nil),                    // 15: weaver.ExecResponse
+	(*ExecExit)(nil),                        // 16: weaver.ExecExit
+	(*PortForwardRequest)(nil),              // 17: weaver.PortForwardRequest
+	(*PortForwardStart)(nil),                // 18: weaver.PortForwardStart
+	(*PortForwardResponse)(nil),             // 19: weaver.PortForwardResponse
+	(*DeleteWorkloadRequest)(nil),           // 20: weaver.DeleteWorkloadRequest
+	(*CreateNamespaceRequest)(nil),          // 21: weaver.CreateNamespaceRequest
+	(*CreateNamespaceResponse)(nil),         // 22: weaver.CreateNamespaceResponse
+	(*GetNamespaceRequest)(nil),             // 23: weaver.GetNamespaceRequest
+	(*GetNamespaceResponse)(nil),            // 24: weaver.GetNamespaceResponse
+	(*ListNamespacesRequest)(nil),           // 25: weaver.ListNamespacesRequest
+	(*ListNamespacesResponse)(nil),          // 26: weaver.ListNamespacesResponse
+	(*UpdateNamespaceRequest)(nil),          // 27: weaver.UpdateNamespaceRequest
+	(*UpdateNamespaceResponse)(nil),         // 28: weaver.UpdateNamespaceResponse
+	(*DeleteNamespaceRequest)(nil),          // 29: weaver.DeleteNamespaceRequest
+	(*Namespace)(nil),                       // 30: weaver.Namespace
+	(*NamespaceSpec)(nil),                   // 31: weaver.NamespaceSpec
+	(*ResourceQuotas)(nil),                  // 32: weaver.ResourceQuotas
+	(*NetworkPolicy)(nil),                   // 33: weaver.NetworkPolicy
+	(*NetworkRule)(nil),                     // 34: weaver.NetworkRule
+	(*NetworkPeer)(nil),                     // 35: weaver.NetworkPeer
+	(*IPBlock)(nil),                         // 36: weaver.IPBlock
+	(*NamespaceStatus)(nil),                 // 37: weaver.NamespaceStatus
+	(*ResourceUsage)(nil),                   // 38: weaver.ResourceUsage
+	(*CreateSecretRequest)(nil),             // 39: weaver.CreateSecretRequest
+	(*CreateSecretResponse)(nil),            // 40: weaver.CreateSecretResponse
+	(*GetSecretRequest)(nil),                // 41: weaver.GetSecretRequest
+	(*GetSecretResponse)(nil),               // 42: weaver.GetSecretResponse
+	(*ListSecretsRequest)(nil),              // 43: weaver.ListSecretsRequest
+	(*ListSecretsResponse)(nil),             // 44: weaver.ListSecretsResponse
+	(*UpdateSecretRequest)(nil),             // 45: weaver.UpdateSecretRequest
+	(*UpdateSecretResponse)(nil),            // 46: weaver.UpdateSecretResponse
+	(*DeleteSecretRequest)(nil),             // 47: weaver.DeleteSecretRequest
+	(*SyncSecretRequest)(nil),               // 48: weaver.SyncSecretRequest
+	(*SyncSecretResponse)(nil),              // 49: weaver.SyncSecretResponse
+	(*Secret)(nil),                          // 50: weaver.Secret
+	(*ExternalSecretRef)(nil),               // 51: weaver.ExternalSecretRef
+	(*SecretStatus)(nil),                    // 52: weaver.SecretStatus
+	(*CreateCronWorkloadRequest)(nil),       // 53: weaver.CreateCronWorkloadRequest
+	(*CreateCronWorkloadResponse)(nil),      // 54: weaver.CreateCronWorkloadResponse
+	(*GetCronWorkloadRequest)(nil),          // 55: weaver.GetCronWorkloadRequest
+	(*GetCronWorkloadResponse)(nil),         // 56: weaver.GetCronWorkloadResponse
+	(*ListCronWorkloadsRequest)(nil),        // 57: weaver.ListCronWorkloadsRequest
+	(*ListCronWorkloadsResponse)(nil),       // 58: weaver.ListCronWorkloadsResponse
+	(*UpdateCronWorkloadRequest)(nil),       // 59: weaver.UpdateCronWorkloadRequest
+	(*UpdateCronWorkloadResponse)(nil),      // 60: weaver.UpdateCronWorkloadResponse
+	(*DeleteCronWorkloadRequest)(nil),       // 61: weaver.DeleteCronWorkloadRequest
+	(*CronWorkload)(nil),                    // 62: weaver.CronWorkload
+	(*CronWorkloadSpec)(nil),                // 63: weaver.CronWorkloadSpec
+	(*WorkloadTemplate)(nil),                // 64: weaver.WorkloadTemplate
+	(*CronWorkloadStatus)(nil),              // 65: weaver.CronWorkloadStatus
+	(*ListProvidersResponse)(nil),           // 66: weaver.ListProvidersResponse
+	(*GetProviderRegionsRequest)(nil),       // 67: weaver.GetProviderRegionsRequest
+	(*GetProviderRegionsResponse)(nil),      // 68: weaver.GetProviderRegionsResponse
+	(*GetProviderMachineTypesRequest)(nil),  // 69: weaver.GetProviderMachineTypesRequest
+	(*GetProviderMachineTypesResponse)(nil), // 70: weaver.GetProviderMachineTypesResponse
+	(*MachineType)(nil),                     // 71: weaver.MachineType
+	(*GetSchedulerStatusResponse)(nil),      // 72: weaver.GetSchedulerStatusResponse
+	(*ScheduleWorkloadRequest)(nil),         // 73: weaver.ScheduleWorkloadRequest
+	(*ScheduleWorkloadResponse)(nil),        // 74: weaver.ScheduleWorkloadResponse
+	(*GetRecommendationsRequest)(nil),       // 75: weaver.GetRecommendationsRequest
+	(*GetRecommendationsResponse)(nil),      // 76: weaver.GetRecommendationsResponse
+	(*ScheduleRecommendation)(nil),          // 77: weaver.ScheduleRecommendation
+	(*ExplainSchedulingRequest)(nil),        // 78: weaver.ExplainSchedulingRequest
+	(*ExplainSchedulingResponse)(nil),       // 79: weaver.ExplainSchedulingResponse
+	(*SchedulingCandidate)(nil),             // 80: weaver.SchedulingCandidate
+	(*ScoreComponent)(nil),                  // 81: weaver.ScoreComponent
+	(*ListSchedulingQueueRequest)(nil),      // 82: weaver.ListSchedulingQueueRequest
+	(*ListSchedulingQueueResponse)(nil),     // 83: weaver.ListSchedulingQueueResponse
+	(*QueuedWorkload)(nil),                  // 84: weaver.QueuedWorkload
+	(*GetSchedulerStatsResponse)(nil),       // 85: weaver.GetSchedulerStatsResponse
+	(*PlacementConstraints)(nil),            // 86: weaver.PlacementConstraints
+	(*CreateTokenRequest)(nil),              // 87: weaver.CreateTokenRequest
+	(*CreateTokenResponse)(nil),             // 88: weaver.CreateTokenResponse
+	(*ListTokensRequest)(nil),               // 89: weaver.ListTokensRequest
+	(*ListTokensResponse)(nil),              // 90: weaver.ListTokensResponse
+	(*RevokeTokenRequest)(nil),              // 91: weaver.RevokeTokenRequest
+	(*Token)(nil),                           // 92: weaver.Token
+	(*CreateRoleBindingRequest)(nil),        // 93: weaver.CreateRoleBindingRequest
+	(*CreateRoleBindingResponse)(nil),       // 94: weaver.CreateRoleBindingResponse
+	(*ListRoleBindingsRequest)(nil),         // 95: weaver.ListRoleBindingsRequest
+	(*ListRoleBindingsResponse)(nil),        // 96: weaver.ListRoleBindingsResponse
+	(*DeleteRoleBindingRequest)(nil),        // 97: weaver.DeleteRoleBindingRequest
+	(*RoleBinding)(nil),                     // 98: weaver.RoleBinding
+	(*HealthCheckResponse)(nil),             // 99: weaver.HealthCheckResponse
+	(*RegisterNodeRequest)(nil),             // 100: weaver.RegisterNodeRequest
+	(*RegisterNodeResponse)(nil),            // 101: weaver.RegisterNodeResponse
+	(*UnregisterNodeRequest)(nil),           // 102: weaver.UnregisterNodeRequest
+	(*HeartbeatRequest)(nil),                // 103: weaver.HeartbeatRequest
+	(*HeartbeatResponse)(nil),               // 104: weaver.HeartbeatResponse
+	(*WatchAssignmentsRequest)(nil),         // 105: weaver.WatchAssignmentsRequest
+	(*WorkloadAssignments)(nil),             // 106: weaver.WorkloadAssignments
+	(*WorkloadAssignment)(nil),              // 107: weaver.WorkloadAssignment
+	(*SecretFile)(nil),                      // 108: weaver.SecretFile
+	(*ReportWorkloadStatusRequest)(nil),     // 109: weaver.ReportWorkloadStatusRequest
+	(*NodeTaint)(nil),                       // 110: weaver.NodeTaint
+	(*NodeResources)(nil),                   // 111: weaver.NodeResources
+	(*AgentLogsRequest)(nil),                // 112: weaver.AgentLogsRequest
+	(*AgentExecRequest)(nil),                // 113: weaver.AgentExecRequest
+	(*AgentPortForwardRequest)(nil),         // 114: weaver.AgentPortForwardRequest
+	(*Workload)(nil),                        // 115: weaver.Workload
+	(*WorkloadSpec)(nil),                    // 116: weaver.WorkloadSpec
+	(*JobSpec)(nil),                         // 117: weaver.JobSpec
+	(*SecretReference)(nil),                 // 118: weaver.SecretReference
+	(*EnvFromSource)(nil),                   // 119: weaver.EnvFromSource
+	(*EnvVarSource)(nil),                    // 120: weaver.EnvVarSource
+	(*ResourceRequests)(nil),                // 121: weaver.ResourceRequests
+	(*VolumeMount)(nil),                     // 122: weaver.VolumeMount
+	(*Port)(nil),                            // 123: weaver.Port
+	(*SidecarSpec)(nil),                     // 124: weaver.SidecarSpec
+	(*PlacementSpec)(nil),                   // 125: weaver.PlacementSpec
+	(*Toleration)(nil),                      // 126: weaver.Toleration
+	(*WorkloadStatus)(nil),                  // 127: weaver.WorkloadStatus
+	(*JobStatus)(nil),                       // 128: weaver.JobStatus
+	(*ProviderReference)(nil),               // 129: weaver.ProviderReference
+	nil,                                     // 130: weaver.CreateWorkloadRequest.LabelsEntry
+	nil,                                     // 131: weaver.CreateWorkloadRequest.AnnotationsEntry
+	nil,                                     // 132: weaver.ListWorkloadsRequest.LabelSelectorEntry
+	nil,                                     // 133: weaver.UpdateWorkloadRequest.LabelsEntry
+	nil,                                     // 134: weaver.UpdateWorkloadRequest.AnnotationsEntry
+	nil,                                     // 135: weaver.WatchWorkloadsRequest.LabelSelectorEntry
+	nil,                                     // 136: weaver.CreateNamespaceRequest.LabelsEntry
+	nil,                                     // 137: weaver.CreateNamespaceRequest.AnnotationsEntry
+	nil,                                     // 138: weaver.ListNamespacesRequest.LabelSelectorEntry
+	nil,                                     // 139: weaver.UpdateNamespaceRequest.LabelsEntry
+	nil,                                     // 140: weaver.UpdateNamespaceRequest.AnnotationsEntry
+	nil,                                     // 141: weaver.Namespace.LabelsEntry
+	nil,                                     // 142: weaver.Namespace.AnnotationsEntry
+	nil,                                     // 143: weaver.NetworkPeer.NamespaceSelectorEntry
+	nil,                                     // 144: weaver.NetworkPeer.WorkloadSelectorEntry
+	nil,                                     // 145: weaver.CreateSecretRequest.DataEntry
+	nil,                                     // 146: weaver.UpdateSecretRequest.DataEntry
+	nil,                                     // 147: weaver.Secret.DataEntry
+	nil,                                     // 148: weaver.ExternalSecretRef.AuthEntry
+	nil,                                     // 149: weaver.CreateCronWorkloadRequest.LabelsEntry
+	nil,                                     // 150: weaver.CreateCronWorkloadRequest.AnnotationsEntry
+	nil,                                     // 151: weaver.ListCronWorkloadsRequest.LabelSelectorEntry
+	nil,                                     // 152: weaver.UpdateCronWorkloadRequest.LabelsEntry
+	nil,                                     // 153: weaver.UpdateCronWorkloadRequest.AnnotationsEntry
+	nil,                                     // 154: weaver.CronWorkload.LabelsEntry
+	nil,                                     // 155: weaver.CronWorkload.AnnotationsEntry
+	nil,                                     // 156: weaver.WorkloadTemplate.LabelsEntry
+	nil,                                     // 157: weaver.WorkloadTemplate.AnnotationsEntry
+	nil,                                     // 158: weaver.GetSchedulerStatsResponse.WorkloadsByProviderEntry
+	nil,                                     // 159: weaver.PlacementConstraints.NodeLabelsEntry
+	nil,                                     // 160: weaver.RegisterNodeRequest.LabelsEntry
+	nil,                                     // 161: weaver.WorkloadAssignment.SecretEnvEntry
+	nil,                                     // 162: weaver.Workload.LabelsEntry
+	nil,                                     // 163: weaver.Workload.AnnotationsEntry
+	nil,                                     // 164: weaver.WorkloadSpec.EnvEntry
+	nil,                                     // 165: weaver.WorkloadSpec.EnvValueFromEntry
+	nil,                                     // 166: weaver.SidecarSpec.EnvEntry
+	nil,                                     // 167: weaver.PlacementSpec.NodeLabelsEntry
+	nil,                                     // 168: weaver.ProviderReference.MetadataEntry
+	(*timestamppb.Timestamp)(nil),           // 169: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                   // 170: google.protobuf.Empty
+}
+var file_weaver_proto_weaver_weaver_proto_depIdxs = []int32{
+	116, // 0: weaver.CreateWorkloadRequest.spec:type_name -> weaver.WorkloadSpec
+	130, // 1: weaver.CreateWorkloadRequest.labels:type_name -> weaver.CreateWorkloadRequest.LabelsEntry
+	131, // 2: weaver.CreateWorkloadRequest.annotations:type_name -> weaver.CreateWorkloadRequest.AnnotationsEntry
+	127, // 3: weaver.CreateWorkloadResponse.status:type_name -> weaver.WorkloadStatus
+	169, // 4: weaver.CreateWorkloadResponse.created_at:type_name -> google.protobuf.Timestamp
+	115, // 5: weaver.GetWorkloadResponse.workload:type_name -> weaver.Workload
+	132, // 6: weaver.ListWorkloadsRequest.label_selector:type_name -> weaver.ListWorkloadsRequest.LabelSelectorEntry
+	115, // 7: weaver.ListWorkloadsResponse.workloads:type_name -> weaver.Workload
+	116, // 8: weaver.UpdateWorkloadRequest.spec:type_name -> weaver.WorkloadSpec
+	133, // 9: weaver.UpdateWorkloadRequest.labels:type_name -> weaver.UpdateWorkloadRequest.LabelsEntry
+	134, // 10: weaver.UpdateWorkloadRequest.annotations:type_name -> weaver.UpdateWorkloadRequest.AnnotationsEntry
+	115, // 11: weaver.UpdateWorkloadResponse.workload:type_name -> weaver.Workload
+	135, // 12: weaver.WatchWorkloadsRequest.label_selector:type_name -> weaver.WatchWorkloadsRequest.LabelSelectorEntry
+	115, // 13: weaver.WorkloadEvent.workload:type_name -> weaver.Workload
+	169, // 14: weaver.StreamWorkloadLogsRequest.since:type_name -> google.protobuf.Timestamp
+	169, // 15: weaver.WorkloadLogEntry.timestamp:type_name -> google.protobuf.Timestamp
+	13,  // 16: weaver.ExecRequest.start:type_name -> weaver.ExecStart
+	14,  // 17: weaver.ExecRequest.resize:type_name -> weaver.TerminalSize
+	16,  // 18: weaver.ExecResponse.exit:type_name -> weaver.ExecExit
+	18,  // 19: weaver.PortForwardRequest.start:type_name -> weaver.PortForwardStart
+	31,  // 20: weaver.CreateNamespaceRequest.spec:type_name -> weaver.NamespaceSpec
+	136, // 21: weaver.CreateNamespaceRequest.labels:type_name -> weaver.CreateNamespaceRequest.LabelsEntry
+	137, // 22: weaver.CreateNamespaceRequest.annotations:type_name -> weaver.CreateNamespaceRequest.AnnotationsEntry
+	30,  // 23: weaver.CreateNamespaceResponse.namespace:type_name -> weaver.Namespace
+	30,  // 24: weaver.GetNamespaceResponse.namespace:type_name -> weaver.Namespace
+	138, // 25: weaver.ListNamespacesRequest.label_selector:type_name -> weaver.ListNamespacesRequest.LabelSelectorEntry
+	30,  // 26: weaver.ListNamespacesResponse.namespaces:type_name -> weaver.Namespace
+	31,  // 27: weaver.UpdateNamespaceRequest.spec:type_name -> weaver.NamespaceSpec
+	139, // 28: weaver.UpdateNamespaceRequest.labels:type_name -> weaver.UpdateNamespaceRequest.LabelsEntry
+	140, // 29: weaver.UpdateNamespaceRequest.annotations:type_name -> weaver.UpdateNamespaceRequest.AnnotationsEntry
+	30,  // 30: weaver.UpdateNamespaceResponse.namespace:type_name -> weaver.Namespace
+	141, // 31: weaver.Namespace.labels:type_name -> weaver.Namespace.LabelsEntry
+	142, // 32: weaver.Namespace.annotations:type_name -> weaver.Namespace.AnnotationsEntry
+	31,  // 33: weaver.Namespace.spec:type_name -> weaver.NamespaceSpec
+	37,  // 34: weaver.Namespace.status:type_name -> weaver.NamespaceStatus
+	169, // 35: weaver.Namespace.created_at:type_name -> google.protobuf.Timestamp
+	169, // 36: weaver.Namespace.updated_at:type_name -> google.protobuf.Timestamp
+	32,  // 37: weaver.NamespaceSpec.quotas:type_name -> weaver.ResourceQuotas
+	33,  // 38: weaver.NamespaceSpec.network_policy:type_name -> weaver.NetworkPolicy
+	125, // 39: weaver.NamespaceSpec.default_placement:type_name -> weaver.PlacementSpec
+	34,  // 40: weaver.NetworkPolicy.ingress:type_name -> weaver.NetworkRule
+	34,  // 41: weaver.NetworkPolicy.egress:type_name -> weaver.NetworkRule
+	35,  // 42: weaver.NetworkRule.from:type_name -> weaver.NetworkPeer
+	35,  // 43: weaver.NetworkRule.to:type_name -> weaver.NetworkPeer
+	123, // 44: weaver.NetworkRule.ports:type_name -> weaver.Port
+	143, // 45: weaver.NetworkPeer.namespace_selector:type_name -> weaver.NetworkPeer.NamespaceSelectorEntry
+	144, // 46: weaver.NetworkPeer.workload_selector:type_name -> weaver.NetworkPeer.WorkloadSelectorEntry
+	36,  // 47: weaver.NetworkPeer.ip_block:type_name -> weaver.IPBlock
+	38,  // 48: weaver.NamespaceStatus.usage:type_name -> weaver.ResourceUsage
+	145, // 49: weaver.CreateSecretRequest.data:type_name -> weaver.CreateSecretRequest.DataEntry
+	51,  // 50: weaver.CreateSecretRequest.external_ref:type_name -> weaver.ExternalSecretRef
+	50,  // 51: weaver.CreateSecretResponse.secret:type_name -> weaver.Secret
+	50,  // 52: weaver.GetSecretResponse.secret:type_name -> weaver.Secret
+	50,  // 53: weaver.ListSecretsResponse.secrets:type_name -> weaver.Secret
+	146, // 54: weaver.UpdateSecretRequest.data:type_name -> weaver.UpdateSecretRequest.DataEntry
+	51,  // 55: weaver.UpdateSecretRequest.external_ref:type_name -> weaver.ExternalSecretRef
+	50,  // 56: weaver.UpdateSecretResponse.secret:type_name -> weaver.Secret
+	50,  // 57: weaver.SyncSecretResponse.secret:type_name -> weaver.Secret
+	147, // 58: weaver.Secret.data:type_name -> weaver.Secret.DataEntry
+	51,  // 59: weaver.Secret.external_ref:type_name -> weaver.ExternalSecretRef
+	52,  // 60: weaver.Secret.status:type_name -> weaver.SecretStatus
+	169, // 61: weaver.Secret.created_at:type_name -> google.protobuf.Timestamp
+	169, // 62: weaver.Secret.updated_at:type_name -> google.protobuf.Timestamp
+	148, // 63: weaver.ExternalSecretRef.auth:type_name -> weaver.ExternalSecretRef.AuthEntry
+	169, // 64: weaver.SecretStatus.last_sync:type_name -> google.protobuf.Timestamp
+	63,  // 65: weaver.CreateCronWorkloadRequest.spec:type_name -> weaver.CronWorkloadSpec
+	149, // 66: weaver.CreateCronWorkloadRequest.labels:type_name -> weaver.CreateCronWorkloadRequest.LabelsEntry
+	150, // 67: weaver.CreateCronWorkloadRequest.annotations:type_name -> weaver.CreateCronWorkloadRequest.AnnotationsEntry
+	62,  // 68: weaver.CreateCronWorkloadResponse.cron_workload:type_name -> weaver.CronWorkload
+	62,  // 69: weaver.GetCronWorkloadResponse.cron_workload:type_name -> weaver.CronWorkload
+	151, // 70: weaver.ListCronWorkloadsRequest.label_selector:type_name -> weaver.ListCronWorkloadsRequest.LabelSelectorEntry
+	62,  // 71: weaver.ListCronWorkloadsResponse.cron_workloads:type_name -> weaver.CronWorkload
+	63,  // 72: weaver.UpdateCronWorkloadRequest.spec:type_name -> weaver.CronWorkloadSpec
+	152, // 73: weaver.UpdateCronWorkloadRequest.labels:type_name -> weaver.UpdateCronWorkloadRequest.LabelsEntry
+	153, // 74: weaver.UpdateCronWorkloadRequest.annotations:type_name -> weaver.UpdateCronWorkloadRequest.AnnotationsEntry
+	62,  // 75: weaver.UpdateCronWorkloadResponse.cron_workload:type_name -> weaver.CronWorkload
+	154, // 76: weaver.CronWorkload.labels:type_name -> weaver.CronWorkload.LabelsEntry
+	155, // 77: weaver.CronWorkload.annotations:type_name -> weaver.CronWorkload.AnnotationsEntry
+	63,  // 78: weaver.CronWorkload.spec:type_name -> weaver.CronWorkloadSpec
+	65,  // 79: weaver.CronWorkload.status:type_name -> weaver.CronWorkloadStatus
+	169, // 80: weaver.CronWorkload.created_at:type_name -> google.protobuf.Timestamp
+	169, // 81: weaver.CronWorkload.updated_at:type_name -> google.protobuf.Timestamp
+	64,  // 82: weaver.CronWorkloadSpec.template:type_name -> weaver.WorkloadTemplate
+	156, // 83: weaver.WorkloadTemplate.labels:type_name -> weaver.WorkloadTemplate.LabelsEntry
+	157, // 84: weaver.WorkloadTemplate.annotations:type_name -> weaver.WorkloadTemplate.AnnotationsEntry
+	116, // 85: weaver.WorkloadTemplate.spec:type_name -> weaver.WorkloadSpec
+	169, // 86: weaver.CronWorkloadStatus.last_schedule_time:type_name -> google.protobuf.Timestamp
+	169, // 87: weaver.CronWorkloadStatus.last_successful_time:type_name -> google.protobuf.Timestamp
+	169, // 88: weaver.CronWorkloadStatus.next_schedule_time:type_name -> google.protobuf.Timestamp
+	71,  // 89: weaver.GetProviderMachineTypesResponse.machine_types:type_name -> weaver.MachineType
+	116, // 90: weaver.ScheduleWorkloadRequest.spec:type_name -> weaver.WorkloadSpec
+	86,  // 91: weaver.ScheduleWorkloadRequest.constraints:type_name -> weaver.PlacementConstraints
+	116, // 92: weaver.GetRecommendationsRequest.spec:type_name -> weaver.WorkloadSpec
+	86,  // 93: weaver.GetRecommendationsRequest.constraints:type_name -> weaver.PlacementConstraints
+	77,  // 94: weaver.GetRecommendationsResponse.recommendations:type_name -> weaver.ScheduleRecommendation
+	116, // 95: weaver.ExplainSchedulingRequest.spec:type_name -> weaver.WorkloadSpec
+	80,  // 96: weaver.ExplainSchedulingResponse.candidates:type_name -> weaver.SchedulingCandidate
+	127, // 97: weaver.ExplainSchedulingResponse.status:type_name -> weaver.WorkloadStatus
+	81,  // 98: weaver.SchedulingCandidate.scores:type_name -> weaver.ScoreComponent
+	84,  // 99: weaver.ListSchedulingQueueResponse.workloads:type_name -> weaver.QueuedWorkload
+	169, // 100: weaver.QueuedWorkload.next_attempt:type_name -> google.protobuf.Timestamp
+	158, // 101: weaver.GetSchedulerStatsResponse.workloads_by_provider:type_name -> weaver.GetSchedulerStatsResponse.WorkloadsByProviderEntry
+	159, // 102: weaver.PlacementConstraints.node_labels:type_name -> weaver.PlacementConstraints.NodeLabelsEntry
+	126, // 103: weaver.PlacementConstraints.tolerations:type_name -> weaver.Toleration
+	92,  // 104: weaver.CreateTokenResponse.token:type_name -> weaver.Token
+	92,  // 105: weaver.ListTokensResponse.tokens:type_name -> weaver.Token
+	169, // 106: weaver.Token.created_at:type_name -> google.protobuf.Timestamp
+	169, // 107: weaver.Token.expires_at:type_name -> google.protobuf.Timestamp
+	169, // 108: weaver.Token.revoked_at:type_name -> google.protobuf.Timestamp
+	98,  // 109: weaver.CreateRoleBindingResponse.role_binding:type_name -> weaver.RoleBinding
+	98,  // 110: weaver.ListRoleBindingsResponse.role_bindings:type_name -> weaver.RoleBinding
+	169, // 111: weaver.RoleBinding.created_at:type_name -> google.protobuf.Timestamp
+	169, // 112: weaver.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
+	160, // 113: weaver.RegisterNodeRequest.labels:type_name -> weaver.RegisterNodeRequest.LabelsEntry
+	111, // 114: weaver.RegisterNodeRequest.capacity:type_name -> weaver.NodeResources
+	110, // 115: weaver.RegisterNodeRequest.taints:type_name -> weaver.NodeTaint
+	169, // 116: weaver.HeartbeatRequest.timestamp:type_name -> google.protobuf.Timestamp
+	107, // 117: weaver.WorkloadAssignments.workloads:type_name -> weaver.WorkloadAssignment
+	169, // 118: weaver.WorkloadAssignments.timestamp:type_name -> google.protobuf.Timestamp
+	116, // 119: weaver.WorkloadAssignment.spec:type_name -> weaver.WorkloadSpec
+	161, // 120: weaver.WorkloadAssignment.secret_env:type_name -> weaver.WorkloadAssignment.SecretEnvEntry
+	108, // 121: weaver.WorkloadAssignment.secret_files:type_name -> weaver.SecretFile
+	169, // 122: weaver.ReportWorkloadStatusRequest.timestamp:type_name -> google.protobuf.Timestamp
+	169, // 123: weaver.AgentLogsRequest.since:type_name -> google.protobuf.Timestamp
+	12,  // 124: weaver.AgentExecRequest.request:type_name -> weaver.ExecRequest
+	17,  // 125: weaver.AgentPortForwardRequest.request:type_name -> weaver.PortForwardRequest
+	162, // 126: weaver.Workload.labels:type_name -> weaver.Workload.LabelsEntry
+	163, // 127: weaver.Workload.annotations:type_name -> weaver.Workload.AnnotationsEntry
+	116, // 128: weaver.Workload.spec:type_name -> weaver.WorkloadSpec
+	127, // 129: weaver.Workload.status:type_name -> weaver.WorkloadStatus
+	169, // 130: weaver.Workload.created_at:type_name -> google.protobuf.Timestamp
+	169, // 131: weaver.Workload.updated_at:type_name -> google.protobuf.Timestamp
+	169, // 132: weaver.Workload.deleted_at:type_name -> google.protobuf.Timestamp
+	164, // 133: weaver.WorkloadSpec.env:type_name -> weaver.WorkloadSpec.EnvEntry
+	121, // 134: weaver.WorkloadSpec.resources:type_name -> weaver.ResourceRequests
+	122, // 135: weaver.WorkloadSpec.volumes:type_name -> weaver.VolumeMount
+	123, // 136: weaver.WorkloadSpec.ports:type_name -> weaver.Port
+	124, // 137: weaver.WorkloadSpec.sidecars:type_name -> weaver.SidecarSpec
+	125, // 138: weaver.WorkloadSpec.placement:type_name -> weaver.PlacementSpec
+	119, // 139: weaver.WorkloadSpec.env_from:type_name -> weaver.EnvFromSource
+	165, // 140: weaver.WorkloadSpec.env_value_from:type_name -> weaver.WorkloadSpec.EnvValueFromEntry
+	117, // 141: weaver.WorkloadSpec.job:type_name -> weaver.JobSpec
+	118, // 142: weaver.EnvFromSource.secret_ref:type_name -> weaver.SecretReference
+	118, // 143: weaver.EnvVarSource.secret_key_ref:type_name -> weaver.SecretReference
+	118, // 144: weaver.VolumeMount.secret:type_name -> weaver.SecretReference
+	166, // 145: weaver.SidecarSpec.env:type_name -> weaver.SidecarSpec.EnvEntry
+	167, // 146: weaver.PlacementSpec.node_labels:type_name -> weaver.PlacementSpec.NodeLabelsEntry
+	126, // 147: weaver.PlacementSpec.tolerations:type_name -> weaver.Toleration
+	169, // 148: weaver.WorkloadStatus.start_time:type_name -> google.protobuf.Timestamp
+	169, // 149: weaver.WorkloadStatus.finish_time:type_name -> google.protobuf.Timestamp
+	169, // 150: weaver.WorkloadStatus.last_snapshot:type_name -> google.protobuf.Timestamp
+	129, // 151: weaver.WorkloadStatus.provider_ref:type_name -> weaver.ProviderReference
+	128, // 152: weaver.WorkloadStatus.job:type_name -> weaver.JobStatus
+	169, // 153: weaver.JobStatus.start_time:type_name -> google.protobuf.Timestamp
+	169, // 154: weaver.JobStatus.completion_time:type_name -> google.protobuf.Timestamp
+	169, // 155: weaver.JobStatus.retry_at:type_name -> google.protobuf.Timestamp
+	168, // 156: weaver.ProviderReference.metadata:type_name -> weaver.ProviderReference.MetadataEntry
+	120, // 157: weaver.WorkloadSpec.EnvValueFromEntry.value:type_name -> weaver.EnvVarSource
+	0,   // 158: weaver.WeaverService.CreateWorkload:input_type -> weaver.CreateWorkloadRequest
+	2,   // 159: weaver.WeaverService.GetWorkload:input_type -> weaver.GetWorkloadRequest
+	4,   // 160: weaver.WeaverService.ListWorkloads:input_type -> weaver.ListWorkloadsRequest
+	6,   // 161: weaver.WeaverService.UpdateWorkload:input_type -> weaver.UpdateWorkloadRequest
+	20,  // 162: weaver.WeaverService.DeleteWorkload:input_type -> weaver.DeleteWorkloadRequest
+	8,   // 163: weaver.WeaverService.WatchWorkloads:input_type -> weaver.WatchWorkloadsRequest
+	10,  // 164: weaver.WeaverService.StreamWorkloadLogs:input_type -> weaver.StreamWorkloadLogsRequest
+	12,  // 165: weaver.WeaverService.ExecWorkload:input_type -> weaver.ExecRequest
+	17,  // 166: weaver.WeaverService.PortForward:input_type -> weaver.PortForwardRequest
+	21,  // 167: weaver.WeaverService.CreateNamespace:input_type -> weaver.CreateNamespaceRequest
+	23,  // 168: weaver.WeaverService.GetNamespace:input_type -> weaver.GetNamespaceRequest
+	25,  // 169: weaver.WeaverService.ListNamespaces:input_type -> weaver.ListNamespacesRequest
+	27,  // 170: weaver.WeaverService.UpdateNamespace:input_type -> weaver.UpdateNamespaceRequest
+	29,  // 171: weaver.WeaverService.DeleteNamespace:input_type -> weaver.DeleteNamespaceRequest
+	39,  // 172: weaver.WeaverService.CreateSecret:input_type -> weaver.CreateSecretRequest
+	41,  // 173: weaver.WeaverService.GetSecret:input_type -> weaver.GetSecretRequest
+	43,  // 174: weaver.WeaverService.ListSecrets:input_type -> weaver.ListSecretsRequest
+	45,  // 175: weaver.WeaverService.UpdateSecret:input_type -> weaver.UpdateSecretRequest
+	47,  // 176: weaver.WeaverService.DeleteSecret:input_type -> weaver.DeleteSecretRequest
+	48,  // 177: weaver.WeaverService.SyncSecret:input_type -> weaver.SyncSecretRequest
+	53,  // 178: weaver.WeaverService.CreateCronWorkload:input_type -> weaver.CreateCronWorkloadRequest
+	55,  // 179: weaver.WeaverService.GetCronWorkload:input_type -> weaver.GetCronWorkloadRequest
+	57,  // 180: weaver.WeaverService.ListCronWorkloads:input_type -> weaver.ListCronWorkloadsRequest
+	59,  // 181: weaver.WeaverService.UpdateCronWorkload:input_type -> weaver.UpdateCronWorkloadRequest
+	61,  // 182: weaver.WeaverService.DeleteCronWorkload:input_type -> weaver.DeleteCronWorkloadRequest
+	170, // 183: weaver.WeaverService.ListProviders:input_type -> google.protobuf.Empty
+	67,  // 184: weaver.WeaverService.GetProviderRegions:input_type -> weaver.GetProviderRegionsRequest
+	69,  // 185: weaver.WeaverService.GetProviderMachineTypes:input_type -> weaver.GetProviderMachineTypesRequest
+	170, // 186: weaver.WeaverService.GetSchedulerStatus:input_type -> google.protobuf.Empty
+	73,  // 187: weaver.WeaverService.ScheduleWorkload:input_type -> weaver.ScheduleWorkloadRequest
+	75,  // 188: weaver.WeaverService.GetRecommendations:input_type -> weaver.GetRecommendationsRequest
+	78,  // 189: weaver.WeaverService.ExplainScheduling:input_type -> weaver.ExplainSchedulingRequest
+	82,  // 190: weaver.WeaverService.ListSchedulingQueue:input_type -> weaver.ListSchedulingQueueRequest
+	170, // 191: weaver.WeaverService.GetSchedulerStats:input_type -> google.protobuf.Empty
+	87,  // 192: weaver.WeaverService.CreateToken:input_type -> weaver.CreateTokenRequest
+	89,  // 193: weaver.WeaverService.ListTokens:input_type -> weaver.ListTokensRequest
+	91,  // 194: weaver.WeaverService.RevokeToken:input_type -> weaver.RevokeTokenRequest
+	93,  // 195: weaver.WeaverService.CreateRoleBinding:input_type -> weaver.CreateRoleBindingRequest
+	95,  // 196: weaver.WeaverService.ListRoleBindings:input_type -> weaver.ListRoleBindingsRequest
+	97,  // 197: weaver.WeaverService.DeleteRoleBinding:input_type -> weaver.DeleteRoleBindingRequest
+	170, // 198: weaver.WeaverService.HealthCheck:input_type -> google.protobuf.Empty
+	100, // 199: weaver.NodeService.RegisterNode:input_type -> weaver.RegisterNodeRequest
+	102, // 200: weaver.NodeService.UnregisterNode:input_type -> weaver.UnregisterNodeRequest
+	103, // 201: weaver.NodeService.Heartbeat:input_type -> weaver.HeartbeatRequest
+	105, // 202: weaver.NodeService.WatchAssignments:input_type -> weaver.WatchAssignmentsRequest
+	109, // 203: weaver.NodeService.ReportWorkloadStatus:input_type -> weaver.ReportWorkloadStatusRequest
+	112, // 204: weaver.AgentService.StreamLogs:input_type -> weaver.AgentLogsRequest
+	113, // 205: weaver.AgentService.Exec:input_type -> weaver.AgentExecRequest
+	114, // 206: weaver.AgentService.PortForward:input_type -> weaver.AgentPortForwardRequest
+	1,   // 207: weaver.WeaverService.CreateWorkload:output_type -> weaver.CreateWorkloadResponse
+	3,   // 208: weaver.WeaverService.GetWorkload:output_type -> weaver.GetWorkloadResponse
+	5,   // 209: weaver.WeaverService.ListWorkloads:output_type -> weaver.ListWorkloadsResponse
+	7,   // 210: weaver.WeaverService.UpdateWorkload:output_type -> weaver.UpdateWorkloadResponse
+	170, // 211: weaver.WeaverService.DeleteWorkload:output_type -> google.protobuf.Empty
+	9,   // 212: weaver.WeaverService.WatchWorkloads:output_type -> weaver.WorkloadEvent
+	11,  // 213: weaver.WeaverService.StreamWorkloadLogs:output_type -> weaver.WorkloadLogEntry
+	15,  // 214: weaver.WeaverService.ExecWorkload:output_type -> weaver.ExecResponse
+	19,  // 215: weaver.WeaverService.PortForward:output_type -> weaver.PortForwardResponse
+	22,  // 216: weaver.WeaverService.CreateNamespace:output_type -> weaver.CreateNamespaceResponse
+	24,  // 217: weaver.WeaverService.GetNamespace:output_type -> weaver.GetNamespaceResponse
+	26,  // 218: weaver.WeaverService.ListNamespaces:output_type -> weaver.ListNamespacesResponse
+	28,  // 219: weaver.WeaverService.UpdateNamespace:output_type -> weaver.UpdateNamespaceResponse
+	170, // 220: weaver.WeaverService.DeleteNamespace:output_type -> google.protobuf.Empty
+	40,  // 221: weaver.WeaverService.CreateSecret:output_type -> weaver.CreateSecretResponse
+	42,  // 222: weaver.WeaverService.GetSecret:output_type -> weaver.GetSecretResponse
+	44,  // 223: weaver.WeaverService.ListSecrets:output_type -> weaver.ListSecretsResponse
+	46,  // 224: weaver.WeaverService.UpdateSecret:output_type -> weaver.UpdateSecretResponse
+	170, // 225: weaver.WeaverService.DeleteSecret:output_type -> google.protobuf.Empty
+	49,  // 226: weaver.WeaverService.SyncSecret:output_type -> weaver.SyncSecretResponse
+	54,  // 227: weaver.WeaverService.CreateCronWorkload:output_type -> weaver.CreateCronWorkloadResponse
+	56,  // 228: weaver.WeaverService.GetCronWorkload:output_type -> weaver.GetCronWorkloadResponse
+	58,  // 229: weaver.WeaverService.ListCronWorkloads:output_type -> weaver.ListCronWorkloadsResponse
+	60,  // 230: weaver.WeaverService.UpdateCronWorkload:output_type -> weaver.UpdateCronWorkloadResponse
+	170, // 231: weaver.WeaverService.DeleteCronWorkload:output_type -> google.protobuf.Empty
+	66,  // 232: weaver.WeaverService.ListProviders:output_type -> weaver.ListProvidersResponse
+	68,  // 233: weaver.WeaverService.GetProviderRegions:output_type -> weaver.GetProviderRegionsResponse
+	70,  // 234: weaver.WeaverService.GetProviderMachineTypes:output_type -> weaver.GetProviderMachineTypesResponse
+	72,  // 235: weaver.WeaverService.GetSchedulerStatus:output_type -> weaver.GetSchedulerStatusResponse
+	74,  // 236: weaver.WeaverService.ScheduleWorkload:output_type -> weaver.ScheduleWorkloadResponse
+	76,  // 237: weaver.WeaverService.GetRecommendations:output_type -> weaver.GetRecommendationsResponse
+	79,  // 238: weaver.WeaverService.ExplainScheduling:output_type -> weaver.ExplainSchedulingResponse
+	83,  // 239: weaver.WeaverService.ListSchedulingQueue:output_type -> weaver.ListSchedulingQueueResponse
+	85,  // 240: weaver.WeaverService.GetSchedulerStats:output_type -> weaver.GetSchedulerStatsResponse
+	88,  // 241: weaver.WeaverService.CreateToken:output_type -> weaver.CreateTokenResponse
+	90,  // 242: weaver.WeaverService.ListTokens:output_type -> weaver.ListTokensResponse
+	170, // 243: weaver.WeaverService.RevokeToken:output_type -> google.protobuf.Empty
+	94,  // 244: weaver.WeaverService.CreateRoleBinding:output_type -> weaver.CreateRoleBindingResponse
+	96,  // 245: weaver.WeaverService.ListRoleBindings:output_type -> weaver.ListRoleBindingsResponse
+	170, // 246: weaver.WeaverService.DeleteRoleBinding:output_type -> google.protobuf.Empty
+	99,  // 247: weaver.WeaverService.HealthCheck:output_type -> weaver.HealthCheckResponse
+	101, // 248: weaver.NodeService.RegisterNode:output_type -> weaver.RegisterNodeResponse
+	170, // 249: weaver.NodeService.UnregisterNode:output_type -> google.protobuf.Empty
+	104, // 250: weaver.NodeService.Heartbeat:output_type -> weaver.HeartbeatResponse
+	106, // 251: weaver.NodeService.WatchAssignments:output_type -> weaver.WorkloadAssignments
+	170, // 252: weaver.NodeService.ReportWorkloadStatus:output_type -> google.protobuf.Empty
+	11,  // 253: weaver.AgentService.StreamLogs:output_type -> weaver.WorkloadLogEntry
+	15,  // 254: weaver.AgentService.Exec:output_type -> weaver.ExecResponse
+	19,  // 255: weaver.AgentService.PortForward:output_type -> weaver.PortForwardResponse
+	207, // [207:256] is the sub-list for method output_type
+	158, // [158:207] is the sub-list for method input_type
+	158, // [158:158] is the sub-list for extension type_name
+	158, // [158:158] is the sub-list for extension extendee
+	0,   // [0:158] is the sub-list for field type_name
+}
+
+func init() { file_weaver_proto_weaver_weaver_proto_init() }
+func file_weaver_proto_weaver_weaver_proto_init() {
+	if File_weaver_proto_weaver_weaver_proto != nil {
+		return
+	}
+	file_weaver_proto_weaver_weaver_proto_msgTypes[12].OneofWrappers = []any{
+		(*ExecRequest_Start)(nil),
+		(*ExecRequest_Stdin)(nil),
+		(*ExecRequest_Resize)(nil),
+		(*ExecRequest_CloseStdin)(nil),
+	}
+	file_weaver_proto_weaver_weaver_proto_msgTypes[15].OneofWrappers = []any{
+		(*ExecResponse_Stdout)(nil),
+		(*ExecResponse_Stderr)(nil),
+		(*ExecResponse_Exit)(nil),
+	}
+	file_weaver_proto_weaver_weaver_proto_msgTypes[17].OneofWrappers = []any{
+		(*PortForwardRequest_Start)(nil),
+		(*PortForwardRequest_Data)(nil),
+	}
+	file_weaver_proto_weaver_weaver_proto_msgTypes[63].OneofWrappers = []any{}
+	file_weaver_proto_weaver_weaver_proto_msgTypes[117].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_weaver_proto_weaver_weaver_proto_rawDesc), len(file_weaver_proto_weaver_weaver_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   169,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_weaver_proto_weaver_weaver_proto_goTypes,
+		DependencyIndexes: file_weaver_proto_weaver_weaver_proto_depIdxs,
+		MessageInfos:      file_weaver_proto_weaver_weaver_proto_msgTypes,
+	}.Build()
+	File_weaver_proto_weaver_weaver_proto = out.File
+	file_weaver_proto_weaver_weaver_proto_goTypes = nil
+	file_weaver_proto_weaver_weaver_proto_depIdxs = nil
+}
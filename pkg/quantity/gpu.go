@@ -0,0 +1,63 @@
+package quantity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GPU is a parsed GPU request
+type GPU struct {
+	Type  string // e.g. "nvidia-a100"; empty means any GPU
+	Count int64
+}
+
+// ParseGPU parses a GPU request. Accepted forms are a count ("2"), a device plugin
+// resource ("nvidia.com/gpu=2"), a type with a count ("nvidia-a100:2" or
+// "nvidia-a100=2") and a bare type ("nvidia-a100"), which means a single device.
+func ParseGPU(s string) (GPU, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return GPU{}, nil
+	}
+
+	name, count := s, ""
+	if i := strings.LastIndexAny(s, "=:"); i >= 0 {
+		name, count = strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+1:])
+		if name == "" || count == "" {
+			return GPU{}, fmt.Errorf("%w %q: expected type:count", ErrInvalid, s)
+		}
+	} else if _, err := strconv.ParseFloat(s, 64); err == nil {
+		name, count = "", s
+	} else {
+		count = "1"
+	}
+
+	n, err := strconv.ParseInt(count, 10, 64)
+	if err != nil {
+		return GPU{}, fmt.Errorf("%w %q: gpu count must be a whole number", ErrInvalid, s)
+	}
+	if n < 0 {
+		return GPU{}, fmt.Errorf("%w %q: gpu count must not be negative", ErrInvalid, s)
+	}
+
+	// Device plugin resource names select the vendor, not a GPU type
+	if strings.Contains(name, "/") {
+		name = ""
+	}
+
+	return GPU{Type: name, Count: n}, nil
+}
+
+// IsZero reports whether no GPU is requested
+func (g GPU) IsZero() bool {
+	return g.Count == 0
+}
+
+// String formats the request in the form ParseGPU accepts
+func (g GPU) String() string {
+	if g.Type == "" {
+		return strconv.FormatInt(g.Count, 10)
+	}
+	return fmt.Sprintf("%s:%d", g.Type, g.Count)
+}
@@ -0,0 +1,201 @@
+package quantity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Byte multipliers for memory and disk quantities
+const (
+	Ki int64 = 1 << 10
+	Mi int64 = 1 << 20
+	Gi int64 = 1 << 30
+	Ti int64 = 1 << 40
+	Pi int64 = 1 << 50
+	Ei int64 = 1 << 60
+
+	K int64 = 1e3
+	M int64 = 1e6
+	G int64 = 1e9
+	T int64 = 1e12
+	P int64 = 1e15
+	E int64 = 1e18
+)
+
+// ErrInvalid is returned for quantities that cannot be parsed
+var ErrInvalid = errors.New("invalid quantity")
+
+// suffixes maps Kubernetes quantity suffixes to multipliers of the base unit
+var suffixes = map[string]float64{
+	"n":  1e-9,
+	"u":  1e-6,
+	"m":  1e-3,
+	"":   1,
+	"k":  1e3,
+	"M":  1e6,
+	"G":  1e9,
+	"T":  1e12,
+	"P":  1e15,
+	"E":  1e18,
+	"Ki": float64(Ki),
+	"Mi": float64(Mi),
+	"Gi": float64(Gi),
+	"Ti": float64(Ti),
+	"Pi": float64(Pi),
+	"Ei": float64(Ei),
+}
+
+// aliases maps case-insensitive byte suffixes found in provider specs ("16GB", "512mb", "4GiB")
+// that Kubernetes does not accept itself
+var aliases = map[string]float64{
+	"kb":  1e3,
+	"mb":  1e6,
+	"gb":  1e9,
+	"tb":  1e12,
+	"pb":  1e15,
+	"ki":  float64(Ki),
+	"mi":  float64(Mi),
+	"gi":  float64(Gi),
+	"ti":  float64(Ti),
+	"pi":  float64(Pi),
+	"kib": float64(Ki),
+	"mib": float64(Mi),
+	"gib": float64(Gi),
+	"tib": float64(Ti),
+	"pib": float64(Pi),
+}
+
+// parse parses a quantity ("2", "2.5", "500m", "4Gi", "1e3") into its base unit
+func parse(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+
+	// Split the numeric part from the suffix; exponents are part of the number
+	end := 0
+	if s[0] == '+' || s[0] == '-' {
+		end++
+	}
+	for end < len(s) {
+		c := s[end]
+		if (c >= '0' && c <= '9') || c == '.' {
+			end++
+			continue
+		}
+		if (c == 'e' || c == 'E') && end+1 < len(s) && isExponent(s[end+1:]) {
+			end++
+			if s[end] == '-' || s[end] == '+' {
+				end++
+			}
+			continue
+		}
+		break
+	}
+
+	number, suffix := s[:end], s[end:]
+	if number == "" {
+		return 0, fmt.Errorf("%w %q: missing number", ErrInvalid, s)
+	}
+
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, fmt.Errorf("%w %q: malformed number", ErrInvalid, s)
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("%w %q: must not be negative", ErrInvalid, s)
+	}
+
+	multiplier, ok := suffixes[suffix]
+	if !ok {
+		multiplier, ok = aliases[strings.ToLower(suffix)]
+	}
+	if !ok {
+		return 0, fmt.Errorf("%w %q: unknown suffix %q", ErrInvalid, s, suffix)
+	}
+
+	return value * multiplier, nil
+}
+
+// isExponent reports whether s starts with a decimal exponent such as "3", "-3" or "+3"
+func isExponent(s string) bool {
+	if s[0] == '-' || s[0] == '+' {
+		s = s[1:]
+	}
+	return s != "" && s[0] >= '0' && s[0] <= '9'
+}
+
+// toInt64 rounds a value up to the nearest integer, as Kubernetes does, and checks for overflow
+func toInt64(s string, value float64) (int64, error) {
+	value = math.Ceil(value)
+	if value >= math.MaxInt64 {
+		return 0, fmt.Errorf("%w %q: too large", ErrInvalid, s)
+	}
+	return int64(value), nil
+}
+
+// ParseCPU parses a CPU quantity ("2", "2.5", "2000m") into millicores
+func ParseCPU(s string) (int64, error) {
+	cores, err := parse(s)
+	if err != nil {
+		return 0, err
+	}
+	return toInt64(s, cores*1000)
+}
+
+// ParseBytes parses a memory or disk quantity ("4Gi", "512M", "16GB", "1024") into bytes.
+// Plain numbers are bytes, as in Kubernetes.
+func ParseBytes(s string) (int64, error) {
+	b, err := parse(s)
+	if err != nil {
+		return 0, err
+	}
+	return toInt64(s, b)
+}
+
+// FormatCPU formats millicores as whole cores when possible ("2") and millicores otherwise ("2500m")
+func FormatCPU(milli int64) string {
+	if milli%1000 == 0 {
+		return strconv.FormatInt(milli/1000, 10)
+	}
+	return strconv.FormatInt(milli, 10) + "m"
+}
+
+// FormatBytes formats bytes with the largest binary suffix that represents them exactly
+func FormatBytes(b int64) string {
+	units := []struct {
+		suffix string
+		size   int64
+	}{
+		{"Ei", Ei}, {"Pi", Pi}, {"Ti", Ti}, {"Gi", Gi}, {"Mi", Mi}, {"Ki", Ki},
+	}
+
+	for _, unit := range units {
+		if b != 0 && b%unit.size == 0 {
+			return strconv.FormatInt(b/unit.size, 10) + unit.suffix
+		}
+	}
+	return strconv.FormatInt(b, 10)
+}
+
+// Cores converts millicores to cores
+func Cores(milli int64) float64 {
+	return float64(milli) / 1000
+}
+
+// In converts a quantity to the given unit, e.g. In(b, Gi) for GiB
+func In(value, unit int64) float64 {
+	return float64(value) / float64(unit)
+}
+
+// RoundUp converts a quantity to a whole number of the given unit, rounding up
+// so a request is never under-provisioned
+func RoundUp(value, unit int64) int64 {
+	if value <= 0 {
+		return 0
+	}
+	return (value + unit - 1) / unit
+}
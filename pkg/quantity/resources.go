@@ -0,0 +1,69 @@
+package quantity
+
+import (
+	"fmt"
+)
+
+// Resources is a parsed set of resources used for capacity accounting
+type Resources struct {
+	MilliCPU int64
+	Memory   int64 // bytes
+	GPU      int64
+	Disk     int64 // bytes
+}
+
+// Parse parses CPU, memory, GPU and disk quantities into Resources; empty strings are zero
+func Parse(cpu, memory, gpu, disk string) (Resources, error) {
+	var r Resources
+	var err error
+
+	if r.MilliCPU, err = ParseCPU(cpu); err != nil {
+		return Resources{}, fmt.Errorf("invalid cpu: %w", err)
+	}
+	if r.Memory, err = ParseBytes(memory); err != nil {
+		return Resources{}, fmt.Errorf("invalid memory: %w", err)
+	}
+	g, err := ParseGPU(gpu)
+	if err != nil {
+		return Resources{}, fmt.Errorf("invalid gpu: %w", err)
+	}
+	r.GPU = g.Count
+	if r.Disk, err = ParseBytes(disk); err != nil {
+		return Resources{}, fmt.Errorf("invalid disk: %w", err)
+	}
+
+	return r, nil
+}
+
+// Add returns the sum of two sets of resources
+func (r Resources) Add(o Resources) Resources {
+	return Resources{
+		MilliCPU: r.MilliCPU + o.MilliCPU,
+		Memory:   r.Memory + o.Memory,
+		GPU:      r.GPU + o.GPU,
+		Disk:     r.Disk + o.Disk,
+	}
+}
+
+// Sub returns r minus o
+func (r Resources) Sub(o Resources) Resources {
+	return Resources{
+		MilliCPU: r.MilliCPU - o.MilliCPU,
+		Memory:   r.Memory - o.Memory,
+		GPU:      r.GPU - o.GPU,
+		Disk:     r.Disk - o.Disk,
+	}
+}
+
+// Fits reports whether r fits within the given free resources
+func (r Resources) Fits(free Resources) bool {
+	return r.MilliCPU <= free.MilliCPU &&
+		r.Memory <= free.Memory &&
+		r.GPU <= free.GPU &&
+		r.Disk <= free.Disk
+}
+
+// IsZero reports whether no resources are set
+func (r Resources) IsZero() bool {
+	return r == Resources{}
+}
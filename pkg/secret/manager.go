@@ -86,16 +86,26 @@ func (m *Manager) Create(ctx context.Context, namespace, name string, spec *Spec
 		return nil, fmt.Errorf("failed to create secret: %w", err)
 	}
 
-	return secret, nil
+	return Redact(secret), nil
 }
 
-// Get retrieves a secret by namespace and name
+// Get retrieves a secret by namespace and name with its values redacted
 func (m *Manager) Get(ctx context.Context, namespace, name string) (*Secret, error) {
 	secret, err := m.repo.Get(ctx, namespace, name)
 	if err != nil {
 		return nil, err
 	}
 
+	return Redact(secret), nil
+}
+
+// Reveal retrieves a secret by namespace and name with its values decrypted
+func (m *Manager) Reveal(ctx context.Context, namespace, name string) (*Secret, error) {
+	secret, err := m.repo.Get(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
 	// Decrypt data for response
 	if err := m.decryptSecretData(secret); err != nil {
 		return nil, fmt.Errorf("failed to decrypt secret data: %w", err)
@@ -104,19 +114,14 @@ func (m *Manager) Get(ctx context.Context, namespace, name string) (*Secret, err
 	return secret, nil
 }
 
-// GetByID retrieves a secret by ID
+// GetByID retrieves a secret by ID with its values redacted
 func (m *Manager) GetByID(ctx context.Context, id string) (*Secret, error) {
 	secret, err := m.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	// Decrypt data for response
-	if err := m.decryptSecretData(secret); err != nil {
-		return nil, fmt.Errorf("failed to decrypt secret data: %w", err)
-	}
-
-	return secret, nil
+	return Redact(secret), nil
 }
 
 // Update updates an existing secret
@@ -162,12 +167,7 @@ func (m *Manager) Update(ctx context.Context, namespace, name string, spec *Spec
 		return nil, fmt.Errorf("failed to update secret: %w", err)
 	}
 
-	// Decrypt data for response
-	if err := m.decryptSecretData(secret); err != nil {
-		return nil, fmt.Errorf("failed to decrypt secret data: %w", err)
-	}
-
-	return secret, nil
+	return Redact(secret), nil
 }
 
 // Delete deletes a secret
@@ -175,13 +175,27 @@ func (m *Manager) Delete(ctx context.Context, namespace, name string) error {
 	return m.repo.Delete(ctx, namespace, name)
 }
 
-// List lists secrets with optional filtering
+// List lists secrets with optional filtering, with their values redacted
 func (m *Manager) List(ctx context.Context, filter Filter) (*List, error) {
 	list, err := m.repo.List(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
+	for i := range list.Items {
+		list.Items[i] = *Redact(&list.Items[i])
+	}
+
+	return list, nil
+}
+
+// RevealList lists secrets with optional filtering, with their values decrypted
+func (m *Manager) RevealList(ctx context.Context, filter Filter) (*List, error) {
+	list, err := m.repo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
 	// Decrypt data for all secrets in response
 	for i := range list.Items {
 		if err := m.decryptSecretData(&list.Items[i]); err != nil {
@@ -193,15 +207,16 @@ func (m *Manager) List(ctx context.Context, filter Filter) (*List, error) {
 	return list, nil
 }
 
-// SyncExternal syncs secrets from external providers
-func (m *Manager) SyncExternal(ctx context.Context, namespace, name string) error {
+// SyncExternal syncs secrets from external providers and returns the synced
+// secret with its values redacted. A failed sync is recorded in the status.
+func (m *Manager) SyncExternal(ctx context.Context, namespace, name string) (*Secret, error) {
 	secret, err := m.repo.Get(ctx, namespace, name)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if secret.Spec.ExternalRef == nil {
-		return fmt.Errorf("secret %s/%s has no external reference", namespace, name)
+		return nil, fmt.Errorf("secret %s/%s has no external reference", namespace, name)
 	}
 
 	if err := m.syncFromExternal(ctx, secret); err != nil {
@@ -217,7 +232,11 @@ func (m *Manager) SyncExternal(ctx context.Context, namespace, name string) erro
 	}
 
 	secret.UpdatedAt = time.Now()
-	return m.repo.Update(ctx, secret)
+	if err := m.repo.Update(ctx, secret); err != nil {
+		return nil, fmt.Errorf("failed to update secret: %w", err)
+	}
+
+	return Redact(secret), nil
 }
 
 // syncFromExternal syncs data from external provider
@@ -270,6 +289,32 @@ func (m *Manager) decryptSecretData(secret *Secret) error {
 	return nil
 }
 
+// Redact returns a copy of a secret without its values or external provider
+// credentials. The keys are kept so callers can tell what a secret holds.
+func Redact(secret *Secret) *Secret {
+	redacted := *secret
+
+	if secret.Spec.Data != nil {
+		redacted.Spec.Data = make(map[string][]byte, len(secret.Spec.Data))
+		for key := range secret.Spec.Data {
+			redacted.Spec.Data[key] = nil
+		}
+	}
+
+	if ref := secret.Spec.ExternalRef; ref != nil {
+		redactedRef := *ref
+		if ref.Auth != nil {
+			redactedRef.Auth = make(map[string]string, len(ref.Auth))
+			for key := range ref.Auth {
+				redactedRef.Auth[key] = ""
+			}
+		}
+		redacted.Spec.ExternalRef = &redactedRef
+	}
+
+	return &redacted
+}
+
 // GetDecryptedValue gets a specific decrypted value from a secret
 func (m *Manager) GetDecryptedValue(ctx context.Context, namespace, name, key string) ([]byte, error) {
 	secret, err := m.repo.Get(ctx, namespace, name)
@@ -25,6 +25,15 @@ const (
 	TypeServiceAccountToken SecretType = "kubernetes.io/service-account-token" // nolint:gosec
 )
 
+// Valid reports whether t is one of the known secret types
+func (t SecretType) Valid() bool {
+	switch t {
+	case TypeOpaque, TypeDockerConfigJSON, TypeBasicAuth, TypeTLS, TypeSSHAuth, TypeServiceAccountToken:
+		return true
+	}
+	return false
+}
+
 // ExternalSecretRef references a secret in an external system
 type ExternalSecretRef struct {
 	Provider string            `json:"provider"` // "vault", "aws-secrets-manager", "azure-keyvault"
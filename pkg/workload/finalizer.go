@@ -0,0 +1,21 @@
+package workload
+
+import "slices"
+
+// Finalizers name the cleanup a deleted workload waits for before it is removed
+const (
+	FinalizerRoute    = "weaver.io/route"    // proxy route to the workload
+	FinalizerProvider = "weaver.io/provider" // provider-side resource
+	FinalizerSecrets  = "weaver.io/secrets"  // secrets mounted by the provider
+	FinalizerStorage  = "weaver.io/storage"  // volumes and snapshots in content storage
+)
+
+// Terminating reports whether the workload has been deleted
+func (w *Workload) Terminating() bool {
+	return w.DeletedAt != nil
+}
+
+// RemoveFinalizer drops a finalizer that has completed
+func (w *Workload) RemoveFinalizer(name string) {
+	w.Finalizers = slices.DeleteFunc(w.Finalizers, func(f string) bool { return f == name })
+}
@@ -0,0 +1,244 @@
+package workload
+
+import (
+	"fmt"
+	"time"
+)
+
+// Kind distinguishes long-running services from workloads that run to completion
+type Kind string
+
+const (
+	KindService Kind = "Service"
+	KindJob     Kind = "Job"
+)
+
+// Reasons set on jobs
+const (
+	ReasonCompleted            = "Completed"
+	ReasonRetrying             = "Retrying"
+	ReasonBackoffLimitExceeded = "BackoffLimitExceeded"
+	ReasonDeadlineExceeded     = "DeadlineExceeded"
+)
+
+const defaultBackoffLimit int32 = 6
+
+// JobSpec configures a workload that runs until it has completed successfully
+// a number of times, retrying failed runs
+type JobSpec struct {
+	Completions  int32  `json:"completions,omitempty"`  // successful runs needed, default 1
+	Parallelism  int32  `json:"parallelism,omitempty"`  // runs at a time, default 1
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"` // failed runs tolerated, default 6
+
+	// ActiveDeadlineSeconds bounds how long the job may run in total, retries included
+	ActiveDeadlineSeconds int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// TTLSecondsAfterFinished deletes the job that long after it finished; unset keeps it
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// JobStatus counts the runs of a job
+type JobStatus struct {
+	Active    int32 `json:"active"`
+	Succeeded int32 `json:"succeeded"`
+	Failed    int32 `json:"failed"`
+
+	StartTime      *time.Time `json:"startTime,omitempty"`
+	CompletionTime *time.Time `json:"completionTime,omitempty"`
+
+	// RetryAt delays the next run of a job waiting to be scheduled again
+	RetryAt *time.Time `json:"retryAt,omitempty"`
+}
+
+// IsJob reports whether the spec describes a run-to-completion workload
+func (s *Spec) IsJob() bool {
+	return s.Kind == KindJob
+}
+
+// Default fills in the job settings left unset. Jobs are never restarted in
+// place; failed runs are retried up to the backoff limit instead.
+func (s *Spec) Default() {
+	if !s.IsJob() {
+		return
+	}
+
+	if s.Job == nil {
+		s.Job = &JobSpec{}
+	}
+	if s.Job.Completions == 0 {
+		s.Job.Completions = 1
+	}
+	if s.Job.Parallelism == 0 {
+		s.Job.Parallelism = 1
+	}
+	if s.Job.BackoffLimit == nil {
+		limit := defaultBackoffLimit
+		s.Job.BackoffLimit = &limit
+	}
+	if s.Restart == "" {
+		s.Restart = RestartPolicyNever
+	}
+}
+
+// validateJob checks the kind of the spec and its job settings
+func (s *Spec) validateJob() error {
+	switch s.Kind {
+	case "", KindService:
+		if s.Job != nil {
+			return fmt.Errorf("job settings require kind %s", KindJob)
+		}
+		return nil
+	case KindJob:
+	default:
+		return fmt.Errorf("unknown kind %q", s.Kind)
+	}
+
+	if s.Restart != "" && s.Restart != RestartPolicyNever {
+		return fmt.Errorf("jobs must use restart policy %s, failed runs are retried up to the backoff limit", RestartPolicyNever)
+	}
+	if s.Job == nil {
+		return nil
+	}
+	if s.Job.Completions < 0 {
+		return fmt.Errorf("job completions must not be negative")
+	}
+	if s.Job.Parallelism < 0 {
+		return fmt.Errorf("job parallelism must not be negative")
+	}
+	if s.Job.BackoffLimit != nil && *s.Job.BackoffLimit < 0 {
+		return fmt.Errorf("job backoffLimit must not be negative")
+	}
+	if s.Job.ActiveDeadlineSeconds < 0 {
+		return fmt.Errorf("job activeDeadlineSeconds must not be negative")
+	}
+	if s.Job.TTLSecondsAfterFinished != nil && *s.Job.TTLSecondsAfterFinished < 0 {
+		return fmt.Errorf("job ttlSecondsAfterFinished must not be negative")
+	}
+
+	return nil
+}
+
+// JobDeadline returns when a started job runs out of time, if it has a deadline
+func (w *Workload) JobDeadline() (time.Time, bool) {
+	job := w.Spec.Job
+	if !w.Spec.IsJob() || job == nil || job.ActiveDeadlineSeconds == 0 || w.Status.Job == nil || w.Status.Job.StartTime == nil {
+		return time.Time{}, false
+	}
+	return w.Status.Job.StartTime.Add(time.Duration(job.ActiveDeadlineSeconds) * time.Second), true
+}
+
+// JobTimeout returns how long the next run of a job with a deadline may take
+func (w *Workload) JobTimeout(now time.Time) (time.Duration, bool) {
+	if deadline, ok := w.JobDeadline(); ok {
+		return deadline.Sub(now), true
+	}
+	if w.Spec.IsJob() && w.Spec.Job != nil && w.Spec.Job.ActiveDeadlineSeconds > 0 {
+		return time.Duration(w.Spec.Job.ActiveDeadlineSeconds) * time.Second, true
+	}
+	return 0, false
+}
+
+// JobExpired reports whether a finished job has outlived its TTL
+func (w *Workload) JobExpired(now time.Time) bool {
+	job := w.Spec.Job
+	if !w.Spec.IsJob() || job == nil || job.TTLSecondsAfterFinished == nil || w.Status.FinishTime == nil {
+		return false
+	}
+	if w.Status.Phase != PhaseSucceeded && w.Status.Phase != PhaseFailed {
+		return false
+	}
+	ttl := time.Duration(*job.TTLSecondsAfterFinished) * time.Second
+	return !now.Before(w.Status.FinishTime.Add(ttl))
+}
+
+// StartJobRun records that a run of the job is active
+func (w *Workload) StartJobRun(at time.Time) {
+	if w.Status.Job == nil {
+		w.Status.Job = &JobStatus{}
+	}
+	if w.Status.Job.StartTime == nil {
+		w.Status.Job.StartTime = &at
+	}
+	w.Status.Job.Active = 1
+	w.Status.Job.RetryAt = nil
+}
+
+// EndJobRun records a run of the job that exited with the given code. The job
+// succeeds once enough runs did and fails once too many failed or it would
+// outlive its deadline; otherwise it goes back to Pending to run again,
+// after a backoff if the run failed. Its placement is then cleared, so the
+// caller removes the run from its provider.
+func (w *Workload) EndJobRun(exitCode int32, failed bool, at time.Time) {
+	w.Spec.Default()
+	if w.Status.Job == nil {
+		w.Status.Job = &JobStatus{StartTime: &at}
+	}
+
+	job := w.Status.Job
+	job.Active = 0
+	if failed {
+		job.Failed++
+	} else {
+		job.Succeeded++
+	}
+	w.Status.ExitCode = exitCode
+
+	if job.Succeeded >= w.Spec.Job.Completions {
+		job.CompletionTime = &at
+		w.finishJob(PhaseSucceeded, ReasonCompleted, fmt.Sprintf("Completed %d of %d runs", job.Succeeded, w.Spec.Job.Completions), at)
+		return
+	}
+	if job.Failed > *w.Spec.Job.BackoffLimit {
+		w.finishJob(PhaseFailed, ReasonBackoffLimitExceeded, fmt.Sprintf("Failed %d times, the last run exited with code %d", job.Failed, exitCode), at)
+		return
+	}
+
+	retryAt := at
+	if failed {
+		retryAt = at.Add(RestartBackoff(int(job.Failed)))
+	}
+	if deadline, ok := w.JobDeadline(); ok && !retryAt.Before(deadline) {
+		w.FailJob(ReasonDeadlineExceeded, "Job would not be retried before its deadline", at)
+		return
+	}
+
+	w.Status.Phase = PhasePending
+	w.Status.Reason = ""
+	if failed {
+		w.Status.Reason = ReasonRetrying
+	}
+	w.Status.Message = fmt.Sprintf("Run exited with code %d, %d of %d runs completed", exitCode, job.Succeeded, w.Spec.Job.Completions)
+	job.RetryAt = &retryAt
+	w.clearPlacement()
+}
+
+// FailJob ends the job as failed, e.g. once it exceeded its deadline. The
+// caller removes any active run from its provider.
+func (w *Workload) FailJob(reason, message string, at time.Time) {
+	if w.Status.Job == nil {
+		w.Status.Job = &JobStatus{}
+	}
+	w.Status.Job.Active = 0
+	w.finishJob(PhaseFailed, reason, message, at)
+}
+
+// finishJob moves the job to a terminal phase
+func (w *Workload) finishJob(phase Phase, reason, message string, at time.Time) {
+	w.Status.Phase = phase
+	w.Status.Reason = reason
+	w.Status.Message = message
+	w.Status.FinishTime = &at
+	w.Status.Job.RetryAt = nil
+}
+
+// clearPlacement forgets where the last run of the workload was placed so the
+// next run is scheduled afresh
+func (w *Workload) clearPlacement() {
+	w.Status.Provider = ""
+	w.Status.NodeID = ""
+	w.Status.TailscaleIP = ""
+	w.Status.ContainerID = ""
+	w.Status.ProviderRef = nil
+	w.Status.StartTime = nil
+	w.Status.FinishTime = nil
+}
@@ -6,7 +6,15 @@ type Repository interface {
 	Create(ctx context.Context, workload *Workload) error
 	Get(ctx context.Context, id string) (*Workload, error)
 	GetByName(ctx context.Context, namespace, name string) (*Workload, error)
+	// Update stores the workload if its ResourceVersion is current and advances
+	// it, or fails with a conflict if the workload changed since it was read
 	Update(ctx context.Context, workload *Workload) error
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, namespace string, filters map[string]string) ([]*Workload, error)
+	// List returns every workload matching the filter, newest first
+	List(ctx context.Context, filter Filter) ([]*Workload, error)
+	// ListPage returns up to limit matching workloads, newest first, after the
+	// position of continueToken. The returned token is empty on the last page.
+	ListPage(ctx context.Context, filter Filter, limit int, continueToken string) ([]*Workload, string, error)
+	ListByPhase(ctx context.Context, phases ...Phase) ([]*Workload, error)
+	ListByNode(ctx context.Context, nodeID string) ([]*Workload, error)
 }
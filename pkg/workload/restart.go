@@ -0,0 +1,49 @@
+package workload
+
+import "time"
+
+// Reasons set while an exited workload waits to be restarted
+const (
+	ReasonRestarting       = "Restarting"
+	ReasonCrashLoopBackOff = "CrashLoopBackOff"
+)
+
+const (
+	initialRestartBackoff = 10 * time.Second
+	maxRestartBackoff     = 5 * time.Minute
+
+	// RestartBackoffReset is how long a workload must run before exiting for the
+	// exit not to count as another consecutive crash
+	RestartBackoffReset = 10 * time.Minute
+)
+
+// Restarts reports whether a workload that exited, successfully or not, is
+// restarted under the policy. Workloads without a policy are always restarted.
+func (p RestartPolicy) Restarts(failed bool) bool {
+	switch p {
+	case RestartPolicyNever:
+		return false
+	case RestartPolicyOnFailure:
+		return failed
+	}
+	return true
+}
+
+// RestartBackoff returns how long to wait before restarting a workload after
+// its given number of consecutive crashes, doubling up to five minutes
+func RestartBackoff(crashes int) time.Duration {
+	delay := initialRestartBackoff
+	for i := 1; i < crashes && delay < maxRestartBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRestartBackoff)
+}
+
+// RestartReason returns the reason of a workload waiting to be restarted after
+// its given number of consecutive crashes
+func RestartReason(crashes int) string {
+	if crashes > 1 {
+		return ReasonCrashLoopBackOff
+	}
+	return ReasonRestarting
+}
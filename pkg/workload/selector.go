@@ -0,0 +1,245 @@
+package workload
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Operator compares a label or field against the values of a requirement
+type Operator string
+
+const (
+	OperatorEquals       Operator = "="
+	OperatorNotEquals    Operator = "!="
+	OperatorIn           Operator = "in"
+	OperatorNotIn        Operator = "notin"
+	OperatorExists       Operator = "exists"
+	OperatorDoesNotExist Operator = "!"
+)
+
+// Fields that field selectors can match on
+const (
+	FieldPhase    = "status.phase"
+	FieldProvider = "status.provider"
+	FieldNodeID   = "status.nodeId"
+)
+
+// Requirement constrains a single label or field. Equality operators take
+// one value, set operators one or more and existence operators none.
+type Requirement struct {
+	Key      string
+	Operator Operator
+	Values   []string
+}
+
+// Selector matches when all of its requirements hold
+type Selector []Requirement
+
+// Filter selects workloads by namespace, labels and fields
+type Filter struct {
+	Namespace string // all namespaces when empty
+	Labels    Selector
+	Fields    Selector
+}
+
+// ParseSelector parses a label selector such as
+// "app=web,tier in (frontend, edge),!canary"
+func ParseSelector(s string) (Selector, error) {
+	var selector Selector
+	for _, term := range splitTerms(s) {
+		requirement, err := parseRequirement(term)
+		if err != nil {
+			return nil, err
+		}
+		selector = append(selector, requirement)
+	}
+	return selector, nil
+}
+
+// ParseFieldSelector parses a field selector such as
+// "status.phase!=Succeeded,status.provider=fly"
+func ParseFieldSelector(s string) (Selector, error) {
+	selector, err := ParseSelector(s)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, requirement := range selector {
+		switch requirement.Key {
+		case FieldPhase, FieldProvider, FieldNodeID:
+		default:
+			return nil, fmt.Errorf("unsupported field %q", requirement.Key)
+		}
+		if requirement.Operator == OperatorExists || requirement.Operator == OperatorDoesNotExist {
+			return nil, fmt.Errorf("field %s needs a value", requirement.Key)
+		}
+	}
+	return selector, nil
+}
+
+// SelectorFromMap returns a selector requiring every label in the map
+func SelectorFromMap(labels map[string]string) Selector {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	selector := make(Selector, 0, len(keys))
+	for _, key := range keys {
+		selector = append(selector, Requirement{Key: key, Operator: OperatorEquals, Values: []string{labels[key]}})
+	}
+	return selector
+}
+
+// Matches reports whether a workload passes the filter
+func (f Filter) Matches(w *Workload) bool {
+	if f.Namespace != "" && w.Namespace != f.Namespace {
+		return false
+	}
+	if !f.Labels.Matches(w.Labels) {
+		return false
+	}
+	for _, requirement := range f.Fields {
+		if !requirement.matches(FieldValue(w, requirement.Key), true) {
+			return false
+		}
+	}
+	return true
+}
+
+// Matches reports whether a set of labels satisfies every requirement
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, requirement := range s {
+		value, ok := labels[requirement.Key]
+		if !requirement.matches(value, ok) {
+			return false
+		}
+	}
+	return true
+}
+
+// FieldValue returns the value of a selectable field of a workload
+func FieldValue(w *Workload, field string) string {
+	switch field {
+	case FieldPhase:
+		return string(w.Status.Phase)
+	case FieldProvider:
+		return w.Status.Provider
+	case FieldNodeID:
+		return w.Status.NodeID
+	}
+	return ""
+}
+
+// matches applies the requirement to a value; ok is false when it is unset.
+// Negative operators match unset values, like in Kubernetes.
+func (r Requirement) matches(value string, ok bool) bool {
+	switch r.Operator {
+	case OperatorEquals, OperatorIn:
+		return ok && slices.Contains(r.Values, value)
+	case OperatorNotEquals, OperatorNotIn:
+		return !ok || !slices.Contains(r.Values, value)
+	case OperatorExists:
+		return ok
+	case OperatorDoesNotExist:
+		return !ok
+	}
+	return false
+}
+
+// splitTerms splits a selector on the commas that are not inside a value set
+func splitTerms(s string) []string {
+	var terms []string
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	terms = append(terms, s[start:])
+
+	result := terms[:0]
+	for _, term := range terms {
+		if term = strings.TrimSpace(term); term != "" {
+			result = append(result, term)
+		}
+	}
+	return result
+}
+
+// parseRequirement parses a single selector term
+func parseRequirement(term string) (Requirement, error) {
+	if strings.HasPrefix(term, "!") && !strings.Contains(term, "=") {
+		return newRequirement(term[1:], OperatorDoesNotExist, nil, term)
+	}
+
+	if open := strings.Index(term, "("); open >= 0 {
+		if !strings.HasSuffix(term, ")") {
+			return Requirement{}, fmt.Errorf("invalid selector %q: missing closing parenthesis", term)
+		}
+		fields := strings.Fields(term[:open])
+		if len(fields) != 2 {
+			return Requirement{}, fmt.Errorf("invalid selector %q", term)
+		}
+
+		var operator Operator
+		switch strings.ToLower(fields[1]) {
+		case "in":
+			operator = OperatorIn
+		case "notin":
+			operator = OperatorNotIn
+		default:
+			return Requirement{}, fmt.Errorf("invalid selector %q: unknown operator %s", term, fields[1])
+		}
+
+		var values []string
+		for _, value := range strings.Split(term[open+1:len(term)-1], ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+		if len(values) == 0 {
+			return Requirement{}, fmt.Errorf("invalid selector %q: empty value set", term)
+		}
+		return newRequirement(fields[0], operator, values, term)
+	}
+
+	for _, op := range []struct {
+		token    string
+		operator Operator
+	}{
+		{"!=", OperatorNotEquals},
+		{"==", OperatorEquals},
+		{"=", OperatorEquals},
+	} {
+		if key, value, ok := strings.Cut(term, op.token); ok {
+			return newRequirement(key, op.operator, []string{strings.TrimSpace(value)}, term)
+		}
+	}
+
+	return newRequirement(term, OperatorExists, nil, term)
+}
+
+func newRequirement(key string, operator Operator, values []string, term string) (Requirement, error) {
+	key = strings.TrimSpace(key)
+	if key == "" || strings.ContainsAny(key, " \t!=(),") {
+		return Requirement{}, fmt.Errorf("invalid selector %q: invalid key", term)
+	}
+	for _, value := range values {
+		if strings.ContainsAny(value, " \t!=(),") {
+			return Requirement{}, fmt.Errorf("invalid selector %q: invalid value %q", term, value)
+		}
+	}
+	return Requirement{Key: key, Operator: operator, Values: values}, nil
+}
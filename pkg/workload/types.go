@@ -2,6 +2,8 @@ package workload
 
 import (
 	"time"
+
+	"github.com/codecflow/fabric/pkg/secret"
 )
 
 // Spec defines the specification for a workload
@@ -16,6 +18,20 @@ type Spec struct {
 	Sidecars  []SidecarSpec     `json:"sidecars,omitempty"`
 	Restart   RestartPolicy     `json:"restart,omitempty"`
 	Placement PlacementSpec     `json:"placement"`
+	Priority  int32             `json:"priority,omitempty"` // higher is scheduled first
+
+	// Jobs run to completion, services until they are deleted
+	Kind Kind     `json:"kind,omitempty"`
+	Job  *JobSpec `json:"job,omitempty"`
+
+	// Secret-backed environment, resolved by the provider at provision time
+	EnvFrom      []secret.EnvFromSource  `json:"envFrom,omitempty"`
+	EnvValueFrom map[string]EnvVarSource `json:"envValueFrom,omitempty"`
+}
+
+// EnvVarSource sets a single environment variable from a secret key
+type EnvVarSource struct {
+	SecretKeyRef *secret.Reference `json:"secretKeyRef,omitempty"`
 }
 
 // ResourceRequests specifies compute resource requirements
@@ -31,6 +47,10 @@ type VolumeMount struct {
 	MountPath string `json:"mountPath"`
 	ReadOnly  bool   `json:"readOnly,omitempty"`
 	ContentID string `json:"contentId,omitempty"` // Iroh CID
+	Size      string `json:"size,omitempty"`      // e.g. "10Gi"
+
+	// Secret mounts the secret's keys as files under MountPath, or only Key if set
+	Secret *secret.Reference `json:"secret,omitempty"`
 }
 
 // Port defines a network port
@@ -83,6 +103,7 @@ type Status struct {
 	StartTime    *time.Time `json:"startTime,omitempty"`
 	FinishTime   *time.Time `json:"finishTime,omitempty"`
 	RestartCount int32      `json:"restartCount"`
+	ExitCode     int32      `json:"exitCode,omitempty"` // of the last exited container
 
 	// Runtime information
 	NodeID      string `json:"nodeId,omitempty"`
@@ -90,11 +111,24 @@ type Status struct {
 	TailscaleIP string `json:"tailscaleIp,omitempty"`
 	ContainerID string `json:"containerId,omitempty"`
 
+	// Provider-side resource backing this workload
+	ProviderRef *ProviderReference `json:"providerRef,omitempty"`
+
+	// Runs of a job
+	Job *JobStatus `json:"job,omitempty"`
+
 	// Snapshot information
 	SnapshotID   string     `json:"snapshotId,omitempty"`
 	LastSnapshot *time.Time `json:"lastSnapshot,omitempty"`
 }
 
+// ProviderReference identifies the resource a provider created for a workload
+type ProviderReference struct {
+	ExternalID string            `json:"externalId"`         // e.g. pod ID, job ID, machine ID
+	Name       string            `json:"name,omitempty"`     // e.g. app or pod name
+	Metadata   map[string]string `json:"metadata,omitempty"` // provider-specific details
+}
+
 // Phase represents the lifecycle phase
 type Phase string
 
@@ -105,8 +139,14 @@ const (
 	PhaseSucceeded Phase = "Succeeded"
 	PhaseFailed    Phase = "Failed"
 	PhaseUnknown   Phase = "Unknown"
+
+	// PhaseTerminating is set once a workload is deleted, until its finalizers have run
+	PhaseTerminating Phase = "Terminating"
 )
 
+// ReasonUnschedulable is set on pending workloads that no provider can currently run
+const ReasonUnschedulable = "Unschedulable"
+
 // Workload represents a complete workload definition
 type Workload struct {
 	ID          string            `json:"id"`
@@ -118,6 +158,12 @@ type Workload struct {
 	Spec   Spec   `json:"spec"`
 	Status Status `json:"status"`
 
+	// Cleanup still pending before a deleted workload is removed
+	Finalizers []string `json:"finalizers,omitempty"`
+
+	// ResourceVersion advances on every update; updating a stale copy fails
+	ResourceVersion int64 `json:"resourceVersion"`
+
 	CreatedAt time.Time  `json:"createdAt"`
 	UpdatedAt time.Time  `json:"updatedAt"`
 	DeletedAt *time.Time `json:"deletedAt,omitempty"`
@@ -128,12 +174,3 @@ type List struct {
 	Items []Workload `json:"items"`
 	Total int        `json:"total"`
 }
-
-// Filter defines filters for workload queries
-type Filter struct {
-	Namespace string
-	Labels    map[string]string
-	Phase     Phase
-	Provider  string
-	NodeID    string
-}
@@ -0,0 +1,114 @@
+package workload
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+)
+
+// Change names a part of a workload that differs between two versions
+type Change string
+
+const (
+	ChangeLabels      Change = "labels"
+	ChangeAnnotations Change = "annotations"
+	ChangePriority    Change = "priority"
+	ChangeImage       Change = "image"
+	ChangeCommand     Change = "command"
+	ChangeArgs        Change = "args"
+	ChangeEnv         Change = "env"
+	ChangeSecretEnv   Change = "secretEnv" // envFrom and envValueFrom
+	ChangeResources   Change = "resources"
+	ChangeVolumes     Change = "volumes"
+	ChangePorts       Change = "ports"
+	ChangeSidecars    Change = "sidecars"
+	ChangeRestart     Change = "restart"
+	ChangePlacement   Change = "placement"
+	ChangeJob         Change = "job" // kind and job settings
+)
+
+// Metadata reports whether the change only concerns Fabric's own bookkeeping,
+// which never requires touching the running workload
+func (c Change) Metadata() bool {
+	switch c {
+	case ChangeLabels, ChangeAnnotations, ChangePriority:
+		return true
+	}
+	return false
+}
+
+// UpdateStrategy is how a change to a workload is applied
+type UpdateStrategy string
+
+const (
+	// UpdateStrategyNone means nothing changed
+	UpdateStrategyNone UpdateStrategy = "None"
+	// UpdateStrategyInPlace changes the running workload without restarting it elsewhere
+	UpdateStrategyInPlace UpdateStrategy = "InPlace"
+	// UpdateStrategyRecreate replaces the running workload with a new instance
+	UpdateStrategyRecreate UpdateStrategy = "Recreate"
+)
+
+// Diff lists the parts of desired that differ from current, in a stable order.
+// Nil and empty collections are equal.
+func Diff(current, desired *Workload) []Change { // nolint:gocyclo
+	var changes []Change
+	add := func(change Change, equal bool) {
+		if !equal {
+			changes = append(changes, change)
+		}
+	}
+
+	add(ChangeLabels, maps.Equal(current.Labels, desired.Labels))
+	add(ChangeAnnotations, maps.Equal(current.Annotations, desired.Annotations))
+	add(ChangePriority, current.Spec.Priority == desired.Spec.Priority)
+
+	a, b := &current.Spec, &desired.Spec
+	add(ChangeImage, a.Image == b.Image)
+	add(ChangeCommand, slices.Equal(a.Command, b.Command))
+	add(ChangeArgs, slices.Equal(a.Args, b.Args))
+	add(ChangeEnv, maps.Equal(a.Env, b.Env))
+	add(ChangeSecretEnv, equalSlices(a.EnvFrom, b.EnvFrom) && equalMaps(a.EnvValueFrom, b.EnvValueFrom))
+	add(ChangeResources, a.Resources == b.Resources)
+	add(ChangeVolumes, equalSlices(a.Volumes, b.Volumes))
+	add(ChangePorts, slices.Equal(a.Ports, b.Ports))
+	add(ChangeSidecars, slices.EqualFunc(a.Sidecars, b.Sidecars, equalSidecars))
+	add(ChangeRestart, a.Restart == b.Restart)
+	add(ChangePlacement, a.Placement.Provider == b.Placement.Provider &&
+		a.Placement.Region == b.Placement.Region &&
+		a.Placement.Zone == b.Placement.Zone &&
+		maps.Equal(a.Placement.NodeLabels, b.Placement.NodeLabels) &&
+		slices.Equal(a.Placement.Tolerations, b.Placement.Tolerations))
+	add(ChangeJob, a.Kind == b.Kind && reflect.DeepEqual(a.Job, b.Job))
+
+	return changes
+}
+
+// AllowsSurge reports whether a replacement instance may run next to the
+// current one during a recreate. Data volumes can only be attached once, and
+// a job's runs are counted one instance at a time.
+func (s *Spec) AllowsSurge() bool {
+	if s.IsJob() {
+		return false
+	}
+	for _, volume := range s.Volumes {
+		if volume.Secret == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func equalSidecars(a, b SidecarSpec) bool {
+	return a.Name == b.Name && a.Image == b.Image &&
+		slices.Equal(a.Command, b.Command) && slices.Equal(a.Args, b.Args) && maps.Equal(a.Env, b.Env)
+}
+
+// equalSlices and equalMaps compare elements holding pointers by value
+func equalSlices[T any](a, b []T) bool {
+	return len(a) == len(b) && (len(a) == 0 || reflect.DeepEqual(a, b))
+}
+
+func equalMaps[K comparable, V any](a, b map[K]V) bool {
+	return len(a) == len(b) && (len(a) == 0 || reflect.DeepEqual(a, b))
+}
@@ -0,0 +1,82 @@
+package workload
+
+import (
+	"fmt"
+
+	"github.com/codecflow/fabric/pkg/quantity"
+)
+
+// Quantity parses the requests into resources for capacity accounting
+func (r ResourceRequests) Quantity() (quantity.Resources, error) {
+	return quantity.Parse(r.CPU, r.Memory, r.GPU, "")
+}
+
+// GPURequest parses the requested GPU type and count
+func (r ResourceRequests) GPURequest() (quantity.GPU, error) {
+	return quantity.ParseGPU(r.GPU)
+}
+
+// Validate checks that the spec can be scheduled
+func (s *Spec) Validate() error {
+	if s.Image == "" {
+		return fmt.Errorf("image is required")
+	}
+
+	if _, err := s.Resources.Quantity(); err != nil {
+		return fmt.Errorf("resources: %w", err)
+	}
+
+	for _, port := range s.Ports {
+		if port.ContainerPort < 1 || port.ContainerPort > 65535 {
+			return fmt.Errorf("port %d is out of range", port.ContainerPort)
+		}
+	}
+
+	switch s.Restart {
+	case "", RestartPolicyAlways, RestartPolicyOnFailure, RestartPolicyNever:
+	default:
+		return fmt.Errorf("unknown restart policy %q", s.Restart)
+	}
+
+	if err := s.validateJob(); err != nil {
+		return err
+	}
+
+	if err := s.validateSecrets(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSecrets checks the secret references of the spec
+func (s *Spec) validateSecrets() error {
+	for i, source := range s.EnvFrom {
+		if source.SecretRef == nil || source.SecretRef.Name == "" {
+			return fmt.Errorf("envFrom[%d]: secret name is required", i)
+		}
+	}
+
+	for name, source := range s.EnvValueFrom {
+		if _, ok := s.Env[name]; ok {
+			return fmt.Errorf("env %s is set both as a value and from a secret", name)
+		}
+		if source.SecretKeyRef == nil || source.SecretKeyRef.Name == "" || source.SecretKeyRef.Key == "" {
+			return fmt.Errorf("env %s: secret name and key are required", name)
+		}
+	}
+
+	for _, volume := range s.Volumes {
+		if volume.Secret == nil {
+			continue
+		}
+		if volume.Secret.Name == "" {
+			return fmt.Errorf("volume %s: secret name is required", volume.Name)
+		}
+		if volume.MountPath == "" {
+			return fmt.Errorf("volume %s: mountPath is required", volume.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,308 @@
+package agent
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/codecflow/fabric/pkg/proto/weaver"
+	"github.com/codecflow/fabric/shuttle/internal/config"
+	"github.com/codecflow/fabric/shuttle/internal/containerd"
+	"github.com/codecflow/fabric/shuttle/internal/logs"
+)
+
+// Server serves Weaver requests that need the node itself, such as logs, exec
+// and port forwarding
+type Server struct {
+	weaver.UnimplementedAgentServiceServer
+
+	config  *config.AgentConfig
+	runtime *containerd.Runtime
+	server  *grpc.Server
+}
+
+// New creates a new agent server
+func New(cfg *config.AgentConfig, runtime *containerd.Runtime) (*Server, error) {
+	return &Server{
+		config:  cfg,
+		runtime: runtime,
+	}, nil
+}
+
+// Start listens on host, the node's mesh address so the agent is only
+// reachable over the mesh, and serves Weaver over mutual TLS
+func (s *Server) Start(ctx context.Context, host string) error {
+	if !s.config.Enabled {
+		return nil
+	}
+	if host == "" {
+		return fmt.Errorf("no mesh address to listen on, refusing to expose the agent on all interfaces")
+	}
+
+	creds, err := serverCredentials(s.config)
+	if err != nil {
+		return fmt.Errorf("failed to load agent TLS credentials: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(host, strconv.Itoa(s.config.Port)))
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %d: %w", s.config.Port, err)
+	}
+
+	s.server = grpc.NewServer(grpc.Creds(creds))
+	weaver.RegisterAgentServiceServer(s.server, s)
+
+	go func() {
+		log.Printf("Starting agent server on %s", listener.Addr())
+		if err := s.server.Serve(listener); err != nil {
+			log.Printf("Agent server error: %v", err)
+		}
+	}()
+
+	return nil
+}
+
+// serverCredentials serves the agent certificate and only accepts clients
+// presenting Weaver's certificate, issued by the client CA. Nodes may share
+// the CA, so a valid certificate alone does not make a client Weaver.
+func serverCredentials(cfg *config.AgentConfig) (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load agent certificate: %w", err)
+	}
+
+	ca, err := os.ReadFile(cfg.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("failed to parse client CA file %s", cfg.ClientCAFile)
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+		VerifyConnection: func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 || state.PeerCertificates[0].Subject.CommonName != cfg.WeaverName {
+				return fmt.Errorf("client certificate does not belong to %s", cfg.WeaverName)
+			}
+			return nil
+		},
+	}), nil
+}
+
+// Stop stops the agent server
+func (s *Server) Stop() error {
+	if s.server != nil {
+		s.server.Stop()
+	}
+	return nil
+}
+
+// StreamLogs streams the output of a workload's container
+func (s *Server) StreamLogs(req *weaver.AgentLogsRequest, stream weaver.AgentService_StreamLogsServer) error {
+	if !validName(req.Namespace) || !validName(req.Name) {
+		return fmt.Errorf("invalid workload %s/%s", req.Namespace, req.Name)
+	}
+	// Shuttle runs a single container per workload, named after the workload
+	if req.Container != "" && req.Container != req.Name {
+		return fmt.Errorf("container %s not found", req.Container)
+	}
+
+	opts := logs.Options{
+		Tail:   req.TailLines,
+		Follow: req.Follow,
+	}
+	if req.Since != nil {
+		opts.Since = req.Since.AsTime()
+	}
+
+	path := s.runtime.LogPath(req.Namespace, req.Name)
+	return logs.Read(stream.Context(), path, opts, func(line logs.Line) error {
+		entry := &weaver.WorkloadLogEntry{
+			Line:   line.Text,
+			Stream: line.Stream,
+		}
+		if !line.Time.IsZero() {
+			entry.Timestamp = timestamppb.New(line.Time)
+		}
+		return stream.Send(entry)
+	})
+}
+
+// Exec runs a command in a workload's container
+func (s *Server) Exec(stream weaver.AgentService_ExecServer) error { // nolint:gocyclo
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	start := req.GetRequest().GetStart()
+	if start == nil {
+		return fmt.Errorf("first exec request must start the command")
+	}
+	if !validName(req.Namespace) || !validName(req.Name) {
+		return fmt.Errorf("invalid workload %s/%s", req.Namespace, req.Name)
+	}
+	if start.Container != "" && start.Container != req.Name {
+		return fmt.Errorf("container %s not found", start.Container)
+	}
+
+	var mu sync.Mutex
+	send := func(resp *weaver.ExecResponse) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return stream.Send(resp)
+	}
+
+	stdinReader, stdinWriter := io.Pipe()
+	// Unblocks the relay if the command exits without reading all input
+	defer func() { _ = stdinReader.Close() }()
+	resize := make(chan containerd.TerminalSize, 1)
+
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					_ = stdinWriter.Close()
+				} else {
+					_ = stdinWriter.CloseWithError(err)
+				}
+				return
+			}
+
+			switch payload := req.GetRequest().GetPayload().(type) {
+			case *weaver.ExecRequest_Stdin:
+				if start.Stdin {
+					_, _ = stdinWriter.Write(payload.Stdin)
+				}
+			case *weaver.ExecRequest_CloseStdin:
+				_ = stdinWriter.Close()
+			case *weaver.ExecRequest_Resize:
+				size := containerd.TerminalSize{
+					Width:  uint16(payload.Resize.Width),  // nolint:gosec
+					Height: uint16(payload.Resize.Height), // nolint:gosec
+				}
+				select {
+				case <-resize:
+				default:
+				}
+				resize <- size
+			}
+		}
+	}()
+
+	opts := containerd.ExecOptions{
+		Command: start.Command,
+		TTY:     start.Tty,
+		Stdout: streamWriter(func(data []byte) error {
+			return send(&weaver.ExecResponse{Payload: &weaver.ExecResponse_Stdout{Stdout: data}})
+		}),
+		Stderr: streamWriter(func(data []byte) error {
+			return send(&weaver.ExecResponse{Payload: &weaver.ExecResponse_Stderr{Stderr: data}})
+		}),
+		Resize: resize,
+	}
+	if start.Stdin {
+		opts.Stdin = stdinReader
+	}
+
+	code, err := s.runtime.Exec(stream.Context(), req.Namespace, req.Name, opts)
+	if err != nil {
+		return err
+	}
+
+	return send(&weaver.ExecResponse{Payload: &weaver.ExecResponse_Exit{Exit: &weaver.ExecExit{ExitCode: int32(code)}}}) // nolint:gosec
+}
+
+// PortForward forwards one connection to a port of a workload's container
+func (s *Server) PortForward(stream weaver.AgentService_PortForwardServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	start := req.GetRequest().GetStart()
+	if start == nil {
+		return fmt.Errorf("first port forward request must select the port")
+	}
+	if !validName(req.Namespace) || !validName(req.Name) {
+		return fmt.Errorf("invalid workload %s/%s", req.Namespace, req.Name)
+	}
+	if start.Port == 0 || start.Port > 65535 {
+		return fmt.Errorf("invalid port %d", start.Port)
+	}
+
+	conn, err := s.runtime.Dial(stream.Context(), req.Namespace, req.Name, uint16(start.Port))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err == io.EOF {
+				// Weaver is done sending, so the workload sees the end of the input
+				if tcp, ok := conn.(*net.TCPConn); ok {
+					_ = tcp.CloseWrite()
+				}
+				return
+			}
+			if err != nil {
+				_ = conn.Close()
+				return
+			}
+			if data := req.GetRequest().GetData(); len(data) > 0 {
+				if _, err := conn.Write(data); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := conn.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&weaver.PortForwardResponse{Data: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// streamWriter sends everything written as one message
+type streamWriter func(data []byte) error
+
+func (f streamWriter) Write(p []byte) (int, error) {
+	if err := f(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// validName reports whether a name is safe to use in a file name
+func validName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, "/\\")
+}
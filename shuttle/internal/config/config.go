@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/codecflow/fabric/pkg/quantity"
 )
 
 // Config defines the configuration for Shuttle
@@ -22,6 +24,9 @@ type Config struct {
 	// Container runtime configuration
 	Runtime RuntimeConfig `yaml:"runtime"`
 
+	// Node agent serving Weaver requests such as logs
+	Agent AgentConfig `yaml:"agent"`
+
 	// Metrics and monitoring
 	Metrics MetricsConfig `yaml:"metrics"`
 
@@ -36,9 +41,17 @@ type NodeConfig struct {
 	Region   string            `yaml:"region"`
 	Zone     string            `yaml:"zone"`
 	Labels   map[string]string `yaml:"labels"`
+	Taints   []Taint           `yaml:"taints"`
 	Capacity ResourceCapacity  `yaml:"capacity"`
 }
 
+// Taint keeps workloads off the node unless they tolerate it
+type Taint struct {
+	Key    string `yaml:"key"`
+	Value  string `yaml:"value"`
+	Effect string `yaml:"effect"` // NoSchedule, PreferNoSchedule, NoExecute
+}
+
 // ResourceCapacity defines the node's resource capacity
 type ResourceCapacity struct {
 	CPU    string `yaml:"cpu"`    // e.g. "4" or "4000m"
@@ -50,6 +63,7 @@ type ResourceCapacity struct {
 // WeaverConfig defines connection to Weaver control plane
 type WeaverConfig struct {
 	Endpoint string        `yaml:"endpoint"`
+	Token    string        `yaml:"token"` // API token of node:<node ID>; a client certificate in tls authenticates the node otherwise
 	TLS      TLSConfig     `yaml:"tls"`
 	Timeout  time.Duration `yaml:"timeout"`
 	Retry    RetryConfig   `yaml:"retry"`
@@ -84,14 +98,29 @@ type TailscaleConfig struct {
 
 // RuntimeConfig defines container runtime configuration
 type RuntimeConfig struct {
-	Type       string        `yaml:"type"` // "containerd", "firecracker", "kata"
-	Socket     string        `yaml:"socket"`
-	Namespace  string        `yaml:"namespace"`
-	DataRoot   string        `yaml:"dataRoot"`
-	StateRoot  string        `yaml:"stateRoot"`
-	PluginDir  string        `yaml:"pluginDir"`
-	MaxProcs   int           `yaml:"maxProcs"`
-	GCInterval time.Duration `yaml:"gcInterval"`
+	Type        string        `yaml:"type"` // "containerd", "firecracker", "kata"
+	Socket      string        `yaml:"socket"`
+	Namespace   string        `yaml:"namespace"`
+	DataRoot    string        `yaml:"dataRoot"`
+	StateRoot   string        `yaml:"stateRoot"`
+	PluginDir   string        `yaml:"pluginDir"`
+	SecretRoot  string        `yaml:"secretRoot"` // tmpfs-backed directory for secret files
+	LogRoot     string        `yaml:"logRoot"`    // directory for container log files
+	LogMaxSize  string        `yaml:"logMaxSize"` // e.g. "10Mi", rotated when exceeded
+	LogMaxFiles int           `yaml:"logMaxFiles"`
+	MaxProcs    int           `yaml:"maxProcs"`
+	GCInterval  time.Duration `yaml:"gcInterval"`
+}
+
+// AgentConfig defines the agent Weaver connects to over the mesh. The agent
+// only listens on the mesh address and only serves Weaver's client certificate.
+type AgentConfig struct {
+	Enabled      bool   `yaml:"enabled"`
+	Port         int    `yaml:"port"`
+	CertFile     string `yaml:"certFile"` // certificate naming the node ID, which Weaver verifies
+	KeyFile      string `yaml:"keyFile"`
+	ClientCAFile string `yaml:"clientCaFile"` // CA that issued Weaver's client certificate
+	WeaverName   string `yaml:"weaverName"`   // common name of Weaver's client certificate
 }
 
 // MetricsConfig defines metrics configuration
@@ -150,13 +179,22 @@ func Load(path string) (*Config, error) {
 			AcceptDNS: true,
 		},
 		Runtime: RuntimeConfig{
-			Type:       "containerd",
-			Socket:     "/run/containerd/containerd.sock",
-			Namespace:  "fabric",
-			DataRoot:   "/var/lib/containerd",
-			StateRoot:  "/run/containerd",
-			MaxProcs:   0, // Use all available
-			GCInterval: 5 * time.Minute,
+			Type:        "containerd",
+			Socket:      "/run/containerd/containerd.sock",
+			Namespace:   "fabric",
+			DataRoot:    "/var/lib/containerd",
+			StateRoot:   "/run/containerd",
+			SecretRoot:  "/run/shuttle/secrets",
+			LogRoot:     "/var/log/shuttle/containers",
+			LogMaxSize:  "10Mi",
+			LogMaxFiles: 3,
+			MaxProcs:    0, // Use all available
+			GCInterval:  5 * time.Minute,
+		},
+		Agent: AgentConfig{
+			Enabled:    false, // needs certificates, see AgentConfig
+			Port:       7070,
+			WeaverName: "weaver",
 		},
 		Metrics: MetricsConfig{
 			Enabled:  true,
@@ -213,6 +251,9 @@ func (c *Config) loadFromEnv() error {
 	if endpoint := os.Getenv("WEAVER_ENDPOINT"); endpoint != "" {
 		c.Weaver.Endpoint = endpoint
 	}
+	if token := os.Getenv("WEAVER_TOKEN"); token != "" {
+		c.Weaver.Token = token
+	}
 	if authKey := os.Getenv("TAILSCALE_AUTH_KEY"); authKey != "" {
 		c.Tailscale.AuthKey = authKey
 	}
@@ -237,6 +278,21 @@ func (c *Config) validate() error {
 	if c.Runtime.Socket == "" {
 		return fmt.Errorf("runtime socket is required")
 	}
+	if _, err := quantity.ParseBytes(c.Runtime.LogMaxSize); err != nil {
+		return fmt.Errorf("invalid runtime log max size: %w", err)
+	}
+	if c.Agent.Enabled && (c.Agent.Port <= 0 || c.Agent.Port > 65535) {
+		return fmt.Errorf("invalid agent port %d", c.Agent.Port)
+	}
+	if c.Agent.Enabled && (c.Agent.CertFile == "" || c.Agent.KeyFile == "" || c.Agent.ClientCAFile == "") {
+		return fmt.Errorf("agent requires a certificate, key and client CA file")
+	}
+	if c.Agent.Enabled && c.Agent.WeaverName == "" {
+		return fmt.Errorf("agent requires the name of Weaver's client certificate")
+	}
+	if c.Agent.Enabled && !c.Tailscale.Enabled {
+		return fmt.Errorf("agent requires Tailscale, it only listens on the mesh address")
+	}
 
 	return nil
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/containerd/containerd"
@@ -11,23 +13,44 @@ import (
 	"github.com/containerd/containerd/namespaces"
 	"github.com/containerd/containerd/oci"
 
+	"github.com/codecflow/fabric/pkg/quantity"
 	"github.com/codecflow/fabric/shuttle/internal/config"
 	"github.com/codecflow/fabric/shuttle/internal/grpc"
+	"github.com/codecflow/fabric/shuttle/internal/logs"
 )
 
+// cfsPeriod is the CFS scheduling period in microseconds used for CPU limits
+const cfsPeriod = 100000
+
 // Runtime manages container lifecycle using containerd
 type Runtime struct {
 	config *config.RuntimeConfig
 	client *containerd.Client
+
+	// Log files capturing the output of running containers
+	mu         sync.Mutex
+	logWriters map[string]*logs.Writer
 }
 
 // New creates a new containerd runtime
 func New(cfg *config.RuntimeConfig) (*Runtime, error) {
 	return &Runtime{
-		config: cfg,
+		config:     cfg,
+		logWriters: make(map[string]*logs.Writer),
 	}, nil
 }
 
+// ContainerID returns the container ID of a workload
+func ContainerID(namespace, name string) string {
+	return fmt.Sprintf("%s-%s", namespace, name)
+}
+
+// LogPath returns the log file of a workload's container. Logs are kept after
+// the container stops and replaced when it starts again.
+func (r *Runtime) LogPath(namespace, name string) string {
+	return filepath.Join(r.config.LogRoot, ContainerID(namespace, name)+".log")
+}
+
 // Start initializes the containerd client
 func (r *Runtime) Start(ctx context.Context) error {
 	log.Printf("Connecting to containerd at %s", r.config.Socket)
@@ -149,6 +172,12 @@ func (r *Runtime) StopContainer(ctx context.Context, containerID string) error {
 		return fmt.Errorf("failed to delete container: %w", err)
 	}
 
+	if err := r.removeSecretFiles(containerID); err != nil {
+		log.Printf("Failed to remove secret files of container %s: %v", containerID, err)
+	}
+
+	r.closeLogWriter(containerID)
+
 	log.Printf("Container %s stopped and removed", containerID)
 	return nil
 }
@@ -168,7 +197,7 @@ func (r *Runtime) pullImage(ctx context.Context, imageRef string) (containerd.Im
 
 // createContainer creates a new container
 func (r *Runtime) createContainer(ctx context.Context, spec *grpc.WorkloadSpec, image containerd.Image) (containerd.Container, error) {
-	containerID := fmt.Sprintf("%s-%s", spec.Namespace, spec.Name)
+	containerID := ContainerID(spec.Namespace, spec.Name)
 
 	// Build OCI spec
 	opts := []oci.SpecOpts{
@@ -184,15 +213,37 @@ func (r *Runtime) createContainer(ctx context.Context, spec *grpc.WorkloadSpec,
 	// Add resource limits
 	if spec.Resources != nil {
 		if spec.Resources.CPULimit != "" {
-			// In a real implementation, parse and set CPU limits
-			log.Printf("Setting CPU limit for container %s: %s", containerID, spec.Resources.CPULimit)
+			milli, err := quantity.ParseCPU(spec.Resources.CPULimit)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cpu limit: %w", err)
+			}
+			if milli > 0 {
+				log.Printf("Setting CPU limit for container %s: %s", containerID, spec.Resources.CPULimit)
+				opts = append(opts, oci.WithCPUCFS(milli*cfsPeriod/1000, cfsPeriod))
+			}
 		}
 		if spec.Resources.MemoryLimit != "" {
-			// In a real implementation, parse and set memory limits
-			log.Printf("Setting memory limit for container %s: %s", containerID, spec.Resources.MemoryLimit)
+			limit, err := quantity.ParseBytes(spec.Resources.MemoryLimit)
+			if err != nil {
+				return nil, fmt.Errorf("invalid memory limit: %w", err)
+			}
+			if limit > 0 {
+				log.Printf("Setting memory limit for container %s: %s", containerID, spec.Resources.MemoryLimit)
+				opts = append(opts, oci.WithMemoryLimit(uint64(limit))) // nolint:gosec
+			}
 		}
 	}
 
+	// Mount secret files
+	if len(spec.SecretFiles) > 0 {
+		mounts, err := r.writeSecretFiles(containerID, spec.SecretFiles)
+		if err != nil {
+			_ = r.removeSecretFiles(containerID)
+			return nil, err
+		}
+		opts = append(opts, mounts)
+	}
+
 	container, err := r.client.NewContainer(
 		ctx,
 		containerID,
@@ -201,6 +252,7 @@ func (r *Runtime) createContainer(ctx context.Context, spec *grpc.WorkloadSpec,
 		containerd.WithNewSpec(opts...),
 	)
 	if err != nil {
+		_ = r.removeSecretFiles(containerID)
 		return nil, fmt.Errorf("failed to create container: %w", err)
 	}
 
@@ -209,9 +261,15 @@ func (r *Runtime) createContainer(ctx context.Context, spec *grpc.WorkloadSpec,
 
 // startTask starts the container task
 func (r *Runtime) startTask(ctx context.Context, container containerd.Container, spec *grpc.WorkloadSpec) (containerd.Task, error) {
-	// Create task with stdio
-	task, err := container.NewTask(ctx, cio.NewCreator(cio.WithStdio))
+	writer, err := r.openLogWriter(container.ID(), spec)
 	if err != nil {
+		return nil, err
+	}
+
+	// Create task with its output captured in the log file
+	task, err := container.NewTask(ctx, cio.NewCreator(cio.WithStreams(nil, writer.Stream("stdout"), writer.Stream("stderr"))))
+	if err != nil {
+		r.closeLogWriter(container.ID())
 		return nil, fmt.Errorf("failed to create task: %w", err)
 	}
 
@@ -220,12 +278,51 @@ func (r *Runtime) startTask(ctx context.Context, container containerd.Container,
 		if _, err := task.Delete(ctx); err != nil {
 			log.Printf("Failed to delete task after start failure: %v", err)
 		}
+		r.closeLogWriter(container.ID())
 		return nil, fmt.Errorf("failed to start task: %w", err)
 	}
 
 	return task, nil
 }
 
+// openLogWriter starts a fresh log file for a container
+func (r *Runtime) openLogWriter(containerID string, spec *grpc.WorkloadSpec) (*logs.Writer, error) {
+	maxSize, err := quantity.ParseBytes(r.config.LogMaxSize)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log max size: %w", err)
+	}
+
+	path := r.LogPath(spec.Namespace, spec.Name)
+	if err := logs.Remove(path); err != nil {
+		log.Printf("Failed to remove previous logs of container %s: %v", containerID, err)
+	}
+
+	writer, err := logs.NewWriter(path, maxSize, r.config.LogMaxFiles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log file: %w", err)
+	}
+
+	r.mu.Lock()
+	r.logWriters[containerID] = writer
+	r.mu.Unlock()
+
+	return writer, nil
+}
+
+// closeLogWriter closes a container's log file, keeping it on disk
+func (r *Runtime) closeLogWriter(containerID string) {
+	r.mu.Lock()
+	writer, ok := r.logWriters[containerID]
+	delete(r.logWriters, containerID)
+	r.mu.Unlock()
+
+	if ok {
+		if err := writer.Close(); err != nil {
+			log.Printf("Failed to close log file of container %s: %v", containerID, err)
+		}
+	}
+}
+
 // waitForRunning waits for the task to be in running state
 func (r *Runtime) waitForRunning(ctx context.Context, task containerd.Task) error {
 	timeout := time.After(30 * time.Second)
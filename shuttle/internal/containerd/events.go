@@ -0,0 +1,62 @@
+package containerd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	apievents "github.com/containerd/containerd/api/events"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/typeurl/v2"
+)
+
+// Exit describes a container whose task exited
+type Exit struct {
+	ContainerID string
+	ExitCode    int32
+	ExitedAt    time.Time
+}
+
+// WatchExits calls handler for each container task that exits in the
+// configured namespace until ctx is cancelled or the event stream fails
+func (r *Runtime) WatchExits(ctx context.Context, handler func(Exit)) error {
+	if r.client == nil {
+		return fmt.Errorf("containerd client not initialized")
+	}
+
+	ctx = namespaces.WithNamespace(ctx, r.config.Namespace)
+	filter := fmt.Sprintf("topic==%q,namespace==%q", "/tasks/exit", r.config.Namespace)
+	envelopes, errs := r.client.Subscribe(ctx, filter)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errs:
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("task exit events failed: %w", err)
+		case envelope := <-envelopes:
+			event, err := typeurl.UnmarshalAny(envelope.Event)
+			if err != nil {
+				log.Printf("Failed to decode task exit event: %v", err)
+				continue
+			}
+
+			// Processes started through exec exit too; only the init process
+			// ends the container
+			exit, ok := event.(*apievents.TaskExit)
+			if !ok || exit.ID != exit.ContainerID {
+				continue
+			}
+
+			handler(Exit{
+				ContainerID: exit.ContainerID,
+				ExitCode:    int32(exit.ExitStatus), // nolint:gosec
+				ExitedAt:    exit.ExitedAt.AsTime(),
+			})
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package containerd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/cio"
+	"github.com/containerd/containerd/namespaces"
+)
+
+// ExecOptions describes a command to run in a workload's container
+type ExecOptions struct {
+	Command []string
+	TTY     bool
+	Stdin   io.Reader // nil when the command gets no input
+	Stdout  io.Writer
+	Stderr  io.Writer // unused with a TTY, which merges it into Stdout
+	Resize  <-chan TerminalSize
+}
+
+// TerminalSize is the size of an exec terminal in characters
+type TerminalSize struct {
+	Width  uint16
+	Height uint16
+}
+
+// Exec runs a command in the container of a workload and returns its exit
+// code. The command is killed when the context ends.
+func (r *Runtime) Exec(ctx context.Context, namespace, name string, opts ExecOptions) (int, error) { // nolint:gocyclo
+	if r.client == nil {
+		return 0, fmt.Errorf("containerd client not initialized")
+	}
+
+	ctx = namespaces.WithNamespace(ctx, r.config.Namespace)
+	// Cleanup must still reach containerd after the caller went away
+	cleanupCtx := namespaces.WithNamespace(context.Background(), r.config.Namespace)
+
+	containerID := ContainerID(namespace, name)
+	container, task, err := r.loadTask(ctx, containerID)
+	if err != nil {
+		return 0, err
+	}
+
+	spec, err := container.Spec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get container spec: %w", err)
+	}
+	processSpec := *spec.Process
+	processSpec.Args = opts.Command
+	processSpec.Terminal = opts.TTY
+
+	// The shim keeps stdin open until told otherwise, so the end of the input
+	// has to be passed on explicitly once the process exists
+	var process containerd.Process
+	created := make(chan struct{})
+	stdin := opts.Stdin
+	if stdin != nil {
+		stdin = &stdinCloser{
+			reader: opts.Stdin,
+			close: func() {
+				<-created
+				if process != nil {
+					_ = process.CloseIO(cleanupCtx, containerd.WithStdinCloser)
+				}
+			},
+		}
+	}
+
+	ioOpts := []cio.Opt{cio.WithStreams(stdin, opts.Stdout, opts.Stderr)}
+	if opts.TTY {
+		ioOpts = append(ioOpts, cio.WithTerminal)
+	}
+
+	execID := fmt.Sprintf("exec-%d", time.Now().UnixNano())
+	process, err = task.Exec(ctx, execID, &processSpec, cio.NewCreator(ioOpts...))
+	close(created)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create exec process: %w", err)
+	}
+	defer func() {
+		if _, err := process.Delete(cleanupCtx); err != nil {
+			log.Printf("Failed to delete exec process %s of container %s: %v", execID, containerID, err)
+		}
+	}()
+
+	statusC, err := process.Wait(cleanupCtx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to wait for exec process: %w", err)
+	}
+
+	if err := process.Start(ctx); err != nil {
+		return 0, fmt.Errorf("failed to start exec process: %w", err)
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	if opts.TTY && opts.Resize != nil {
+		go func() {
+			for {
+				select {
+				case <-done:
+					return
+				case size, ok := <-opts.Resize:
+					if !ok {
+						return
+					}
+					if err := process.Resize(ctx, uint32(size.Width), uint32(size.Height)); err != nil {
+						log.Printf("Failed to resize exec terminal of container %s: %v", containerID, err)
+					}
+				}
+			}
+		}()
+	}
+
+	var status containerd.ExitStatus
+	select {
+	case status = <-statusC:
+	case <-ctx.Done():
+		if err := process.Kill(cleanupCtx, syscall.SIGKILL); err != nil {
+			log.Printf("Failed to kill exec process %s of container %s: %v", execID, containerID, err)
+		}
+		status = <-statusC
+	}
+
+	// All output is copied before the exit code is reported
+	process.IO().Wait()
+
+	code, _, err := status.Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get exec exit status: %w", err)
+	}
+	return int(code), nil
+}
+
+// Dial connects to a port on the loopback interface of a workload's
+// container, which has a network namespace of its own
+func (r *Runtime) Dial(ctx context.Context, namespace, name string, port uint16) (net.Conn, error) {
+	if r.client == nil {
+		return nil, fmt.Errorf("containerd client not initialized")
+	}
+
+	ctx = namespaces.WithNamespace(ctx, r.config.Namespace)
+
+	_, task, err := r.loadTask(ctx, ContainerID(namespace, name))
+	if err != nil {
+		return nil, err
+	}
+
+	return dialInNetNS(ctx, task.Pid(), port)
+}
+
+// loadTask returns a container and its running task
+func (r *Runtime) loadTask(ctx context.Context, containerID string) (containerd.Container, containerd.Task, error) {
+	container, err := r.client.LoadContainer(ctx, containerID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load container: %w", err)
+	}
+
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("container %s is not running: %w", containerID, err)
+	}
+
+	return container, task, nil
+}
+
+// stdinCloser calls close when the input ends or fails
+type stdinCloser struct {
+	reader io.Reader
+	close  func()
+}
+
+func (s *stdinCloser) Read(p []byte) (int, error) {
+	n, err := s.reader.Read(p)
+	if err != nil {
+		s.close()
+	}
+	return n, err
+}
@@ -0,0 +1,47 @@
+package containerd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"runtime"
+	"strconv"
+
+	"golang.org/x/sys/unix"
+)
+
+// dialInNetNS connects to port on the loopback interface of the network
+// namespace of process pid
+func dialInNetNS(ctx context.Context, pid uint32, port uint16) (net.Conn, error) {
+	netns, err := os.Open(fmt.Sprintf("/proc/%d/ns/net", pid))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open network namespace: %w", err)
+	}
+	defer func() { _ = netns.Close() }()
+
+	type result struct {
+		conn net.Conn
+		err  error
+	}
+	resultC := make(chan result, 1)
+
+	go func() {
+		// The thread joins the container's namespace and is never unlocked, so
+		// it exits with the goroutine instead of running other goroutines there.
+		// A socket stays in the namespace it was created in.
+		runtime.LockOSThread()
+
+		if err := unix.Setns(int(netns.Fd()), unix.CLONE_NEWNET); err != nil { // nolint:gosec
+			resultC <- result{err: fmt.Errorf("failed to enter network namespace: %w", err)}
+			return
+		}
+
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(int(port))))
+		resultC <- result{conn: conn, err: err}
+	}()
+
+	r := <-resultC
+	return r.conn, r.err
+}
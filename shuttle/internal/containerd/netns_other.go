@@ -0,0 +1,14 @@
+//go:build !linux
+
+package containerd
+
+import (
+	"context"
+	"fmt"
+	"net"
+)
+
+// dialInNetNS is only supported on Linux
+func dialInNetNS(ctx context.Context, pid uint32, port uint16) (net.Conn, error) {
+	return nil, fmt.Errorf("port forwarding is only supported on linux")
+}
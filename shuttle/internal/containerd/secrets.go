@@ -0,0 +1,54 @@
+package containerd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/containerd/containerd/oci"
+	"github.com/opencontainers/runtime-spec/specs-go"
+
+	"github.com/codecflow/fabric/shuttle/internal/grpc"
+)
+
+// secretDir returns the host directory holding a container's secret files
+func (r *Runtime) secretDir(containerID string) string {
+	return filepath.Join(r.config.SecretRoot, containerID)
+}
+
+// writeSecretFiles writes a workload's secret files to tmpfs and returns the
+// read-only bind mounts that expose them in the container
+func (r *Runtime) writeSecretFiles(containerID string, files []grpc.SecretFile) (oci.SpecOpts, error) {
+	if err := ensureTmpfs(r.config.SecretRoot); err != nil {
+		return nil, fmt.Errorf("secret root %s: %w", r.config.SecretRoot, err)
+	}
+
+	dir := r.secretDir(containerID)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create secret directory: %w", err)
+	}
+
+	mounts := make([]specs.Mount, 0, len(files))
+	for i, f := range files {
+		source := filepath.Join(dir, strconv.Itoa(i))
+		// Readable by any container user; the directory keeps other host users out
+		if err := os.WriteFile(source, f.Value, 0o444); err != nil { // nolint:gosec
+			return nil, fmt.Errorf("failed to write secret file for %s: %w", f.Path, err)
+		}
+
+		mounts = append(mounts, specs.Mount{
+			Destination: f.Path,
+			Type:        "bind",
+			Source:      source,
+			Options:     []string{"rbind", "ro", "nosuid", "nodev", "noexec"},
+		})
+	}
+
+	return oci.WithMounts(mounts), nil
+}
+
+// removeSecretFiles deletes a container's secret files
+func (r *Runtime) removeSecretFiles(containerID string) error {
+	return os.RemoveAll(r.secretDir(containerID))
+}
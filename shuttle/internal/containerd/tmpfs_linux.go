@@ -0,0 +1,31 @@
+package containerd
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// tmpfsMagic is the filesystem type reported by statfs for tmpfs
+const tmpfsMagic = 0x01021994
+
+// ensureTmpfs makes sure dir is backed by tmpfs so secret values never reach disk,
+// mounting a private tmpfs there if needed
+func ensureTmpfs(dir string) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return fmt.Errorf("failed to stat filesystem: %w", err)
+	}
+	if stat.Type == tmpfsMagic {
+		return nil
+	}
+
+	if err := syscall.Mount("tmpfs", dir, "tmpfs", syscall.MS_NOSUID|syscall.MS_NODEV|syscall.MS_NOEXEC, "mode=0700"); err != nil {
+		return fmt.Errorf("failed to mount tmpfs: %w", err)
+	}
+	return nil
+}
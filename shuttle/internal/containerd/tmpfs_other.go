@@ -0,0 +1,10 @@
+//go:build !linux
+
+package containerd
+
+import "fmt"
+
+// ensureTmpfs is only supported on Linux
+func ensureTmpfs(dir string) error {
+	return fmt.Errorf("secret files require a tmpfs, which is only supported on linux")
+}
@@ -2,32 +2,79 @@ package grpc
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
 	"log"
+	"os"
+	"sort"
 	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/codecflow/fabric/pkg/proto/weaver"
 	"github.com/codecflow/fabric/shuttle/internal/config"
 )
 
+// ErrNodeNotRegistered is returned when Weaver no longer knows this node
+var ErrNodeNotRegistered = errors.New("node is not registered with weaver")
+
 // Client manages gRPC communication with Weaver
 type Client struct {
 	config *config.WeaverConfig
+	conn   *grpc.ClientConn
+	nodes  weaver.NodeServiceClient
 }
 
 // New creates a new gRPC client
 func New(cfg *config.WeaverConfig) (*Client, error) {
+	creds, err := transportCredentials(&cfg.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS credentials: %w", err)
+	}
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if cfg.Token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials{token: cfg.Token, secure: cfg.TLS.Enabled}))
+	}
+
+	// The connection is established lazily on the first call
+	conn, err := grpc.NewClient(cfg.Endpoint, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connection to %s: %w", cfg.Endpoint, err)
+	}
+
 	return &Client{
 		config: cfg,
+		conn:   conn,
+		nodes:  weaver.NewNodeServiceClient(conn),
 	}, nil
 }
 
+// Close closes the connection to Weaver
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
 // NodeInfo represents node information for registration
 type NodeInfo struct {
-	ID       string                  `json:"id"`
-	Name     string                  `json:"name"`
-	Region   string                  `json:"region"`
-	Zone     string                  `json:"zone"`
-	Labels   map[string]string       `json:"labels"`
-	Capacity config.ResourceCapacity `json:"capacity"`
+	ID        string                  `json:"id"`
+	Name      string                  `json:"name"`
+	Region    string                  `json:"region"`
+	Zone      string                  `json:"zone"`
+	Labels    map[string]string       `json:"labels"`
+	Taints    []config.Taint          `json:"taints"`
+	Capacity  config.ResourceCapacity `json:"capacity"`
+	Address   string                  `json:"address"`
+	AgentPort int32                   `json:"agentPort"`
+	Version   string                  `json:"version"`
 }
 
 // WorkloadSpec represents a workload specification
@@ -39,6 +86,18 @@ type WorkloadSpec struct {
 	Command   []string          `json:"command"`
 	Env       []string          `json:"env"`
 	Resources *ResourceRequests `json:"resources"`
+
+	// RestartPolicy is Always, OnFailure or Never; empty restarts always
+	RestartPolicy string `json:"restartPolicy"`
+
+	// Secret files are never logged or persisted by the node
+	SecretFiles []SecretFile `json:"-"`
+}
+
+// SecretFile is a secret value mounted read-only at Path in the container
+type SecretFile struct {
+	Path  string
+	Value []byte
 }
 
 // ResourceRequests represents resource requirements
@@ -50,10 +109,15 @@ type ResourceRequests struct {
 
 // WorkloadStatus represents workload status report
 type WorkloadStatus struct {
-	WorkloadID string    `json:"workloadId"`
-	NodeID     string    `json:"nodeId"`
-	Status     string    `json:"status"`
-	UpdatedAt  time.Time `json:"updatedAt"`
+	WorkloadID   string    `json:"workloadId"`
+	NodeID       string    `json:"nodeId"`
+	Status       string    `json:"status"`
+	ContainerID  string    `json:"containerId"`
+	Message      string    `json:"message"`
+	Reason       string    `json:"reason"`
+	ExitCode     int32     `json:"exitCode"`
+	RestartCount int32     `json:"restartCount"`
+	UpdatedAt    time.Time `json:"updatedAt"`
 }
 
 // NodeHealth represents node health report
@@ -61,42 +125,288 @@ type NodeHealth struct {
 	NodeID        string    `json:"nodeId"`
 	Status        string    `json:"status"`
 	WorkloadCount int       `json:"workloadCount"`
+	WorkloadIDs   []string  `json:"workloadIds"`
 	Timestamp     time.Time `json:"timestamp"`
 }
 
-// RegisterNode registers this node with Weaver
-func (c *Client) RegisterNode(ctx context.Context, nodeInfo *NodeInfo) error {
-	// In a real implementation, this would make a gRPC call to Weaver
-	// For now, we'll simulate the registration
-	log.Printf("Registering node %s with Weaver at %s\n", nodeInfo.ID, c.config.Endpoint)
-	return nil
+// RegisterNode registers this node with Weaver and returns the heartbeat interval it should use
+func (c *Client) RegisterNode(ctx context.Context, nodeInfo *NodeInfo) (time.Duration, error) {
+	req := &weaver.RegisterNodeRequest{
+		NodeId:    nodeInfo.ID,
+		Name:      nodeInfo.Name,
+		Region:    nodeInfo.Region,
+		Zone:      nodeInfo.Zone,
+		Labels:    nodeInfo.Labels,
+		Address:   nodeInfo.Address,
+		Version:   nodeInfo.Version,
+		AgentPort: nodeInfo.AgentPort,
+		Capacity: &weaver.NodeResources{
+			Cpu:    nodeInfo.Capacity.CPU,
+			Memory: nodeInfo.Capacity.Memory,
+			Gpu:    nodeInfo.Capacity.GPU,
+			Disk:   nodeInfo.Capacity.Disk,
+		},
+	}
+
+	for _, taint := range nodeInfo.Taints {
+		req.Taints = append(req.Taints, &weaver.NodeTaint{
+			Key:    taint.Key,
+			Value:  taint.Value,
+			Effect: taint.Effect,
+		})
+	}
+
+	var resp *weaver.RegisterNodeResponse
+	err := c.call(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.nodes.RegisterNode(ctx, req)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to register node: %w", err)
+	}
+
+	log.Printf("Registered node %s with Weaver at %s", resp.NodeId, c.config.Endpoint)
+	return time.Duration(resp.HeartbeatIntervalSeconds) * time.Second, nil
 }
 
 // UnregisterNode unregisters this node from Weaver
 func (c *Client) UnregisterNode(ctx context.Context, nodeID string) error {
-	// In a real implementation, this would make a gRPC call to Weaver
-	log.Printf("Unregistering node %s from Weaver\n", nodeID)
+	err := c.call(ctx, func(ctx context.Context) error {
+		_, err := c.nodes.UnregisterNode(ctx, &weaver.UnregisterNodeRequest{NodeId: nodeID})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to unregister node: %w", err)
+	}
 	return nil
 }
 
-// GetAssignedWorkloads retrieves workloads assigned to this node
-func (c *Client) GetAssignedWorkloads(ctx context.Context, nodeID string) ([]*WorkloadSpec, error) {
-	// In a real implementation, this would make a gRPC call to Weaver
-	// For now, return empty list
-	return []*WorkloadSpec{}, nil
+// WatchAssignments streams the workloads assigned to this node, calling handler with
+// the full assignment set each time it changes. It returns when the stream ends.
+func (c *Client) WatchAssignments(ctx context.Context, nodeID string, handler func([]*WorkloadSpec) error) error {
+	stream, err := c.nodes.WatchAssignments(ctx, &weaver.WatchAssignmentsRequest{NodeId: nodeID})
+	if err != nil {
+		return fmt.Errorf("failed to watch assignments: %w", err)
+	}
+
+	for {
+		assignments, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to receive assignments: %w", err)
+		}
+
+		specs := make([]*WorkloadSpec, 0, len(assignments.Workloads))
+		for _, a := range assignments.Workloads {
+			specs = append(specs, convertAssignment(a))
+		}
+
+		if err := handler(specs); err != nil {
+			log.Printf("Error handling assignments: %v", err)
+		}
+	}
 }
 
 // ReportWorkloadStatus reports workload status to Weaver
-func (c *Client) ReportWorkloadStatus(ctx context.Context, status *WorkloadStatus) error {
-	// In a real implementation, this would make a gRPC call to Weaver
-	log.Printf("Reporting workload status: %s = %s\n", status.WorkloadID, status.Status)
+func (c *Client) ReportWorkloadStatus(ctx context.Context, workloadStatus *WorkloadStatus) error {
+	req := &weaver.ReportWorkloadStatusRequest{
+		NodeId:       workloadStatus.NodeID,
+		WorkloadId:   workloadStatus.WorkloadID,
+		Status:       workloadStatus.Status,
+		ContainerId:  workloadStatus.ContainerID,
+		Message:      workloadStatus.Message,
+		ExitCode:     workloadStatus.ExitCode,
+		Timestamp:    timestamppb.New(workloadStatus.UpdatedAt),
+		RestartCount: workloadStatus.RestartCount,
+		Reason:       workloadStatus.Reason,
+	}
+
+	err := c.call(ctx, func(ctx context.Context) error {
+		_, err := c.nodes.ReportWorkloadStatus(ctx, req)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to report status of workload %s: %w", workloadStatus.WorkloadID, err)
+	}
 	return nil
 }
 
-// ReportHealth reports node health to Weaver
-func (c *Client) ReportHealth(ctx context.Context, health *NodeHealth) error {
-	// In a real implementation, this would make a gRPC call to Weaver
-	log.Printf("Reporting node health: %s = %s (%d workloads)\n",
-		health.NodeID, health.Status, health.WorkloadCount)
-	return nil
+// ReportHealth reports node health to Weaver and returns the IDs of workloads
+// this node runs but no longer owns, which must be stopped
+func (c *Client) ReportHealth(ctx context.Context, health *NodeHealth) ([]string, error) {
+	req := &weaver.HeartbeatRequest{
+		NodeId:        health.NodeID,
+		Status:        health.Status,
+		WorkloadCount: int32(health.WorkloadCount), // nolint:gosec
+		WorkloadIds:   health.WorkloadIDs,
+		Timestamp:     timestamppb.New(health.Timestamp),
+	}
+
+	var resp *weaver.HeartbeatResponse
+	err := c.call(ctx, func(ctx context.Context) error {
+		var err error
+		resp, err = c.nodes.Heartbeat(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send heartbeat: %w", err)
+	}
+
+	if !resp.Registered {
+		return nil, ErrNodeNotRegistered
+	}
+	return resp.FencedWorkloadIds, nil
+}
+
+// call runs a unary RPC with the configured timeout, retrying transient failures
+func (c *Client) call(ctx context.Context, fn func(ctx context.Context) error) error {
+	attempts := c.config.Retry.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+	backoff := c.config.Retry.Backoff
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		callCtx, cancel := c.withTimeout(ctx)
+		err = fn(callCtx)
+		cancel()
+
+		if err == nil || !retryable(err) || attempt == attempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+
+	return err
+}
+
+// withTimeout bounds a single RPC by the configured timeout
+func (c *Client) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.config.Timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, c.config.Timeout)
+}
+
+// retryable reports whether an RPC error is worth retrying
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+// transportCredentials builds the gRPC transport credentials from the TLS configuration
+func transportCredentials(cfg *config.TLSConfig) (credentials.TransportCredentials, error) {
+	if !cfg.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName: cfg.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if cfg.CertFile != "" && cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if cfg.CAFile != "" {
+		ca, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("failed to parse CA file %s", cfg.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// tokenCredentials sends the API token as a bearer token with every call
+type tokenCredentials struct {
+	token  string
+	secure bool
+}
+
+func (c tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + c.token}, nil
+}
+
+// RequireTransportSecurity allows the token over plaintext connections when
+// TLS is disabled, e.g. inside a private network
+func (c tokenCredentials) RequireTransportSecurity() bool {
+	return c.secure
+}
+
+// convertAssignment converts a protobuf workload assignment to a WorkloadSpec
+func convertAssignment(a *weaver.WorkloadAssignment) *WorkloadSpec {
+	spec := &WorkloadSpec{
+		ID:        a.Id,
+		Name:      a.Name,
+		Namespace: a.Namespace,
+	}
+
+	if a.Spec == nil {
+		return spec
+	}
+
+	spec.Image = a.Spec.Image
+	spec.RestartPolicy = a.Spec.RestartPolicy
+	spec.Command = append(append([]string{}, a.Spec.Command...), a.Spec.Args...)
+
+	// Secret variables were resolved by Weaver and never clash with plain ones
+	env := make(map[string]string, len(a.Spec.Env)+len(a.SecretEnv))
+	for key, value := range a.Spec.Env {
+		env[key] = value
+	}
+	for key, value := range a.SecretEnv {
+		env[key] = value
+	}
+
+	// Sort keys so the container environment is stable across assignments
+	keys := make([]string, 0, len(env))
+	for key := range env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		spec.Env = append(spec.Env, fmt.Sprintf("%s=%s", key, env[key]))
+	}
+
+	for _, f := range a.SecretFiles {
+		spec.SecretFiles = append(spec.SecretFiles, SecretFile{Path: f.Path, Value: f.Value})
+	}
+
+	if a.Spec.Resources != nil {
+		spec.Resources = &ResourceRequests{
+			CPULimit:    a.Spec.Resources.Cpu,
+			MemoryLimit: a.Spec.Resources.Memory,
+			GPULimit:    a.Spec.Resources.Gpu,
+		}
+	}
+
+	return spec
 }
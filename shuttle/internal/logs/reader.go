@@ -0,0 +1,199 @@
+package logs
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// pollInterval is how often a followed log file is checked for new lines
+const pollInterval = 500 * time.Millisecond
+
+// Options selects which lines to read
+type Options struct {
+	Tail   int64     // only the last lines when positive
+	Since  time.Time // only lines written after this time when set
+	Follow bool      // keep reading new lines until the context ends
+}
+
+// Line is a single stored line of container output
+type Line struct {
+	Time   time.Time
+	Stream string
+	Text   string
+}
+
+// Read calls emit for the stored lines of the log at path, oldest first,
+// including the rotated files. With Follow it keeps reading new lines, across
+// rotations, until the context ends.
+func Read(ctx context.Context, path string, opts Options, emit func(Line) error) error { // nolint:gocyclo
+	files := rotatedFiles(path)
+
+	var tail []Line
+	collect := func(line Line) error {
+		if !opts.Since.IsZero() && line.Time.Before(opts.Since) {
+			return nil
+		}
+		if opts.Tail <= 0 {
+			return emit(line)
+		}
+		tail = append(tail, line)
+		if int64(len(tail)) > opts.Tail {
+			tail = tail[1:]
+		}
+		return nil
+	}
+
+	for _, file := range files {
+		if err := readFile(file, collect); err != nil {
+			return err
+		}
+	}
+
+	// The current file is kept open for following
+	current, offset, err := openCurrent(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if current != nil {
+			_ = current.Close()
+		}
+	}()
+	if current == nil && len(files) == 0 && !opts.Follow {
+		return fmt.Errorf("no logs found")
+	}
+	if current != nil {
+		if offset, err = readFrom(current, 0, collect); err != nil {
+			return err
+		}
+	}
+
+	for _, line := range tail {
+		if err := emit(line); err != nil {
+			return err
+		}
+	}
+
+	if !opts.Follow {
+		return nil
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if current != nil {
+			if offset, err = readFrom(current, offset, emit); err != nil {
+				return err
+			}
+		}
+
+		// A new file at path means the log was rotated; the old one is fully read
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if current != nil {
+			if openInfo, err := current.Stat(); err == nil && os.SameFile(info, openInfo) {
+				continue
+			}
+			_ = current.Close()
+		}
+
+		if current, offset, err = openCurrent(path); err != nil {
+			return err
+		}
+	}
+}
+
+// rotatedFiles returns the rotated files of path, oldest first
+func rotatedFiles(path string) []string {
+	var files []string
+	for i := 1; ; i++ {
+		file := rotatedPath(path, i)
+		if _, err := os.Stat(file); err != nil {
+			break
+		}
+		files = append([]string{file}, files...)
+	}
+	return files
+}
+
+// openCurrent opens the current log file, returning nil if it does not exist
+func openCurrent(path string) (*os.File, int64, error) {
+	file, err := os.Open(path) // nolint:gosec
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open log file: %w", err)
+	}
+	return file, 0, nil
+}
+
+// readFile reads all lines of a rotated file
+func readFile(path string, emit func(Line) error) error {
+	file, err := os.Open(path) // nolint:gosec
+	if err != nil {
+		// The file was rotated away while reading
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	_, err = readFrom(file, 0, emit)
+	return err
+}
+
+// readFrom reads the complete lines after offset and returns the offset after
+// the last one, so a line still being written is read on the next call
+func readFrom(file *os.File, offset int64, emit func(Line) error) (int64, error) {
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return offset, fmt.Errorf("failed to seek log file: %w", err)
+	}
+
+	reader := bufio.NewReader(file)
+	for {
+		data, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			return offset, nil
+		}
+		if err != nil {
+			return offset, fmt.Errorf("failed to read log file: %w", err)
+		}
+		offset += int64(len(data))
+
+		if err := emit(parseLine(bytes.TrimSuffix(data, []byte("\n")))); err != nil {
+			return offset, err
+		}
+	}
+}
+
+// parseLine parses a stored "<time> <stream> <text>" line
+func parseLine(data []byte) Line {
+	parts := strings.SplitN(string(data), " ", 3)
+	if len(parts) < 3 {
+		return Line{Text: string(data)}
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return Line{Text: string(data)}
+	}
+	return Line{Time: t, Stream: parts[1], Text: parts[2]}
+}
@@ -0,0 +1,172 @@
+package logs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Writer appends container output to a log file, rotating it when it grows
+// past maxSize and keeping maxFiles rotated files next to it. Each line is
+// stored as "<RFC 3339 time> <stream> <text>".
+type Writer struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewWriter opens or creates the log file at path
+func NewWriter(path string, maxSize int64, maxFiles int) (*Writer, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	w := &Writer{path: path, maxSize: maxSize, maxFiles: maxFiles}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Stream returns a writer for one output stream, such as stdout or stderr
+func (w *Writer) Stream(name string) io.Writer {
+	return &streamWriter{log: w, name: name}
+}
+
+// Close closes the log file
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+// writeLine appends a single line, rotating first if the file is full
+func (w *Writer) writeLine(stream string, text []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return os.ErrClosed
+	}
+
+	line := make([]byte, 0, len(text)+64)
+	line = time.Now().UTC().AppendFormat(line, time.RFC3339Nano)
+	line = append(line, ' ')
+	line = append(line, stream...)
+	line = append(line, ' ')
+	line = append(line, text...)
+	line = append(line, '\n')
+
+	if w.maxSize > 0 && w.size > 0 && w.size+int64(len(line)) > w.maxSize {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := w.file.Write(line)
+	w.size += int64(n)
+	return err
+}
+
+// open opens the current log file for appending
+func (w *Writer) open() error {
+	file, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640) // nolint:gosec
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to stat log file: %w", err)
+	}
+
+	w.file = file
+	w.size = info.Size()
+	return nil
+}
+
+// rotate shifts path.N-1 to path.N, the current file to path.1 and reopens path
+func (w *Writer) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return fmt.Errorf("failed to close log file: %w", err)
+	}
+
+	if w.maxFiles > 0 {
+		for i := w.maxFiles - 1; i > 0; i-- {
+			_ = os.Rename(rotatedPath(w.path, i), rotatedPath(w.path, i+1))
+		}
+		if err := os.Rename(w.path, rotatedPath(w.path, 1)); err != nil {
+			return fmt.Errorf("failed to rotate log file: %w", err)
+		}
+	} else if err := os.Remove(w.path); err != nil {
+		return fmt.Errorf("failed to rotate log file: %w", err)
+	}
+
+	return w.open()
+}
+
+// Remove deletes the log file at path and its rotated files
+func Remove(path string) error {
+	for _, file := range append(rotatedFiles(path), path) {
+		if err := os.Remove(file); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove log file: %w", err)
+		}
+	}
+	return nil
+}
+
+// rotatedPath returns the path of the i-th rotated file
+func rotatedPath(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
+
+// streamWriter splits one stream's output into lines
+type streamWriter struct {
+	log     *Writer
+	name    string
+	pending []byte
+}
+
+// maxLineLength bounds lines without a newline so a single write cannot grow
+// the buffer without limit
+const maxLineLength = 64 * 1024
+
+func (s *streamWriter) Write(p []byte) (int, error) {
+	s.pending = append(s.pending, p...)
+
+	for {
+		i := bytes.IndexByte(s.pending, '\n')
+		if i < 0 {
+			break
+		}
+		if err := s.log.writeLine(s.name, s.pending[:i]); err != nil {
+			return 0, err
+		}
+		s.pending = s.pending[i+1:]
+	}
+
+	for len(s.pending) >= maxLineLength {
+		if err := s.log.writeLine(s.name, s.pending[:maxLineLength]); err != nil {
+			return 0, err
+		}
+		s.pending = s.pending[maxLineLength:]
+	}
+
+	return len(p), nil
+}
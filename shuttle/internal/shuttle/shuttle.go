@@ -2,11 +2,14 @@ package shuttle
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"sync"
 	"time"
 
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/shuttle/internal/agent"
 	"github.com/codecflow/fabric/shuttle/internal/config"
 	"github.com/codecflow/fabric/shuttle/internal/containerd"
 	"github.com/codecflow/fabric/shuttle/internal/grpc"
@@ -23,11 +26,15 @@ type Shuttle struct {
 	runtime    *containerd.Runtime
 	grpcClient *grpc.Client
 	metrics    *metrics.Server
+	agent      *agent.Server
 
 	// State management
 	mu        sync.RWMutex
 	workloads map[string]*WorkloadInstance
 	stopping  bool
+
+	// Heartbeat interval requested by Weaver
+	heartbeatInterval time.Duration
 }
 
 // WorkloadInstance represents a running workload
@@ -39,24 +46,44 @@ type WorkloadInstance struct {
 	Status      WorkloadStatus
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+
+	// Restarts under the workload's restart policy
+	RestartCount int32
+	ExitCode     int32 // of the last exited container
+	Reason       string
+	Message      string
+
+	spec      *grpc.WorkloadSpec
+	startedAt time.Time // of the current container
+	crashes   int       // consecutive exits shortly after starting
 }
 
 // WorkloadStatus represents the status of a workload
 type WorkloadStatus string
 
+const (
+	defaultHeartbeatInterval = 30 * time.Second
+	maxWatchBackoff          = 30 * time.Second
+)
+
 const (
 	WorkloadStatusPending WorkloadStatus = "Pending"
 	WorkloadStatusRunning WorkloadStatus = "Running"
 	WorkloadStatusStopped WorkloadStatus = "Stopped"
 	WorkloadStatusFailed  WorkloadStatus = "Failed"
 	WorkloadStatusUnknown WorkloadStatus = "Unknown"
+
+	// WorkloadStatusRestarting workloads wait out a backoff before their
+	// container is started again
+	WorkloadStatusRestarting WorkloadStatus = "Restarting"
 )
 
 // New creates a new Shuttle instance
 func New(cfg *config.Config) (*Shuttle, error) {
 	s := &Shuttle{
-		config:    cfg,
-		workloads: make(map[string]*WorkloadInstance),
+		config:            cfg,
+		workloads:         make(map[string]*WorkloadInstance),
+		heartbeatInterval: defaultHeartbeatInterval,
 	}
 
 	// Initialize Tailscale client
@@ -82,6 +109,15 @@ func New(cfg *config.Config) (*Shuttle, error) {
 	}
 	s.grpcClient = grpcClient
 
+	// Initialize agent server
+	if cfg.Agent.Enabled {
+		agentServer, err := agent.New(&cfg.Agent, runtime)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create agent server: %w", err)
+		}
+		s.agent = agentServer
+	}
+
 	// Initialize metrics server
 	if cfg.Metrics.Enabled {
 		metrics, err := metrics.New(&cfg.Metrics)
@@ -97,6 +133,7 @@ func New(cfg *config.Config) (*Shuttle, error) {
 // Run starts the shuttle and runs until context is canceled
 func (s *Shuttle) Run(ctx context.Context) error {
 	log.Printf("Starting Shuttle node: %s", s.config.Node.ID)
+	defer func() { _ = s.grpcClient.Close() }()
 
 	// Start Tailscale if enabled
 	if s.tailscale != nil {
@@ -123,6 +160,15 @@ func (s *Shuttle) Run(ctx context.Context) error {
 		defer func() { _ = s.metrics.Stop() }()
 	}
 
+	// Start agent server, reachable over the mesh only
+	if s.agent != nil {
+		log.Printf("Starting agent server on port %d...", s.config.Agent.Port)
+		if err := s.agent.Start(ctx, s.meshAddress(ctx)); err != nil {
+			return fmt.Errorf("failed to start agent server: %w", err)
+		}
+		defer func() { _ = s.agent.Stop() }()
+	}
+
 	// Register with Weaver
 	log.Println("Registering with Weaver...")
 	if err := s.registerWithWeaver(ctx); err != nil {
@@ -131,7 +177,8 @@ func (s *Shuttle) Run(ctx context.Context) error {
 
 	// Start workload management loop
 	log.Println("Starting workload management...")
-	go s.workloadLoop(ctx)
+	go s.exitLoop(ctx)
+	go s.watchLoop(ctx)
 
 	// Start health reporting
 	go s.healthLoop(ctx)
@@ -149,8 +196,10 @@ func (s *Shuttle) Run(ctx context.Context) error {
 		log.Printf("Error stopping workloads: %v", err)
 	}
 
-	// Unregister from Weaver
-	if err := s.unregisterFromWeaver(ctx); err != nil {
+	// Unregister from Weaver; ctx is already cancelled at this point
+	unregisterCtx, cancel := context.WithTimeout(context.Background(), s.config.Weaver.Timeout)
+	defer cancel()
+	if err := s.unregisterFromWeaver(unregisterCtx); err != nil {
 		log.Printf("Error unregistering from Weaver: %v", err)
 	}
 
@@ -165,10 +214,40 @@ func (s *Shuttle) registerWithWeaver(ctx context.Context) error {
 		Region:   s.config.Node.Region,
 		Zone:     s.config.Node.Zone,
 		Labels:   s.config.Node.Labels,
+		Taints:   s.config.Node.Taints,
 		Capacity: s.config.Node.Capacity,
 	}
 
-	return s.grpcClient.RegisterNode(ctx, nodeInfo)
+	// Advertise the mesh address so Weaver can route traffic to this node
+	nodeInfo.Address = s.meshAddress(ctx)
+	if s.agent != nil {
+		nodeInfo.AgentPort = int32(s.config.Agent.Port) // nolint:gosec
+	}
+
+	interval, err := s.grpcClient.RegisterNode(ctx, nodeInfo)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	if interval > 0 {
+		s.heartbeatInterval = interval
+	}
+	s.mu.Unlock()
+
+	return nil
+}
+
+// meshAddress returns the node's Tailscale IP, or empty without Tailscale
+func (s *Shuttle) meshAddress(ctx context.Context) string {
+	if s.tailscale == nil {
+		return ""
+	}
+	status, err := s.tailscale.GetStatus(ctx)
+	if err != nil {
+		return ""
+	}
+	return status.IP
 }
 
 // unregisterFromWeaver unregisters this node from the Weaver control plane
@@ -176,31 +255,41 @@ func (s *Shuttle) unregisterFromWeaver(ctx context.Context) error {
 	return s.grpcClient.UnregisterNode(ctx, s.config.Node.ID)
 }
 
-// workloadLoop manages workload lifecycle
-func (s *Shuttle) workloadLoop(ctx context.Context) {
-	ticker := time.NewTicker(10 * time.Second)
-	defer ticker.Stop()
+// watchLoop follows the assignment stream from Weaver, reconnecting with backoff when it drops
+func (s *Shuttle) watchLoop(ctx context.Context) {
+	backoff := s.config.Weaver.Retry.Backoff
+	if backoff <= 0 {
+		backoff = time.Second
+	}
+	delay := backoff
 
 	for {
+		err := s.grpcClient.WatchAssignments(ctx, s.config.Node.ID, func(workloads []*grpc.WorkloadSpec) error {
+			delay = backoff
+			return s.syncWorkloads(ctx, workloads)
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Printf("Assignment stream failed, reconnecting in %s: %v", delay, err)
+		}
+
 		select {
 		case <-ctx.Done():
 			return
-		case <-ticker.C:
-			if err := s.syncWorkloads(ctx); err != nil {
-				log.Printf("Error syncing workloads: %v", err)
-			}
+		case <-time.After(delay):
 		}
-	}
-}
 
-// syncWorkloads synchronizes workloads with Weaver
-func (s *Shuttle) syncWorkloads(ctx context.Context) error {
-	// Get assigned workloads from Weaver
-	workloads, err := s.grpcClient.GetAssignedWorkloads(ctx, s.config.Node.ID)
-	if err != nil {
-		return fmt.Errorf("failed to get assigned workloads: %w", err)
+		delay *= 2
+		if delay > maxWatchBackoff {
+			delay = maxWatchBackoff
+		}
 	}
+}
 
+// syncWorkloads reconciles local workloads with the set assigned by Weaver
+func (s *Shuttle) syncWorkloads(ctx context.Context, workloads []*grpc.WorkloadSpec) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -221,13 +310,16 @@ func (s *Shuttle) syncWorkloads(ctx context.Context) error {
 		}
 	}
 
-	// Start new workloads
+	// Start new workloads; running ones restart with their latest spec
 	for id, spec := range shouldRun {
-		if _, exists := s.workloads[id]; !exists {
-			log.Printf("Starting workload %s", id)
-			if err := s.startWorkload(ctx, spec); err != nil {
-				log.Printf("Error starting workload %s: %v", id, err)
-			}
+		if instance, exists := s.workloads[id]; exists {
+			instance.spec = spec
+			continue
+		}
+
+		log.Printf("Starting workload %s", id)
+		if err := s.startWorkload(ctx, spec); err != nil {
+			log.Printf("Error starting workload %s: %v", id, err)
 		}
 	}
 
@@ -244,13 +336,23 @@ func (s *Shuttle) startWorkload(ctx context.Context, spec *grpc.WorkloadSpec) er
 		Status:    WorkloadStatusPending,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
+		spec:      spec,
+		startedAt: time.Now(),
 	}
 
 	// Start container
 	containerID, err := s.runtime.StartContainer(ctx, spec)
 	if err != nil {
-		instance.Status = WorkloadStatusFailed
 		s.workloads[spec.ID] = instance
+
+		// Containers that exit while starting are restarted like crashed ones
+		if workload.RestartPolicy(spec.RestartPolicy).Restarts(true) {
+			s.scheduleRestart(ctx, instance, fmt.Sprintf("Failed to start container: %v", err))
+			return fmt.Errorf("failed to start container: %w", err)
+		}
+
+		instance.Status = WorkloadStatusFailed
+		go s.reportWorkloadStatus(context.Background(), s.workloadStatus(instance))
 		return fmt.Errorf("failed to start container: %w", err)
 	}
 
@@ -261,11 +363,134 @@ func (s *Shuttle) startWorkload(ctx context.Context, spec *grpc.WorkloadSpec) er
 	s.workloads[spec.ID] = instance
 
 	// Report status to Weaver
-	go s.reportWorkloadStatus(context.Background(), instance)
+	go s.reportWorkloadStatus(context.Background(), s.workloadStatus(instance))
 
 	return nil
 }
 
+// exitLoop follows container exits, subscribing again with backoff when the
+// event stream fails
+func (s *Shuttle) exitLoop(ctx context.Context) {
+	delay := time.Second
+	for {
+		err := s.runtime.WatchExits(ctx, func(exit containerd.Exit) {
+			s.handleExit(ctx, exit)
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Container exit stream failed, subscribing again in %s: %v", delay, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > maxWatchBackoff {
+			delay = maxWatchBackoff
+		}
+	}
+}
+
+// handleExit applies the restart policy of a workload whose container exited
+func (s *Shuttle) handleExit(ctx context.Context, exit containerd.Exit) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var instance *WorkloadInstance
+	for _, candidate := range s.workloads {
+		if candidate.ContainerID == exit.ContainerID {
+			instance = candidate
+			break
+		}
+	}
+
+	// Containers stopped by Shuttle are no longer running, and the exit of a
+	// container replaced by a restart is stale
+	if instance == nil || instance.Status != WorkloadStatusRunning || exit.ExitedAt.Before(instance.startedAt) {
+		return
+	}
+
+	instance.ExitCode = exit.ExitCode
+	cause := fmt.Sprintf("Exited with code %d", exit.ExitCode)
+
+	failed := exit.ExitCode != 0
+	if workload.RestartPolicy(instance.spec.RestartPolicy).Restarts(failed) {
+		s.scheduleRestart(ctx, instance, cause)
+		return
+	}
+
+	log.Printf("Container %s of workload %s exited with code %d", exit.ContainerID, instance.ID, exit.ExitCode)
+
+	instance.Status = WorkloadStatusStopped
+	if failed {
+		instance.Status = WorkloadStatusFailed
+	}
+	instance.Reason = ""
+	instance.Message = cause
+	instance.UpdatedAt = time.Now()
+	go s.reportWorkloadStatus(context.Background(), s.workloadStatus(instance))
+}
+
+// scheduleRestart starts a workload's container again after a backoff that
+// grows with each consecutive crash. The caller holds s.mu.
+func (s *Shuttle) scheduleRestart(ctx context.Context, instance *WorkloadInstance, cause string) {
+	// A container that ran long enough before exiting starts a new crash series
+	if time.Since(instance.startedAt) >= workload.RestartBackoffReset {
+		instance.crashes = 0
+	}
+	instance.crashes++
+	delay := workload.RestartBackoff(instance.crashes)
+
+	instance.Status = WorkloadStatusRestarting
+	instance.Reason = workload.RestartReason(instance.crashes)
+	instance.Message = fmt.Sprintf("%s, restarting in %s", cause, delay)
+	instance.UpdatedAt = time.Now()
+
+	log.Printf("Workload %s: %s", instance.ID, instance.Message)
+	go s.reportWorkloadStatus(context.Background(), s.workloadStatus(instance))
+
+	time.AfterFunc(delay, func() {
+		s.restartWorkload(ctx, instance)
+	})
+}
+
+// restartWorkload replaces the exited container of a restarting workload
+func (s *Shuttle) restartWorkload(ctx context.Context, instance *WorkloadInstance) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The workload may have been stopped or moved while it waited
+	if ctx.Err() != nil || s.stopping || s.workloads[instance.ID] != instance || instance.Status != WorkloadStatusRestarting {
+		return
+	}
+
+	// A container that failed to start may be left behind without an ID
+	containerID := containerd.ContainerID(instance.Namespace, instance.Name)
+	if err := s.runtime.StopContainer(ctx, containerID); err != nil {
+		log.Printf("Error removing exited container %s: %v", containerID, err)
+	}
+
+	instance.startedAt = time.Now()
+	containerID, err := s.runtime.StartContainer(ctx, instance.spec)
+	if err != nil {
+		s.scheduleRestart(ctx, instance, fmt.Sprintf("Failed to restart container: %v", err))
+		return
+	}
+
+	instance.ContainerID = containerID
+	instance.RestartCount++
+	instance.Status = WorkloadStatusRunning
+	instance.Reason = ""
+	instance.Message = ""
+	instance.UpdatedAt = time.Now()
+
+	log.Printf("Restarted workload %s (restart %d)", instance.ID, instance.RestartCount)
+	go s.reportWorkloadStatus(context.Background(), s.workloadStatus(instance))
+}
+
 // stopWorkload stops a running workload
 func (s *Shuttle) stopWorkload(ctx context.Context, instance *WorkloadInstance) error {
 	if instance.ContainerID != "" {
@@ -274,11 +499,16 @@ func (s *Shuttle) stopWorkload(ctx context.Context, instance *WorkloadInstance)
 		}
 	}
 
+	// A container that exited on its own has been reported already
+	exited := instance.Status == WorkloadStatusStopped || instance.Status == WorkloadStatusFailed
+
 	instance.Status = WorkloadStatusStopped
 	instance.UpdatedAt = time.Now()
 
-	// Report status to Weaver
-	go s.reportWorkloadStatus(context.Background(), instance)
+	// Weaver decides what happens to workloads on a node that is shutting down
+	if !s.stopping && !exited {
+		go s.reportWorkloadStatus(context.Background(), s.workloadStatus(instance))
+	}
 
 	return nil
 }
@@ -297,15 +527,23 @@ func (s *Shuttle) stopAllWorkloads(ctx context.Context) error {
 	return nil
 }
 
-// reportWorkloadStatus reports workload status to Weaver
-func (s *Shuttle) reportWorkloadStatus(ctx context.Context, instance *WorkloadInstance) {
-	status := &grpc.WorkloadStatus{
-		WorkloadID: instance.ID,
-		NodeID:     s.config.Node.ID,
-		Status:     string(instance.Status),
-		UpdatedAt:  instance.UpdatedAt,
+// workloadStatus captures the status of a workload to report to Weaver
+func (s *Shuttle) workloadStatus(instance *WorkloadInstance) *grpc.WorkloadStatus {
+	return &grpc.WorkloadStatus{
+		WorkloadID:   instance.ID,
+		NodeID:       s.config.Node.ID,
+		Status:       string(instance.Status),
+		ContainerID:  instance.ContainerID,
+		Message:      instance.Message,
+		Reason:       instance.Reason,
+		ExitCode:     instance.ExitCode,
+		RestartCount: instance.RestartCount,
+		UpdatedAt:    instance.UpdatedAt,
 	}
+}
 
+// reportWorkloadStatus reports workload status to Weaver
+func (s *Shuttle) reportWorkloadStatus(ctx context.Context, status *grpc.WorkloadStatus) {
 	if err := s.grpcClient.ReportWorkloadStatus(ctx, status); err != nil {
 		log.Printf("Error reporting workload status: %v", err)
 	}
@@ -313,7 +551,11 @@ func (s *Shuttle) reportWorkloadStatus(ctx context.Context, instance *WorkloadIn
 
 // healthLoop reports node health to Weaver
 func (s *Shuttle) healthLoop(ctx context.Context) {
-	ticker := time.NewTicker(30 * time.Second)
+	s.mu.RLock()
+	interval := s.heartbeatInterval
+	s.mu.RUnlock()
+
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
 	for {
@@ -321,7 +563,13 @@ func (s *Shuttle) healthLoop(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			if err := s.reportHealth(ctx); err != nil {
+			err := s.reportHealth(ctx)
+			if errors.Is(err, grpc.ErrNodeNotRegistered) {
+				// Weaver lost track of this node, e.g. after a restart
+				log.Println("Node not registered with Weaver, registering again...")
+				err = s.registerWithWeaver(ctx)
+			}
+			if err != nil {
 				log.Printf("Error reporting health: %v", err)
 			}
 		}
@@ -331,17 +579,55 @@ func (s *Shuttle) healthLoop(ctx context.Context) {
 // reportHealth reports node health to Weaver
 func (s *Shuttle) reportHealth(ctx context.Context) error {
 	s.mu.RLock()
-	workloadCount := len(s.workloads)
+	workloadIDs := make([]string, 0, len(s.workloads))
+	for id := range s.workloads {
+		workloadIDs = append(workloadIDs, id)
+	}
 	s.mu.RUnlock()
 
 	health := &grpc.NodeHealth{
 		NodeID:        s.config.Node.ID,
 		Status:        "healthy",
-		WorkloadCount: workloadCount,
+		WorkloadCount: len(workloadIDs),
+		WorkloadIDs:   workloadIDs,
 		Timestamp:     time.Now(),
 	}
 
-	return s.grpcClient.ReportHealth(ctx, health)
+	fenced, err := s.grpcClient.ReportHealth(ctx, health)
+	if err != nil {
+		return err
+	}
+
+	s.fenceWorkloads(ctx, fenced)
+	return nil
+}
+
+// fenceWorkloads stops workloads Weaver has moved to another node, e.g. while
+// this node was partitioned, so they do not keep running twice
+func (s *Shuttle) fenceWorkloads(ctx context.Context, ids []string) {
+	if len(ids) == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range ids {
+		instance, exists := s.workloads[id]
+		if !exists {
+			continue
+		}
+
+		log.Printf("Fencing workload %s, it is no longer assigned to this node", id)
+		if instance.ContainerID != "" {
+			if err := s.runtime.StopContainer(ctx, instance.ContainerID); err != nil {
+				log.Printf("Error stopping container %s: %v", instance.ContainerID, err)
+			}
+		}
+
+		// The workload belongs to another node now, so its status is not reported
+		delete(s.workloads, id)
+	}
 }
 
 // GetWorkloads returns current workloads
@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrUnauthenticated  = errors.New("unauthenticated")
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// Authorizer authenticates API callers and checks their role bindings
+type Authorizer struct {
+	repo           Repository // nil without a database; only static principals authenticate
+	bootstrapToken string
+	admins         map[string]bool
+}
+
+// New creates an authorizer. The bootstrap token authenticates the bootstrap
+// principal; it and the admins are cluster admins without a role binding.
+func New(repo Repository, bootstrapToken string, admins []string) *Authorizer {
+	a := &Authorizer{
+		repo:           repo,
+		bootstrapToken: bootstrapToken,
+		admins:         make(map[string]bool, len(admins)+1),
+	}
+	for _, admin := range admins {
+		a.admins[admin] = true
+	}
+	if bootstrapToken != "" {
+		a.admins[BootstrapPrincipal] = true
+	}
+	return a
+}
+
+// Authenticate identifies a caller by its bearer token or, without one, by
+// the common name of its verified client certificate
+func (a *Authorizer) Authenticate(ctx context.Context, token string, certs []*x509.Certificate) (*Principal, error) {
+	if token != "" {
+		return a.authenticateToken(ctx, token)
+	}
+
+	if len(certs) > 0 {
+		name := certs[0].Subject.CommonName
+		if name == "" {
+			return nil, fmt.Errorf("%w: client certificate has no common name", ErrUnauthenticated)
+		}
+		return &Principal{Name: name, Method: MethodCertificate}, nil
+	}
+
+	return nil, fmt.Errorf("%w: no token or client certificate", ErrUnauthenticated)
+}
+
+func (a *Authorizer) authenticateToken(ctx context.Context, raw string) (*Principal, error) {
+	if a.bootstrapToken != "" && subtle.ConstantTimeCompare([]byte(raw), []byte(a.bootstrapToken)) == 1 {
+		return &Principal{Name: BootstrapPrincipal, Method: MethodBootstrap}, nil
+	}
+
+	id, secret, ok := parseToken(raw)
+	if !ok || a.repo == nil {
+		return nil, fmt.Errorf("%w: invalid token", ErrUnauthenticated)
+	}
+
+	token, err := a.repo.GetToken(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token: %w", err)
+	}
+	if !token.matches(secret) {
+		return nil, fmt.Errorf("%w: invalid token", ErrUnauthenticated)
+	}
+	if !token.Active(time.Now()) {
+		return nil, fmt.Errorf("%w: token %s is revoked or expired", ErrUnauthenticated, token.ID)
+	}
+
+	return &Principal{Name: token.Principal, Method: MethodToken, TokenID: token.ID}, nil
+}
+
+// Authorize checks that a principal holds at least the required role in a
+// namespace. An empty namespace stands for a cluster-wide operation, which
+// needs a cluster-wide binding.
+func (a *Authorizer) Authorize(ctx context.Context, p *Principal, namespace string, required Role) error {
+	if a.admins[p.Name] {
+		return nil
+	}
+	if a.repo == nil {
+		return fmt.Errorf("%w: no role bindings available", ErrPermissionDenied)
+	}
+
+	bindings, err := a.repo.ListRoleBindings(ctx, BindingFilter{Principal: p.Name})
+	if err != nil {
+		return fmt.Errorf("failed to list role bindings: %w", err)
+	}
+
+	for _, binding := range bindings {
+		if binding.Namespace != AllNamespaces && binding.Namespace != namespace {
+			continue
+		}
+		if binding.Role.Allows(required) {
+			return nil
+		}
+	}
+
+	if namespace == "" {
+		return fmt.Errorf("%w: %s needs the cluster-wide %s role", ErrPermissionDenied, p.Name, required)
+	}
+	return fmt.Errorf("%w: %s needs the %s role in namespace %s", ErrPermissionDenied, p.Name, required, namespace)
+}
+
+// IsClusterAdmin reports whether a principal may manage every namespace
+func (a *Authorizer) IsClusterAdmin(ctx context.Context, p *Principal) bool {
+	return a.Authorize(ctx, p, "", RoleAdmin) == nil
+}
+
+type principalKey struct{}
+
+// NewContext returns a context carrying the authenticated principal
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal of an authenticated request
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
@@ -0,0 +1,18 @@
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	CreateToken(ctx context.Context, token *Token) error
+	GetToken(ctx context.Context, id string) (*Token, error)
+	ListTokens(ctx context.Context, principal string) ([]*Token, error)
+	RevokeToken(ctx context.Context, id string, at time.Time) error
+
+	CreateRoleBinding(ctx context.Context, binding *RoleBinding) error
+	GetRoleBinding(ctx context.Context, id string) (*RoleBinding, error)
+	ListRoleBindings(ctx context.Context, filter BindingFilter) ([]*RoleBinding, error)
+	DeleteRoleBinding(ctx context.Context, id string) error
+}
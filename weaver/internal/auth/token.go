@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// tokenPrefix marks Weaver API tokens, which have the form wvr_<id>_<secret>
+const tokenPrefix = "wvr"
+
+// NewToken mints a token for a principal. The returned secret is the
+// token clients present; it cannot be recovered from the stored hash.
+func NewToken(name, principal string, ttl time.Duration) (*Token, string, error) {
+	id := make([]byte, 8)
+	secret := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return nil, "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	token := &Token{
+		ID:        hex.EncodeToString(id),
+		Name:      name,
+		Principal: principal,
+		CreatedAt: time.Now(),
+	}
+	if ttl > 0 {
+		expiresAt := token.CreatedAt.Add(ttl)
+		token.ExpiresAt = &expiresAt
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(secret)
+	token.Hash = hashSecret(encoded)
+
+	return token, strings.Join([]string{tokenPrefix, token.ID, encoded}, "_"), nil
+}
+
+// parseToken splits a presented token into its ID and secret
+func parseToken(raw string) (string, string, bool) {
+	parts := strings.SplitN(raw, "_", 3)
+	if len(parts) != 3 || parts[0] != tokenPrefix || parts[1] == "" || parts[2] == "" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+// matches reports whether a secret belongs to the token
+func (t *Token) matches(secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(t.Hash)) == 1
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,90 @@
+package auth
+
+import "time"
+
+// Role grants a set of operations within a namespace
+type Role string
+
+const (
+	RoleViewer Role = "viewer" // reads workloads, secrets and logs
+	RoleEditor Role = "editor" // also changes them and execs into workloads
+	RoleAdmin  Role = "admin"  // also manages role bindings
+)
+
+// AllNamespaces is the namespace of cluster-wide role bindings. Cluster-wide
+// operations such as managing namespaces need a cluster-wide binding.
+const AllNamespaces = "*"
+
+var roleRanks = map[Role]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleAdmin:  3,
+}
+
+// Valid reports whether the role is known
+func (r Role) Valid() bool {
+	return roleRanks[r] > 0
+}
+
+// Allows reports whether the role includes the operations of the required role
+func (r Role) Allows(required Role) bool {
+	return r.Valid() && roleRanks[r] >= roleRanks[required]
+}
+
+// Method is how a principal authenticated
+type Method string
+
+const (
+	MethodToken       Method = "token"
+	MethodCertificate Method = "certificate"
+	MethodBootstrap   Method = "bootstrap"
+)
+
+// BootstrapPrincipal is authenticated by the configured bootstrap token
+const BootstrapPrincipal = "bootstrap"
+
+// NodePrincipal is the principal a Shuttle node authenticates as, with a token
+// minted for it or a client certificate with it as common name. Nodes only
+// act as themselves.
+func NodePrincipal(nodeID string) string {
+	return "node:" + nodeID
+}
+
+// Principal is an authenticated caller
+type Principal struct {
+	Name    string
+	Method  Method
+	TokenID string // set when authenticated by an API token
+}
+
+// Token is an API token. Only the hash of its secret is stored.
+type Token struct {
+	ID        string
+	Name      string
+	Principal string
+	Hash      string
+	CreatedAt time.Time
+	ExpiresAt *time.Time // never expires when nil
+	RevokedAt *time.Time
+}
+
+// Active reports whether the token can still authenticate
+func (t *Token) Active(now time.Time) bool {
+	return t.RevokedAt == nil && (t.ExpiresAt == nil || now.Before(*t.ExpiresAt))
+}
+
+// RoleBinding grants a principal a role in a namespace, or in every
+// namespace when the namespace is AllNamespaces
+type RoleBinding struct {
+	ID        string
+	Principal string
+	Namespace string
+	Role      Role
+	CreatedAt time.Time
+}
+
+// BindingFilter selects role bindings; empty fields match everything
+type BindingFilter struct {
+	Principal string
+	Namespace string
+}
@@ -0,0 +1,338 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/codecflow/fabric/pkg/config"
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/cron"
+	"github.com/codecflow/fabric/weaver/internal/finalizer"
+	"github.com/codecflow/fabric/weaver/internal/lease"
+	"github.com/codecflow/fabric/weaver/internal/state"
+	"github.com/codecflow/fabric/weaver/services/stream"
+)
+
+const (
+	defaultCronInterval = 10 * time.Second
+
+	// cronLease is held by the replica that runs cron workloads
+	cronLease = "cron-scheduler"
+)
+
+// WorkloadSubmitter admits and schedules new workloads like CreateWorkload does
+type WorkloadSubmitter interface {
+	Submit(ctx context.Context, w *workload.Workload) error
+}
+
+// CronController creates the runs of cron workloads when they are due.
+//
+// Only the replica holding the cron scheduler lease creates runs. The lease is
+// renewed before every cron workload and a pass ends once it is lost, so two
+// replicas never replace or prune runs at once. Run names are derived from
+// their schedule time, so a run is not created twice even if the lease changes
+// hands. After downtime only the latest missed run is started, unless it is
+// past the starting deadline; earlier missed runs are skipped. The concurrency
+// policy decides whether a due run starts next to, waits for or replaces the
+// active runs. Finished runs beyond the history limits are deleted, oldest
+// first.
+type CronController struct {
+	appState *state.State
+	logger   *logrus.Logger
+
+	interval  time.Duration
+	submitter WorkloadSubmitter
+	elector   *lease.Elector
+
+	// Runs that are replaced or fall out of the history are torn down by their finalizers
+	finalizers *finalizer.Runner
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewCronController creates a new cron controller that submits runs through submitter
+func NewCronController(appState *state.State, logger *logrus.Logger, cfg *config.ControllerConfig, submitter WorkloadSubmitter) *CronController {
+	c := &CronController{
+		appState:   appState,
+		logger:     logger,
+		interval:   defaultCronInterval,
+		submitter:  submitter,
+		finalizers: finalizer.New(appState, logger),
+		stopCh:     make(chan struct{}),
+	}
+
+	if cfg != nil && cfg.CronInterval > 0 {
+		c.interval = time.Duration(cfg.CronInterval) * time.Second
+	}
+	c.elector = newElector(appState, cronLease, c.interval)
+
+	return c
+}
+
+// Start runs the scheduling loop until Stop is called or ctx is cancelled
+func (c *CronController) Start(ctx context.Context) {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		c.reconcileAll(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-c.stopCh:
+				return
+			case <-ticker.C:
+				c.reconcileAll(ctx)
+			}
+		}
+	}()
+}
+
+// Stop stops the scheduling loop, waits for it to exit and hands the lease
+// over to the other replicas
+func (c *CronController) Stop() {
+	close(c.stopCh)
+	c.wg.Wait()
+	resign(c.elector, c.logger, "cron scheduler")
+}
+
+// reconcileAll schedules every cron workload while this replica leads
+func (c *CronController) reconcileAll(ctx context.Context) {
+	if c.appState.Repository == nil || c.appState.Repository.Cron == nil || c.appState.Repository.Workload == nil {
+		return
+	}
+
+	if !lead(ctx, c.elector, c.logger, "cron scheduler") {
+		return
+	}
+
+	cronWorkloads, err := c.appState.Repository.Cron.List(ctx, "", nil)
+	if err != nil {
+		c.logger.Warnf("Failed to list cron workloads: %v", err)
+		return
+	}
+
+	for _, cw := range cronWorkloads {
+		if !keep(ctx, c.elector, c.logger, "cron scheduler") {
+			return
+		}
+
+		reconcileCtx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+		err := c.reconcile(reconcileCtx, cw, time.Now())
+		cancel()
+
+		if err != nil {
+			c.logger.Warnf("Failed to reconcile cron workload %s/%s: %v", cw.Namespace, cw.Name, err)
+		}
+	}
+}
+
+// reconcile starts the due run of a cron workload, prunes its history and
+// records its status
+func (c *CronController) reconcile(ctx context.Context, cw *cron.CronWorkload, now time.Time) error {
+	cw.Spec.Default()
+
+	runs, err := c.appState.Repository.Workload.List(ctx, cw.Runs())
+	if err != nil {
+		return fmt.Errorf("failed to list runs: %w", err)
+	}
+
+	previous := cw.Status
+	previous.Active = append([]string(nil), cw.Status.Active...)
+
+	var active, succeeded, failed []*workload.Workload
+	for _, w := range runs {
+		if w.Terminating() {
+			continue
+		}
+		switch w.Status.Phase {
+		case workload.PhaseSucceeded:
+			succeeded = append(succeeded, w)
+			if finished := w.Status.FinishTime; finished != nil &&
+				(cw.Status.LastSuccessfulTime == nil || finished.After(*cw.Status.LastSuccessfulTime)) {
+				cw.Status.LastSuccessfulTime = finished
+			}
+		case workload.PhaseFailed:
+			failed = append(failed, w)
+		default:
+			active = append(active, w)
+		}
+	}
+
+	cw.Status.Active = nil
+	for _, w := range active {
+		cw.Status.Active = append(cw.Status.Active, w.ID)
+	}
+
+	err = c.startDueRun(ctx, cw, runs, active, now)
+
+	c.pruneHistory(ctx, cw, succeeded, *cw.Spec.SuccessfulRunsHistoryLimit)
+	c.pruneHistory(ctx, cw, failed, *cw.Spec.FailedRunsHistoryLimit)
+
+	cw.UpdateNextScheduleTime(now)
+	if !reflect.DeepEqual(previous, cw.Status) {
+		if updateErr := c.appState.Repository.Cron.UpdateStatus(ctx, cw); updateErr != nil {
+			c.logger.Warnf("Failed to update status of cron workload %s/%s: %v", cw.Namespace, cw.Name, updateErr)
+		}
+	}
+
+	return err
+}
+
+// startDueRun starts the latest run of a cron workload that is due and not
+// started yet, according to its starting deadline and concurrency policy
+func (c *CronController) startDueRun(ctx context.Context, cw *cron.CronWorkload, runs, active []*workload.Workload, now time.Time) error {
+	if cw.Spec.Suspend {
+		return nil
+	}
+
+	schedule, err := cron.Parse(cw.Spec.Schedule, cw.Spec.TimeZone)
+	if err != nil {
+		return err
+	}
+
+	since := cw.CreatedAt
+	if cw.Status.LastScheduleTime != nil {
+		since = *cw.Status.LastScheduleTime
+	}
+
+	due, missed := dueTime(schedule, since, now)
+	if due.IsZero() {
+		return nil
+	}
+
+	// Created before the status recorded it, e.g. by a replica that lost the lease
+	name := cw.RunName(due)
+	for _, w := range runs {
+		if w.Name == name {
+			cw.Status.LastScheduleTime = &due
+			return nil
+		}
+	}
+
+	if deadline := cw.Spec.StartingDeadlineSeconds; deadline != nil && now.Sub(due) > time.Duration(*deadline)*time.Second {
+		c.logger.Warnf("Skipped %d missed runs of cron workload %s/%s, the last one was scheduled at %s and is past its starting deadline of %ds",
+			missed, cw.Namespace, cw.Name, due.Format(time.RFC3339), *deadline)
+		c.publish(ctx, stream.EventCronRunMissed, cw, due)
+		cw.Status.LastScheduleTime = &due
+		return nil
+	}
+
+	if len(active) > 0 {
+		switch cw.Spec.ConcurrencyPolicy {
+		case cron.ConcurrencyForbid:
+			// Retried on the next pass, until the starting deadline passes
+			c.logger.Debugf("Run of cron workload %s/%s scheduled at %s waits for %d active runs",
+				cw.Namespace, cw.Name, due.Format(time.RFC3339), len(active))
+			return nil
+		case cron.ConcurrencyReplace:
+			for _, w := range active {
+				c.logger.Infof("Replacing run %s of cron workload %s/%s", w.Name, cw.Namespace, cw.Name)
+				if err := c.finalizers.Start(ctx, w); err != nil {
+					return fmt.Errorf("failed to delete run %s: %w", w.Name, err)
+				}
+				if err := c.finalizers.Run(ctx, w); err != nil {
+					c.logger.Warnf("Run %s/%s is terminating, finalization will be retried: %v", w.Namespace, w.Name, err)
+				}
+			}
+			cw.Status.Active = nil
+		}
+	}
+
+	if missed > 1 {
+		c.logger.Warnf("Skipped %d missed runs of cron workload %s/%s, starting the latest one",
+			missed-1, cw.Namespace, cw.Name)
+	}
+
+	run := cw.NewRun(due)
+	if err := c.submitter.Submit(ctx, run); err != nil {
+		return fmt.Errorf("failed to create run scheduled at %s: %w", due.Format(time.RFC3339), err)
+	}
+
+	cw.Status.LastScheduleTime = &due
+	cw.Status.Active = append(cw.Status.Active, run.ID)
+
+	c.logger.Infof("Created run %s of cron workload %s/%s scheduled at %s",
+		run.Name, cw.Namespace, cw.Name, due.Format(time.RFC3339))
+	c.publish(ctx, stream.EventCronRunCreated, cw, due)
+
+	return nil
+}
+
+// dueTime returns the latest schedule time after since that is not after
+// now, and how many schedule times were due in total
+func dueTime(schedule *cron.Schedule, since, now time.Time) (time.Time, int) {
+	var due time.Time
+	missed := 0
+	for next := schedule.Next(since); !next.IsZero() && !next.After(now); next = schedule.Next(next) {
+		due = next
+		missed++
+	}
+	return due, missed
+}
+
+// pruneHistory deletes the oldest finished runs beyond the limit
+func (c *CronController) pruneHistory(ctx context.Context, cw *cron.CronWorkload, finished []*workload.Workload, limit int32) {
+	if len(finished) <= int(limit) {
+		return
+	}
+
+	sort.Slice(finished, func(i, j int) bool {
+		return finishedAt(finished[i]).After(finishedAt(finished[j]))
+	})
+
+	for _, w := range finished[limit:] {
+		c.logger.Infof("Deleting run %s of cron workload %s/%s, it exceeds the history limit of %d",
+			w.Name, cw.Namespace, cw.Name, limit)
+
+		err := c.finalizers.Start(ctx, w)
+		if err == nil {
+			// Terminating workloads are finalized again by the workload controller
+			err = c.finalizers.Run(ctx, w)
+		}
+		if err != nil {
+			c.logger.Warnf("Failed to delete run %s/%s: %v", w.Namespace, w.Name, err)
+		}
+	}
+}
+
+// finishedAt returns when a run finished, falling back to when it was created
+func finishedAt(w *workload.Workload) time.Time {
+	if w.Status.FinishTime != nil {
+		return *w.Status.FinishTime
+	}
+	return w.CreatedAt
+}
+
+// publish emits a cron workload event if a stream is configured
+func (c *CronController) publish(ctx context.Context, eventType stream.EventType, cw *cron.CronWorkload, scheduled time.Time) {
+	if c.appState.Stream == nil {
+		return
+	}
+
+	event := &stream.Event{
+		Type:   eventType,
+		Source: "weaver.controller",
+		ID:     cw.ID,
+		Data: map[string]interface{}{
+			"name":         cw.Name,
+			"namespace":    cw.Namespace,
+			"scheduleTime": scheduled.Format(time.RFC3339),
+		},
+	}
+
+	if err := stream.PublishEvent(ctx, c.appState.Stream, stream.SubjectEvents, "cron", event); err != nil {
+		c.logger.Warnf("Failed to publish %s event for cron workload %s/%s: %v", eventType, cw.Namespace, cw.Name, err)
+	}
+}
@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/services/provider"
+)
+
+// runsJobs reports whether a provider runs jobs natively
+func runsJobs(p provider.Provider) bool {
+	runner, ok := p.(provider.JobRunner)
+	return ok && runner.RunsJobs()
+}
+
+// syncJob follows the current run of a job on a provider that does not run
+// jobs natively, one run at a time. It reports whether it handled the
+// observed status: an exited run is recorded and the job retried or
+// finished, and a job past its deadline is stopped.
+func (c *WorkloadController) syncJob(ctx context.Context, w *workload.Workload, p provider.Provider, observed *workload.Status) (bool, error) {
+	// Whoever reported the end of the run, such as the node that ran it,
+	// has already recorded it
+	if observed.Job != nil && w.Status.Job != nil &&
+		observed.Job.Succeeded+observed.Job.Failed > w.Status.Job.Succeeded+w.Status.Job.Failed {
+		return true, nil
+	}
+
+	if deadline, ok := w.JobDeadline(); ok && !time.Now().Before(deadline) {
+		return true, c.expireJob(ctx, w, p)
+	}
+
+	if observed.Phase != workload.PhaseSucceeded && observed.Phase != workload.PhaseFailed {
+		return false, nil
+	}
+
+	at := time.Now()
+	if observed.FinishTime != nil {
+		at = *observed.FinishTime
+	}
+
+	next := *w
+	if w.Status.Job != nil {
+		job := *w.Status.Job
+		next.Status.Job = &job
+	}
+	next.EndJobRun(observed.ExitCode, observed.Phase == workload.PhaseFailed, at)
+
+	// The run is replaced by the next one; the last run is kept until the job is deleted
+	if next.Status.Phase == workload.PhasePending {
+		if err := p.DeleteWorkload(ctx, w); err != nil && !errors.Is(err, provider.ErrNoProviderReference) {
+			return true, fmt.Errorf("failed to delete finished run from provider %s: %w", w.Status.Provider, err)
+		}
+	}
+
+	previous := w.Status.Phase
+	*w = next
+	if err := c.update(ctx, w); err != nil {
+		return true, err
+	}
+
+	if w.Status.Phase == workload.PhasePending {
+		c.logger.Infof("Run of job %s/%s exited with code %d, running again at %s",
+			w.Namespace, w.Name, w.Status.ExitCode, w.Status.Job.RetryAt.Format(time.RFC3339))
+		c.queue.CapacityChanged()
+		return true, nil
+	}
+
+	c.transitioned(ctx, w, previous)
+	return true, nil
+}
+
+// expireJob fails a job that exceeded its deadline, stopping its current run.
+// Pending jobs have no run and are given no provider.
+func (c *WorkloadController) expireJob(ctx context.Context, w *workload.Workload, p provider.Provider) error {
+	if p != nil {
+		if err := p.DeleteWorkload(ctx, w); err != nil && !errors.Is(err, provider.ErrNoProviderReference) {
+			return fmt.Errorf("failed to stop job on provider %s: %w", w.Status.Provider, err)
+		}
+		w.Status.ProviderRef = nil
+	}
+
+	previous := w.Status.Phase
+	w.FailJob(workload.ReasonDeadlineExceeded,
+		fmt.Sprintf("Job was active longer than %ds", w.Spec.Job.ActiveDeadlineSeconds), time.Now())
+	if err := c.update(ctx, w); err != nil {
+		return err
+	}
+
+	c.transitioned(ctx, w, previous)
+	return nil
+}
+
+// collectFinishedJobs deletes finished jobs once their TTL expired
+func (c *WorkloadController) collectFinishedJobs(ctx context.Context) {
+	workloads, err := c.appState.Repository.Workload.ListByPhase(ctx, workload.PhaseSucceeded, workload.PhaseFailed)
+	if err != nil {
+		c.logger.Warnf("Failed to list finished workloads: %v", err)
+		return
+	}
+
+	now := time.Now()
+	for _, w := range workloads {
+		if !w.JobExpired(now) {
+			continue
+		}
+		if !c.leads(ctx) {
+			return
+		}
+
+		c.logger.Infof("Deleting job %s/%s, its TTL expired after it finished at %s",
+			w.Namespace, w.Name, w.Status.FinishTime.Format(time.RFC3339))
+
+		deleteCtx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+		err := c.finalizers.Start(deleteCtx, w)
+		if err == nil {
+			// Terminating workloads are finalized again on the next pass
+			err = c.finalizers.Run(deleteCtx, w)
+		}
+		cancel()
+
+		if err != nil {
+			c.logger.Warnf("Failed to delete finished job %s/%s: %v", w.Namespace, w.Name, err)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/codecflow/fabric/weaver/internal/lease"
+	"github.com/codecflow/fabric/weaver/internal/state"
+)
+
+// Leases held by the replica that runs each controller
+const (
+	workloadControllerLease = "workload-controller"
+	nodeControllerLease     = "node-controller"
+	orphanCollectorLease    = "orphan-collector"
+)
+
+// leaseIntervals is how many passes a controller lease outlives, so a missed
+// renewal does not hand it over
+const leaseIntervals = 3
+
+// newElector creates the elector for a controller lease. The lease also
+// outlives a reconcile, so the leader can finish the one it started.
+func newElector(appState *state.State, name string, interval time.Duration) *lease.Elector {
+	var leases lease.Repository
+	if appState.Repository != nil {
+		leases = appState.Repository.Lease
+	}
+	return lease.NewElector(leases, name, leaseIntervals*interval+reconcileTimeout)
+}
+
+// lead acquires or renews a controller lease at the start of a pass and logs
+// when leadership changes
+func lead(ctx context.Context, e *lease.Elector, logger *logrus.Logger, what string) bool {
+	leading, changed, err := e.Lead(ctx)
+	if err != nil {
+		logger.Warnf("Failed to lead the %s: %v", what, err)
+	}
+	if changed && leading {
+		logger.Infof("Leading the %s as %s", what, e.Holder())
+	} else if changed {
+		logger.Infof("No longer leading the %s", what)
+	}
+	return leading
+}
+
+// keep reports whether this replica still leads for another reconcile,
+// renewing the lease when it is about to expire
+func keep(ctx context.Context, e *lease.Elector, logger *logrus.Logger, what string) bool {
+	leading, err := e.Keep(ctx, reconcileTimeout)
+	if err != nil {
+		logger.Warnf("Failed to renew the lease of the %s: %v", what, err)
+	}
+	if !leading {
+		logger.Infof("No longer leading the %s, ending the pass", what)
+	}
+	return leading
+}
+
+// resign hands a controller lease over to the other replicas
+func resign(e *lease.Elector, logger *logrus.Logger, what string) {
+	ctx, cancel := context.WithTimeout(context.Background(), reconcileTimeout)
+	defer cancel()
+	if err := e.Resign(ctx); err != nil {
+		logger.Warnf("Failed to resign as leader of the %s: %v", what, err)
+	}
+}
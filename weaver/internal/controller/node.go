@@ -0,0 +1,404 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/codecflow/fabric/pkg/config"
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/lease"
+	"github.com/codecflow/fabric/weaver/internal/node"
+	"github.com/codecflow/fabric/weaver/internal/repository"
+	"github.com/codecflow/fabric/weaver/internal/state"
+	"github.com/codecflow/fabric/weaver/services/scheduler"
+	"github.com/codecflow/fabric/weaver/services/stream"
+)
+
+const (
+	defaultNodeTimeout     = 45 * time.Second
+	defaultNodeGracePeriod = 2 * time.Minute
+)
+
+// NodeController watches node heartbeats and moves workloads off nodes that stop responding.
+//
+// A node that misses heartbeats for longer than the node timeout is marked Unknown
+// and its workloads follow. Once the grace period has also passed the node is
+// considered lost and its workloads are rescheduled elsewhere. If the node comes
+// back it is fenced: its heartbeat response lists the workloads it no longer owns.
+// Workloads of a node that unregistered are rescheduled right away, and the node
+// is removed once none are left on it.
+// Only the replica holding the node controller lease checks nodes.
+type NodeController struct {
+	appState *state.State
+	logger   *logrus.Logger
+
+	resyncInterval time.Duration
+	nodeTimeout    time.Duration
+	gracePeriod    time.Duration
+	elector        *lease.Elector
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewNodeController creates a new node controller
+func NewNodeController(appState *state.State, logger *logrus.Logger, cfg *config.ControllerConfig) *NodeController {
+	c := &NodeController{
+		appState:       appState,
+		logger:         logger,
+		resyncInterval: defaultResyncInterval,
+		nodeTimeout:    defaultNodeTimeout,
+		gracePeriod:    defaultNodeGracePeriod,
+		stopCh:         make(chan struct{}),
+	}
+
+	if cfg != nil {
+		if cfg.ResyncInterval > 0 {
+			c.resyncInterval = time.Duration(cfg.ResyncInterval) * time.Second
+		}
+		if cfg.NodeTimeout > 0 {
+			c.nodeTimeout = time.Duration(cfg.NodeTimeout) * time.Second
+		}
+		if cfg.NodeGracePeriod > 0 {
+			c.gracePeriod = time.Duration(cfg.NodeGracePeriod) * time.Second
+		}
+	}
+	c.elector = newElector(appState, nodeControllerLease, c.resyncInterval)
+
+	return c
+}
+
+// Start runs the node monitor loop until Stop is called or ctx is cancelled
+func (c *NodeController) Start(ctx context.Context) {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		ticker := time.NewTicker(c.resyncInterval)
+		defer ticker.Stop()
+
+		c.checkAll(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-c.stopCh:
+				return
+			case <-ticker.C:
+				c.checkAll(ctx)
+			}
+		}
+	}()
+}
+
+// Stop stops the node monitor loop, waits for it to exit and hands the lease
+// over to the other replicas
+func (c *NodeController) Stop() {
+	close(c.stopCh)
+	c.wg.Wait()
+	resign(c.elector, c.logger, "node controller")
+}
+
+// checkAll updates the health of every registered node while this replica leads
+func (c *NodeController) checkAll(ctx context.Context) {
+	if c.appState.Repository == nil || c.appState.Repository.Node == nil || c.appState.Repository.Workload == nil {
+		return
+	}
+	if !lead(ctx, c.elector, c.logger, "node controller") {
+		return
+	}
+
+	nodes, err := c.appState.Repository.Node.List(ctx)
+	if err != nil {
+		c.logger.Warnf("Failed to list nodes for health check: %v", err)
+		return
+	}
+
+	for _, n := range nodes {
+		if !keep(ctx, c.elector, c.logger, "node controller") {
+			return
+		}
+
+		checkCtx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+		if err := c.check(checkCtx, n, time.Now()); err != nil {
+			c.logger.Warnf("Failed to check health of node %s: %v", n.ID, err)
+		}
+		cancel()
+	}
+}
+
+// check moves a node through Ready, Unknown and NotReady based on its last heartbeat
+func (c *NodeController) check(ctx context.Context, n *node.Node, now time.Time) error {
+	if n.Status.Reason == node.ReasonUnregistered {
+		return c.drain(ctx, n)
+	}
+
+	silence := now.Sub(n.LastHeartbeat)
+
+	if silence <= c.nodeTimeout {
+		if n.Status.Phase != node.PhaseReady {
+			return c.markReady(ctx, n)
+		}
+		return nil
+	}
+
+	if n.Status.Phase == node.PhaseReady {
+		if err := c.markUnhealthy(ctx, n); err != nil {
+			return err
+		}
+	}
+
+	// Workloads are marked on every pass so a concurrent status write cannot revive them
+	message := fmt.Sprintf("Node %s stopped sending heartbeats", n.ID)
+	if err := c.markWorkloadsUnknown(ctx, n, "NodeUnreachable", message); err != nil {
+		return err
+	}
+
+	if silence <= c.nodeTimeout+c.gracePeriod {
+		return nil
+	}
+
+	if n.Status.Phase != node.PhaseNotReady {
+		if err := c.markLost(ctx, n); err != nil {
+			return err
+		}
+	}
+
+	return c.evict(ctx, n)
+}
+
+// markReady marks a node that resumed sending heartbeats as ready
+func (c *NodeController) markReady(ctx context.Context, n *node.Node) error {
+	if err := c.setStatus(ctx, n, node.Status{Phase: node.PhaseReady}); err != nil {
+		return err
+	}
+
+	c.logger.Infof("Node %s (%s) is healthy again", n.ID, n.Name)
+	c.publish(ctx, stream.EventNodeHealthy, n)
+
+	// Unschedulable workloads may fit on the returning node
+	if c.appState.Queue != nil {
+		c.appState.Queue.CapacityChanged()
+	}
+	return nil
+}
+
+// markUnhealthy marks a node that stopped sending heartbeats as unknown
+func (c *NodeController) markUnhealthy(ctx context.Context, n *node.Node) error {
+	status := node.Status{
+		Phase:   node.PhaseUnknown,
+		Reason:  "NodeHeartbeatTimeout",
+		Message: fmt.Sprintf("No heartbeat since %s", n.LastHeartbeat.Format(time.RFC3339)),
+	}
+	if err := c.setStatus(ctx, n, status); err != nil {
+		return err
+	}
+
+	c.logger.Warnf("Node %s (%s) missed heartbeats since %s", n.ID, n.Name, n.LastHeartbeat.Format(time.RFC3339))
+	c.publish(ctx, stream.EventNodeUnhealthy, n)
+	return nil
+}
+
+// markLost marks a node whose grace period expired as not ready
+func (c *NodeController) markLost(ctx context.Context, n *node.Node) error {
+	status := node.Status{
+		Phase:   node.PhaseNotReady,
+		Reason:  "NodeLost",
+		Message: fmt.Sprintf("No heartbeat since %s, workloads are being rescheduled", n.LastHeartbeat.Format(time.RFC3339)),
+	}
+	if err := c.setStatus(ctx, n, status); err != nil {
+		return err
+	}
+
+	c.logger.Warnf("Node %s (%s) is lost, rescheduling its workloads", n.ID, n.Name)
+	c.publish(ctx, stream.EventNodeLeft, n)
+	return nil
+}
+
+// drain reschedules the workloads of an unregistered node and removes the node
+// once none are left on it
+func (c *NodeController) drain(ctx context.Context, n *node.Node) error {
+	if err := c.markWorkloadsUnknown(ctx, n, node.ReasonUnregistered, fmt.Sprintf("Node %s unregistered", n.ID)); err != nil {
+		return err
+	}
+	if err := c.evict(ctx, n); err != nil {
+		return err
+	}
+
+	workloads, err := c.appState.Repository.Workload.ListByNode(ctx, n.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list workloads: %w", err)
+	}
+	for _, w := range workloads {
+		switch w.Status.Phase {
+		case workload.PhaseScheduled, workload.PhaseRunning, workload.PhaseUnknown:
+			// Retried on the next pass
+			return nil
+		}
+	}
+
+	if err := c.appState.Repository.Node.Delete(ctx, n.ID); err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("failed to delete node: %w", err)
+	}
+	c.logger.Infof("Removed unregistered node %s (%s)", n.ID, n.Name)
+	return nil
+}
+
+// markWorkloadsUnknown marks the active workloads of an unresponsive node as unknown
+func (c *NodeController) markWorkloadsUnknown(ctx context.Context, n *node.Node, reason, message string) error {
+	workloads, err := c.appState.Repository.Workload.ListByNode(ctx, n.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list workloads: %w", err)
+	}
+
+	for _, w := range workloads {
+		switch w.Status.Phase {
+		case workload.PhaseScheduled, workload.PhaseRunning:
+		default:
+			continue
+		}
+
+		w.Status.Phase = workload.PhaseUnknown
+		w.Status.Reason = reason
+		w.Status.Message = message
+		w.UpdatedAt = time.Now()
+
+		err := c.appState.Repository.Workload.Update(ctx, w)
+		if errors.Is(err, repository.ErrConflict) {
+			// Changed since it was listed, e.g. by a status report; marked on the next pass
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update workload %s: %w", w.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// evict reschedules the workloads left on a lost node. Workloads that cannot be
+// placed yet stay Unknown and are retried on the next pass.
+func (c *NodeController) evict(ctx context.Context, n *node.Node) error {
+	if c.appState.Scheduler == nil {
+		return fmt.Errorf("no scheduler configured")
+	}
+
+	workloads, err := c.appState.Repository.Workload.ListByNode(ctx, n.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list workloads: %w", err)
+	}
+
+	for _, w := range workloads {
+		if w.Status.Phase != workload.PhaseUnknown {
+			continue
+		}
+
+		if err := c.reschedule(ctx, n, w); err != nil {
+			c.logger.Warnf("Failed to reschedule workload %s/%s from lost node %s: %v", w.Namespace, w.Name, n.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// reschedule moves a single workload off a lost node
+func (c *NodeController) reschedule(ctx context.Context, n *node.Node, w *workload.Workload) error {
+	result, err := c.appState.Scheduler.Reschedule(ctx, w.ID, &scheduler.RescheduleConstraints{
+		Reason: fmt.Sprintf("node %s lost", n.ID),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Release the old placement; the lost node is fenced when it comes back
+	if p, ok := c.appState.GetProvider(w.Status.Provider); ok && w.Status.ProviderRef != nil {
+		if err := p.DeleteWorkload(ctx, w); err != nil {
+			c.logger.Warnf("Failed to release workload %s on provider %s: %v", w.ID, w.Status.Provider, err)
+		}
+	}
+
+	w.Status.Provider = result.Provider
+	w.Status.NodeID = ""
+	if result.Placement != nil {
+		w.Status.NodeID = result.Placement.NodeID
+	}
+	w.Status.Phase = workload.PhaseScheduled
+	w.Status.Reason = "Rescheduled"
+	w.Status.Message = fmt.Sprintf("Rescheduled from lost node %s", n.ID)
+	w.Status.ProviderRef = nil
+	w.Status.ContainerID = ""
+	w.Status.TailscaleIP = ""
+	w.Status.StartTime = nil
+	w.UpdatedAt = time.Now()
+
+	if err := c.appState.Repository.Workload.Update(ctx, w); err != nil {
+		return fmt.Errorf("failed to update workload: %w", err)
+	}
+
+	c.logger.Infof("Rescheduled workload %s/%s from lost node %s to provider %s", w.Namespace, w.Name, n.ID, w.Status.Provider)
+	c.publishWorkload(ctx, w, n)
+	return nil
+}
+
+// setStatus persists a new node status
+func (c *NodeController) setStatus(ctx context.Context, n *node.Node, status node.Status) error {
+	n.Status = status
+	n.UpdatedAt = time.Now()
+	if err := c.appState.Repository.Node.UpdateStatus(ctx, n); err != nil {
+		return fmt.Errorf("failed to update node status: %w", err)
+	}
+	return nil
+}
+
+// publish emits a node event if a stream is configured
+func (c *NodeController) publish(ctx context.Context, eventType stream.EventType, n *node.Node) {
+	if c.appState.Stream == nil {
+		return
+	}
+
+	event := &stream.Event{
+		Type:   eventType,
+		Source: "weaver.controller",
+		ID:     n.ID,
+		Data: map[string]interface{}{
+			"name":          n.Name,
+			"phase":         string(n.Status.Phase),
+			"reason":        n.Status.Reason,
+			"lastHeartbeat": n.LastHeartbeat,
+		},
+	}
+
+	if err := stream.PublishEvent(ctx, c.appState.Stream, stream.SubjectEvents, "node", event); err != nil {
+		c.logger.Warnf("Failed to publish %s event for node %s: %v", eventType, n.ID, err)
+	}
+}
+
+// publishWorkload emits a scheduled event for a workload moved off a lost node
+func (c *NodeController) publishWorkload(ctx context.Context, w *workload.Workload, lost *node.Node) {
+	if c.appState.Stream == nil {
+		return
+	}
+
+	event := &stream.Event{
+		Type:   stream.EventWorkloadScheduled,
+		Source: "weaver.controller",
+		ID:     w.ID,
+		Data: map[string]interface{}{
+			"name":         w.Name,
+			"namespace":    w.Namespace,
+			"phase":        string(w.Status.Phase),
+			"provider":     w.Status.Provider,
+			"nodeId":       w.Status.NodeID,
+			"previousNode": lost.ID,
+		},
+	}
+
+	if err := stream.PublishEvent(ctx, c.appState.Stream, stream.SubjectWorkloads, w.ID, event); err != nil {
+		c.logger.Warnf("Failed to publish %s event for workload %s: %v", stream.EventWorkloadScheduled, w.ID, err)
+	}
+}
@@ -0,0 +1,273 @@
+package controller
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/codecflow/fabric/pkg/config"
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/lease"
+	"github.com/codecflow/fabric/weaver/internal/metrics"
+	"github.com/codecflow/fabric/weaver/internal/state"
+	"github.com/codecflow/fabric/weaver/services/provider"
+	"github.com/codecflow/fabric/weaver/services/stream"
+)
+
+const (
+	defaultOrphanInterval    = 5 * time.Minute
+	defaultOrphanGracePeriod = 15 * time.Minute
+)
+
+// OrphanCollector deletes provider resources that no workload refers to.
+//
+// A resource is orphaned when the workload it was created for no longer exists
+// or is now backed by another resource, e.g. after a crash between provisioning
+// and recording the provider reference, or a deletion that bypassed finalizers.
+// Orphans are reported when first seen and deleted once they have stayed
+// orphaned for the grace period. In dry-run mode they are only reported. Only
+// the replica holding the orphan collector lease collects.
+type OrphanCollector struct {
+	appState *state.State
+	logger   *logrus.Logger
+
+	interval    time.Duration
+	gracePeriod time.Duration
+	dryRun      bool
+	elector     *lease.Elector
+
+	// firstSeen records when each orphan was first seen, keyed by provider and external ID
+	firstSeen map[string]time.Time
+
+	orphans  *metrics.Vec
+	deleted  *metrics.Vec
+	failures *metrics.Vec
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewOrphanCollector creates a new orphan collector
+func NewOrphanCollector(appState *state.State, logger *logrus.Logger, cfg *config.ControllerConfig) *OrphanCollector {
+	registry := appState.Metrics
+	if registry == nil {
+		registry = metrics.NewRegistry()
+	}
+
+	c := &OrphanCollector{
+		appState:    appState,
+		logger:      logger,
+		interval:    defaultOrphanInterval,
+		gracePeriod: defaultOrphanGracePeriod,
+		firstSeen:   make(map[string]time.Time),
+		orphans:     registry.Gauge("weaver_orphaned_resources", "Provider resources no workload refers to", "provider"),
+		deleted:     registry.Counter("weaver_orphaned_resources_deleted_total", "Orphaned provider resources deleted", "provider"),
+		failures:    registry.Counter("weaver_orphan_collection_errors_total", "Failed provider scans and orphan deletions", "provider"),
+		stopCh:      make(chan struct{}),
+	}
+
+	if cfg != nil {
+		if cfg.OrphanInterval > 0 {
+			c.interval = time.Duration(cfg.OrphanInterval) * time.Second
+		}
+		if cfg.OrphanGracePeriod > 0 {
+			c.gracePeriod = time.Duration(cfg.OrphanGracePeriod) * time.Second
+		}
+		c.dryRun = cfg.OrphanDryRun
+	}
+	c.elector = newElector(appState, orphanCollectorLease, c.interval)
+
+	return c
+}
+
+// Start runs the collection loop until Stop is called or ctx is cancelled
+func (c *OrphanCollector) Start(ctx context.Context) {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		c.collectAll(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-c.stopCh:
+				return
+			case <-ticker.C:
+				c.collectAll(ctx)
+			}
+		}
+	}()
+}
+
+// Stop stops the collection loop, waits for it to exit and hands the lease
+// over to the other replicas
+func (c *OrphanCollector) Stop() {
+	close(c.stopCh)
+	c.wg.Wait()
+	resign(c.elector, c.logger, "orphan collector")
+}
+
+// collectAll scans every provider for orphaned resources while this replica leads
+func (c *OrphanCollector) collectAll(ctx context.Context) {
+	if c.appState.Repository == nil || c.appState.Repository.Workload == nil {
+		return
+	}
+	if !lead(ctx, c.elector, c.logger, "orphan collector") {
+		// Orphans are timed again once this replica leads
+		c.firstSeen = make(map[string]time.Time)
+		return
+	}
+
+	// Providers are listed before the repository so that every resource they
+	// return was created before the workloads it is checked against were read
+	listed := make(map[string][]*workload.Workload)
+	for name, p := range c.appState.ListProviders() {
+		listCtx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+		resources, err := p.ListWorkloads(listCtx, "")
+		cancel()
+		if err != nil {
+			c.logger.Warnf("Failed to list workloads of provider %s for orphan collection: %v", name, err)
+			c.failures.Add(name, 1)
+			continue
+		}
+		listed[name] = resources
+	}
+
+	workloads, err := c.appState.Repository.Workload.List(ctx, workload.Filter{})
+	if err != nil {
+		c.logger.Warnf("Failed to list workloads for orphan collection: %v", err)
+		return
+	}
+
+	byID := make(map[string]*workload.Workload, len(workloads))
+	for _, w := range workloads {
+		byID[w.ID] = w
+	}
+
+	for name, resources := range listed {
+		p, ok := c.appState.GetProvider(name)
+		if !ok {
+			continue
+		}
+		c.collect(ctx, name, p, resources, byID, time.Now())
+	}
+}
+
+// collect reports and deletes the orphans among the resources of one provider
+func (c *OrphanCollector) collect(ctx context.Context, name string, p provider.Provider, resources []*workload.Workload, byID map[string]*workload.Workload, now time.Time) {
+	// Providers delete by name, which a replacement resource may have reused
+	inUse := make(map[string]bool)
+	for _, w := range byID {
+		if w.Status.Provider == name && w.Status.ProviderRef != nil {
+			inUse[w.Status.ProviderRef.Name] = true
+		}
+	}
+
+	seen := make(map[string]bool)
+	orphans := 0
+
+	for _, resource := range resources {
+		ref := resource.Status.ProviderRef
+		if resource.ID == "" || ref == nil || ref.ExternalID == "" {
+			continue
+		}
+		if owned(byID[resource.ID], name, ref.ExternalID) {
+			continue
+		}
+
+		key := name + "/" + ref.ExternalID
+		seen[key] = true
+		orphans++
+
+		firstSeen, ok := c.firstSeen[key]
+		if !ok {
+			firstSeen = now
+			c.firstSeen[key] = now
+			c.logger.Warnf("Found orphaned resource %s on provider %s for workload %s", ref.ExternalID, name, resource.ID)
+			c.publish(ctx, stream.EventOrphanDetected, name, resource)
+		}
+
+		if now.Sub(firstSeen) < c.gracePeriod {
+			continue
+		}
+		if inUse[ref.Name] {
+			c.logger.Warnf("Keeping orphaned resource %s on provider %s: its name %s is used by another workload", ref.ExternalID, name, ref.Name)
+			continue
+		}
+		if c.dryRun {
+			c.logger.Infof("Dry run: would delete orphaned resource %s on provider %s", ref.ExternalID, name)
+			continue
+		}
+		if !keep(ctx, c.elector, c.logger, "orphan collector") {
+			return
+		}
+
+		deleteCtx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+		err := p.DeleteWorkload(deleteCtx, resource)
+		cancel()
+		if err != nil {
+			c.logger.Warnf("Failed to delete orphaned resource %s on provider %s: %v", ref.ExternalID, name, err)
+			c.failures.Add(name, 1)
+			continue
+		}
+
+		delete(c.firstSeen, key)
+		orphans--
+		c.deleted.Add(name, 1)
+		c.logger.Infof("Deleted orphaned resource %s on provider %s for workload %s", ref.ExternalID, name, resource.ID)
+		c.publish(ctx, stream.EventOrphanDeleted, name, resource)
+	}
+
+	// Forget resources that are gone or were claimed since the last scan
+	prefix := name + "/"
+	for key := range c.firstSeen {
+		if strings.HasPrefix(key, prefix) && !seen[key] {
+			delete(c.firstSeen, key)
+		}
+	}
+
+	c.orphans.Set(name, float64(orphans))
+}
+
+// owned reports whether a stored workload is backed by the listed resource.
+// A terminating workload keeps its resource until the provider finalizer ran.
+func owned(w *workload.Workload, providerName, externalID string) bool {
+	if w == nil || w.Status.Provider != providerName {
+		return false
+	}
+	if w.Status.ProviderRef == nil || w.Status.ProviderRef.ExternalID != externalID {
+		return false
+	}
+	return !w.Terminating() || slices.Contains(w.Finalizers, workload.FinalizerProvider)
+}
+
+// publish emits an orphan event if a stream is configured
+func (c *OrphanCollector) publish(ctx context.Context, eventType stream.EventType, providerName string, resource *workload.Workload) {
+	if c.appState.Stream == nil {
+		return
+	}
+
+	event := &stream.Event{
+		Type:   eventType,
+		Source: "weaver.controller",
+		ID:     resource.Status.ProviderRef.ExternalID,
+		Data: map[string]interface{}{
+			"provider":   providerName,
+			"workloadId": resource.ID,
+			"name":       resource.Status.ProviderRef.Name,
+			"dryRun":     c.dryRun,
+		},
+	}
+
+	if err := stream.PublishEvent(ctx, c.appState.Stream, stream.SubjectProviders, providerName, event); err != nil {
+		c.logger.Warnf("Failed to publish %s event for provider %s: %v", eventType, providerName, err)
+	}
+}
@@ -0,0 +1,756 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/codecflow/fabric/pkg/config"
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/finalizer"
+	"github.com/codecflow/fabric/weaver/internal/lease"
+	"github.com/codecflow/fabric/weaver/internal/namespace"
+	"github.com/codecflow/fabric/weaver/internal/queue"
+	"github.com/codecflow/fabric/weaver/internal/repository"
+	"github.com/codecflow/fabric/weaver/internal/state"
+	"github.com/codecflow/fabric/weaver/services/provider"
+	"github.com/codecflow/fabric/weaver/services/scheduler"
+	"github.com/codecflow/fabric/weaver/services/stream"
+)
+
+const (
+	defaultResyncInterval = 10 * time.Second
+	defaultMaxBackoff     = 5 * time.Minute
+	initialBackoff        = 5 * time.Second
+	reconcileTimeout      = 30 * time.Second
+)
+
+// WorkloadController drives workloads from Pending to Running on their providers
+// and tears down deleted workloads. Only the replica holding the workload
+// controller lease reconciles, so a workload is not provisioned twice.
+type WorkloadController struct {
+	appState *state.State
+	logger   *logrus.Logger
+
+	resyncInterval time.Duration
+	maxBackoff     time.Duration
+	elector        *lease.Elector
+
+	mu       sync.Mutex
+	backoff  map[string]*backoffEntry
+	restarts map[string]*restartEntry
+
+	// Pending workloads are scheduled in queue order; capacity holds the last
+	// observed fingerprint of each provider's health and resources
+	queue    *queue.Queue
+	capacity map[string]string
+
+	// Terminating workloads are torn down by their finalizers
+	finalizers *finalizer.Runner
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// backoffEntry tracks retry state for a workload that failed to reconcile
+type backoffEntry struct {
+	attempts    int
+	nextAttempt time.Time
+}
+
+// restartEntry tracks the consecutive crashes of a workload that Weaver
+// restarts on a provider that does not restart it
+type restartEntry struct {
+	crashes   int
+	restartAt time.Time // zero while the workload is running
+}
+
+// New creates a new workload controller
+func New(appState *state.State, logger *logrus.Logger, cfg *config.ControllerConfig) *WorkloadController {
+	c := &WorkloadController{
+		appState:       appState,
+		logger:         logger,
+		resyncInterval: defaultResyncInterval,
+		maxBackoff:     defaultMaxBackoff,
+		backoff:        make(map[string]*backoffEntry),
+		restarts:       make(map[string]*restartEntry),
+		stopCh:         make(chan struct{}),
+	}
+
+	if cfg != nil {
+		if cfg.ResyncInterval > 0 {
+			c.resyncInterval = time.Duration(cfg.ResyncInterval) * time.Second
+		}
+		if cfg.MaxBackoff > 0 {
+			c.maxBackoff = time.Duration(cfg.MaxBackoff) * time.Second
+		}
+	}
+
+	c.queue = appState.Queue
+	if c.queue == nil {
+		c.queue = queue.New(c.maxBackoff)
+	}
+	c.capacity = make(map[string]string)
+	c.finalizers = finalizer.New(appState, logger)
+	c.elector = newElector(appState, workloadControllerLease, c.resyncInterval)
+
+	return c
+}
+
+// Start runs the reconcile loop until Stop is called or ctx is cancelled
+func (c *WorkloadController) Start(ctx context.Context) {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		ticker := time.NewTicker(c.resyncInterval)
+		defer ticker.Stop()
+
+		c.reconcileAll(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-c.stopCh:
+				return
+			case <-ticker.C:
+				c.reconcileAll(ctx)
+			case <-c.queue.Wake():
+				c.reconcileAll(ctx)
+			}
+		}
+	}()
+}
+
+// Stop stops the reconcile loop, waits for it to exit and hands the lease
+// over to the other replicas
+func (c *WorkloadController) Stop() {
+	close(c.stopCh)
+	c.wg.Wait()
+	resign(c.elector, c.logger, "workload controller")
+}
+
+// reconcileAll reconciles every workload that has not reached a terminal phase
+// while this replica leads
+func (c *WorkloadController) reconcileAll(ctx context.Context) {
+	if c.appState.Repository == nil || c.appState.Repository.Workload == nil {
+		return
+	}
+
+	if !lead(ctx, c.elector, c.logger, "workload controller") {
+		// The leader schedules the workloads queued on this replica
+		c.queue.Retain(nil)
+		return
+	}
+
+	workloads, err := c.appState.Repository.Workload.ListByPhase(ctx,
+		workload.PhasePending, workload.PhaseScheduled, workload.PhaseRunning)
+	if err != nil {
+		c.logger.Warnf("Failed to list workloads for reconciliation: %v", err)
+		return
+	}
+
+	active := make(map[string]bool, len(workloads))
+	pending := make(map[string]*workload.Workload)
+	for _, w := range workloads {
+		active[w.ID] = true
+
+		// Pending workloads wait in the scheduling queue
+		if w.Status.Phase == workload.PhasePending {
+			// A job waiting to run again may run out of time first
+			if deadline, ok := w.JobDeadline(); ok && !time.Now().Before(deadline) {
+				if err := c.expireJob(ctx, w, nil); err != nil {
+					c.logger.Warnf("Failed to expire job %s/%s: %v", w.Namespace, w.Name, err)
+				}
+				continue
+			}
+
+			c.queue.Add(w)
+			pending[w.ID] = w
+			continue
+		}
+
+		if !c.due(w.ID) {
+			continue
+		}
+		if !c.leads(ctx) {
+			return
+		}
+
+		reconcileCtx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+		err := c.reconcile(reconcileCtx, w)
+		cancel()
+
+		if err != nil {
+			c.requeue(ctx, w, err)
+			continue
+		}
+		c.forget(w.ID)
+	}
+
+	c.finalizeAll(ctx, active)
+	c.prune(active)
+	c.schedulePending(ctx, pending)
+	c.collectFinishedJobs(ctx)
+}
+
+// finalizeAll retries the finalizers of terminating workloads and records
+// them as active
+func (c *WorkloadController) finalizeAll(ctx context.Context, active map[string]bool) {
+	workloads, err := c.appState.Repository.Workload.ListByPhase(ctx, workload.PhaseTerminating)
+	if err != nil {
+		c.logger.Warnf("Failed to list terminating workloads: %v", err)
+		return
+	}
+
+	for _, w := range workloads {
+		active[w.ID] = true
+		if !c.due(w.ID) {
+			continue
+		}
+		if !c.leads(ctx) {
+			return
+		}
+
+		finalizeCtx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+		err := c.finalizers.Run(finalizeCtx, w)
+		cancel()
+
+		if err != nil {
+			c.requeue(ctx, w, err)
+			continue
+		}
+		c.forget(w.ID)
+	}
+}
+
+// schedulePending schedules the due workloads of the queue in priority order
+func (c *WorkloadController) schedulePending(ctx context.Context, pending map[string]*workload.Workload) {
+	keep := make(map[string]bool, len(pending))
+	for id := range pending {
+		keep[id] = true
+	}
+	c.queue.Retain(keep)
+
+	if c.queue.HasUnschedulable() {
+		c.checkCapacity(ctx)
+	}
+
+	for _, id := range c.queue.Due() {
+		// Workloads queued since the listing are picked up on the next pass
+		w, ok := pending[id]
+		if !ok {
+			continue
+		}
+		if !c.leads(ctx) {
+			return
+		}
+
+		reconcileCtx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+		err := c.reconcile(reconcileCtx, w)
+		cancel()
+
+		switch {
+		case err == nil:
+			c.queue.Remove(id)
+		case errors.Is(err, repository.ErrConflict):
+			// Scheduled again from its latest version on the next pass
+			c.logger.Debugf("Workload %s/%s changed while it was scheduled: %v", w.Namespace, w.Name, err)
+		case w.Status.Phase == workload.PhasePending:
+			c.backoffPending(ctx, w, err)
+		default:
+			// Scheduled but not provisioned; retried like any other reconcile error
+			c.queue.Remove(id)
+			c.requeue(ctx, w, err)
+		}
+	}
+}
+
+// leads reports whether this replica still leads for another reconcile
+func (c *WorkloadController) leads(ctx context.Context) bool {
+	return keep(ctx, c.elector, c.logger, "workload controller")
+}
+
+// backoffPending delays the next scheduling attempt of a pending workload and
+// records why it could not be scheduled
+func (c *WorkloadController) backoffPending(ctx context.Context, w *workload.Workload, err error) {
+	unschedulable := errors.Is(err, scheduler.ErrUnschedulable)
+	delay := c.queue.Backoff(w.ID, err, unschedulable)
+
+	c.logger.Warnf("Failed to schedule workload %s/%s (retrying in %s): %v", w.Namespace, w.Name, delay, err)
+
+	reason := "SchedulingFailed"
+	if unschedulable {
+		reason = workload.ReasonUnschedulable
+	}
+	if w.Status.Reason == reason && w.Status.Message == err.Error() {
+		return
+	}
+
+	previous := w.Status.Reason
+	w.Status.Reason = reason
+	w.Status.Message = err.Error()
+	if err := c.update(ctx, w); err != nil {
+		c.logger.Warnf("Failed to record scheduling error on workload %s: %v", w.ID, err)
+		return
+	}
+
+	if unschedulable && previous != reason {
+		c.publish(ctx, stream.EventWorkloadUnschedulable, w)
+	}
+}
+
+// checkCapacity wakes unschedulable workloads when the health or available
+// resources of a provider changed since the last check
+func (c *WorkloadController) checkCapacity(ctx context.Context) {
+	changed := false
+	for name, p := range c.appState.Providers {
+		checkCtx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+		fingerprint := capacityFingerprint(checkCtx, p)
+		cancel()
+
+		if previous, ok := c.capacity[name]; !ok || previous != fingerprint {
+			changed = changed || ok
+		}
+		c.capacity[name] = fingerprint
+	}
+
+	if changed {
+		c.logger.Info("Provider capacity changed, retrying unschedulable workloads")
+		c.queue.CapacityChanged()
+	}
+}
+
+// capacityFingerprint hashes the health and available resources of a provider
+func capacityFingerprint(ctx context.Context, p provider.Provider) string {
+	if err := p.HealthCheck(ctx); err != nil {
+		return "unhealthy"
+	}
+
+	resources, err := p.GetAvailableResources(ctx)
+	if err != nil {
+		return "unavailable"
+	}
+
+	data, _ := json.Marshal(resources)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// reconcile moves a single workload one step closer to its desired state
+func (c *WorkloadController) reconcile(ctx context.Context, w *workload.Workload) error {
+	switch w.Status.Phase {
+	case workload.PhasePending:
+		if err := c.schedule(ctx, w); err != nil {
+			return err
+		}
+		return c.provision(ctx, w)
+	case workload.PhaseScheduled:
+		return c.provision(ctx, w)
+	case workload.PhaseRunning:
+		p, err := c.providerFor(w)
+		if err != nil {
+			return err
+		}
+		return c.sync(ctx, w, p)
+	}
+
+	return nil
+}
+
+// schedule picks a provider for a pending workload
+func (c *WorkloadController) schedule(ctx context.Context, w *workload.Workload) error {
+	if c.appState.Scheduler == nil {
+		return fmt.Errorf("no scheduler configured")
+	}
+
+	result, err := c.appState.Scheduler.Schedule(ctx, w)
+	if err != nil {
+		return fmt.Errorf("failed to schedule workload: %w", err)
+	}
+
+	w.Status.Provider = result.Provider
+	if result.Placement != nil {
+		w.Status.NodeID = result.Placement.NodeID
+	}
+	w.Status.Phase = workload.PhaseScheduled
+	w.Status.Reason = ""
+	w.Status.Message = ""
+
+	if err := c.update(ctx, w); err != nil {
+		return err
+	}
+
+	c.publish(ctx, stream.EventWorkloadScheduled, w)
+	c.logger.Infof("Scheduled workload %s/%s on provider %s", w.Namespace, w.Name, w.Status.Provider)
+
+	return nil
+}
+
+// provision creates a scheduled workload on its provider
+func (c *WorkloadController) provision(ctx context.Context, w *workload.Workload) error {
+	p, err := c.providerFor(w)
+	if err != nil {
+		return err
+	}
+
+	// A provider reference means the workload was already created
+	if w.Status.ProviderRef == nil {
+		providerName := w.Status.Provider
+		err := p.CreateWorkload(ctx, w)
+		if observer, ok := c.appState.Scheduler.(scheduler.PlacementObserver); ok {
+			observer.ObservePlacement(providerName, err)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create workload on provider %s: %w", providerName, err)
+		}
+
+		// Providers may overwrite status fields; the phase is owned by the controller
+		// until the provider reports the workload as running.
+		w.Status.Provider = providerName
+		w.Status.Phase = workload.PhaseScheduled
+		w.Status.Reason = ""
+		w.Status.Message = fmt.Sprintf("Provisioned on provider %s", providerName)
+		if w.Spec.IsJob() && !runsJobs(p) {
+			w.StartJobRun(time.Now())
+		}
+
+		if err := c.update(ctx, w); err != nil {
+			return err
+		}
+
+		c.logger.Infof("Provisioned workload %s/%s on provider %s", w.Namespace, w.Name, providerName)
+	}
+
+	return c.sync(ctx, w, p)
+}
+
+// sync copies the status observed on the provider back to the repository
+func (c *WorkloadController) sync(ctx context.Context, w *workload.Workload, p provider.Provider) error {
+	observed, err := p.GetWorkload(ctx, w)
+	if err != nil {
+		return fmt.Errorf("failed to get workload from provider %s: %w", w.Status.Provider, err)
+	}
+
+	if w.Spec.IsJob() && !runsJobs(p) {
+		if handled, err := c.syncJob(ctx, w, p, &observed.Status); handled || err != nil {
+			return err
+		}
+	}
+
+	if restarts(w, p, observed.Status.Phase) {
+		return c.restart(ctx, w, p, &observed.Status)
+	}
+
+	previous := w.Status.Phase
+	if !mergeStatus(&w.Status, &observed.Status) {
+		return nil
+	}
+
+	if err := c.update(ctx, w); err != nil {
+		return err
+	}
+
+	c.transitioned(ctx, w, previous)
+	return nil
+}
+
+// transitioned logs a phase change of a workload and publishes its event.
+// Finished workloads release their resources.
+func (c *WorkloadController) transitioned(ctx context.Context, w *workload.Workload, previous workload.Phase) {
+	if w.Status.Phase == previous {
+		return
+	}
+
+	c.logger.Infof("Workload %s/%s transitioned from %s to %s", w.Namespace, w.Name, previous, w.Status.Phase)
+
+	switch w.Status.Phase {
+	case workload.PhaseRunning:
+		c.publish(ctx, stream.EventWorkloadStarted, w)
+	case workload.PhaseSucceeded:
+		c.publish(ctx, stream.EventWorkloadStopped, w)
+		c.release(ctx, w)
+	case workload.PhaseFailed:
+		c.publish(ctx, stream.EventWorkloadFailed, w)
+		c.release(ctx, w)
+	}
+}
+
+// restarts reports whether Weaver restarts a workload that the provider observed
+// in the given phase. Providers that restart workloads themselves report them as
+// running while they do.
+func restarts(w *workload.Workload, p provider.Provider, observed workload.Phase) bool {
+	if observed != workload.PhaseSucceeded && observed != workload.PhaseFailed {
+		return false
+	}
+	if restarter, ok := p.(provider.Restarter); ok && restarter.RestartsWorkloads() {
+		return false
+	}
+	return w.Spec.Restart.Restarts(observed == workload.PhaseFailed)
+}
+
+// restart recreates an exited workload on its provider. The workload stays
+// running while it waits out a backoff that grows with each consecutive crash.
+func (c *WorkloadController) restart(ctx context.Context, w *workload.Workload, p provider.Provider, observed *workload.Status) error {
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, ok := c.restarts[w.ID]
+	if !ok {
+		entry = &restartEntry{}
+		c.restarts[w.ID] = entry
+	}
+	exited := entry.restartAt.IsZero()
+	if exited {
+		// A workload that ran long enough before exiting starts a new crash series
+		if w.Status.StartTime != nil && now.Sub(*w.Status.StartTime) >= workload.RestartBackoffReset {
+			entry.crashes = 0
+		}
+		entry.crashes++
+		entry.restartAt = now.Add(workload.RestartBackoff(entry.crashes))
+	}
+	crashes, restartAt := entry.crashes, entry.restartAt
+	c.mu.Unlock()
+
+	if exited {
+		delay := restartAt.Sub(now).Round(time.Second)
+		w.Status.ExitCode = observed.ExitCode
+		w.Status.Reason = workload.RestartReason(crashes)
+		w.Status.Message = fmt.Sprintf("Exited with code %d, restarting in %s", observed.ExitCode, delay)
+		if err := c.update(ctx, w); err != nil {
+			return err
+		}
+		c.logger.Infof("Workload %s/%s exited on provider %s, restarting in %s", w.Namespace, w.Name, w.Status.Provider, delay)
+	}
+
+	if now.Before(restartAt) {
+		return nil
+	}
+
+	if err := p.DeleteWorkload(ctx, w); err != nil && !errors.Is(err, provider.ErrNoProviderReference) {
+		return fmt.Errorf("failed to delete exited workload from provider %s: %w", w.Status.Provider, err)
+	}
+
+	w.Status.Phase = workload.PhaseScheduled
+	w.Status.RestartCount++
+	w.Status.ProviderRef = nil
+	w.Status.ContainerID = ""
+	w.Status.StartTime = nil
+	w.Status.FinishTime = nil
+	if err := c.update(ctx, w); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	entry.restartAt = time.Time{}
+	c.mu.Unlock()
+
+	c.logger.Infof("Restarting workload %s/%s on provider %s (restart %d)", w.Namespace, w.Name, w.Status.Provider, w.Status.RestartCount)
+	return c.provision(ctx, w)
+}
+
+// mergeStatus applies observed provider status onto the stored status and reports whether anything changed
+func mergeStatus(current, observed *workload.Status) bool { // nolint:gocyclo
+	changed := false
+
+	// Pending on the provider side means the workload is placed but not yet running,
+	// and Unknown is left for the next poll rather than stopping reconciliation
+	switch observed.Phase {
+	case workload.PhaseRunning, workload.PhaseSucceeded, workload.PhaseFailed:
+		if current.Phase != observed.Phase {
+			current.Phase = observed.Phase
+			changed = true
+		}
+	}
+
+	if observed.Message != "" && current.Message != observed.Message {
+		current.Message = observed.Message
+		changed = true
+	}
+	if observed.Reason != "" && current.Reason != observed.Reason {
+		current.Reason = observed.Reason
+		changed = true
+	}
+	if observed.ContainerID != "" && current.ContainerID != observed.ContainerID {
+		current.ContainerID = observed.ContainerID
+		changed = true
+	}
+	if observed.RestartCount > current.RestartCount {
+		current.RestartCount = observed.RestartCount
+		changed = true
+	}
+	if observed.ExitCode != 0 && current.ExitCode != observed.ExitCode {
+		current.ExitCode = observed.ExitCode
+		changed = true
+	}
+	// A workload running again is no longer waiting to be restarted
+	if observed.Phase == workload.PhaseRunning && observed.Reason == "" && current.Reason == workload.ReasonCrashLoopBackOff {
+		current.Reason = ""
+		changed = true
+	}
+	if observed.StartTime != nil && current.StartTime == nil {
+		current.StartTime = observed.StartTime
+		changed = true
+	}
+	if observed.FinishTime != nil && current.FinishTime == nil {
+		current.FinishTime = observed.FinishTime
+		changed = true
+	}
+	// Providers that run jobs natively count their runs
+	if observed.Job != nil && !reflect.DeepEqual(current.Job, observed.Job) {
+		current.Job = observed.Job
+		changed = true
+	}
+
+	return changed
+}
+
+// release returns the resources of a finished workload to its namespace's quota
+// and lets queued workloads use the freed capacity
+func (c *WorkloadController) release(ctx context.Context, w *workload.Workload) {
+	if c.appState.Repository.Namespace != nil {
+		if err := namespace.RefreshUsage(ctx, c.appState.Repository.Namespace, c.appState.Repository.Workload, w.Namespace); err != nil {
+			c.logger.Warnf("Failed to refresh usage of namespace %s: %v", w.Namespace, err)
+		}
+	}
+
+	c.queue.CapacityChanged()
+}
+
+// providerFor returns the provider a workload has been scheduled on
+func (c *WorkloadController) providerFor(w *workload.Workload) (provider.Provider, error) {
+	if w.Status.Provider == "" {
+		return nil, fmt.Errorf("workload has no provider assigned")
+	}
+
+	p, ok := c.appState.GetProvider(w.Status.Provider)
+	if !ok {
+		return nil, fmt.Errorf("provider %s not available", w.Status.Provider)
+	}
+
+	return p, nil
+}
+
+// update persists the workload status
+func (c *WorkloadController) update(ctx context.Context, w *workload.Workload) error {
+	w.UpdatedAt = time.Now()
+	if err := c.appState.Repository.Workload.Update(ctx, w); err != nil {
+		return fmt.Errorf("failed to update workload: %w", err)
+	}
+	return nil
+}
+
+// requeue records a failed attempt and schedules the next one with exponential backoff
+func (c *WorkloadController) requeue(ctx context.Context, w *workload.Workload, err error) {
+	// The workload changed since it was listed and is reconciled again from its
+	// latest version on the next pass
+	if errors.Is(err, repository.ErrConflict) {
+		c.logger.Debugf("Workload %s/%s changed during reconciliation: %v", w.Namespace, w.Name, err)
+		return
+	}
+
+	c.mu.Lock()
+	entry, ok := c.backoff[w.ID]
+	if !ok {
+		entry = &backoffEntry{}
+		c.backoff[w.ID] = entry
+	}
+	entry.attempts++
+
+	delay := initialBackoff
+	for i := 1; i < entry.attempts && delay < c.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > c.maxBackoff {
+		delay = c.maxBackoff
+	}
+	entry.nextAttempt = time.Now().Add(delay)
+	attempts := entry.attempts
+	c.mu.Unlock()
+
+	c.logger.Warnf("Failed to reconcile workload %s/%s (attempt %d, retrying in %s): %v",
+		w.Namespace, w.Name, attempts, delay, err)
+
+	// Surface the failure on the workload without changing its phase
+	reason := "ReconcileFailed"
+	switch w.Status.Phase {
+	case workload.PhasePending:
+		reason = "SchedulingFailed"
+	case workload.PhaseScheduled:
+		reason = "ProvisioningFailed"
+	case workload.PhaseTerminating:
+		reason = "FinalizationFailed"
+	}
+
+	if w.Status.Reason != reason || w.Status.Message != err.Error() {
+		w.Status.Reason = reason
+		w.Status.Message = err.Error()
+		if err := c.update(ctx, w); err != nil {
+			c.logger.Warnf("Failed to record reconcile error on workload %s: %v", w.ID, err)
+		}
+	}
+}
+
+// due reports whether a workload is ready to be reconciled
+func (c *WorkloadController) due(id string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.backoff[id]
+	return !ok || !time.Now().Before(entry.nextAttempt)
+}
+
+// forget clears the backoff state of a workload
+func (c *WorkloadController) forget(id string) {
+	c.mu.Lock()
+	delete(c.backoff, id)
+	c.mu.Unlock()
+}
+
+// prune drops state for workloads that are no longer being reconciled
+func (c *WorkloadController) prune(active map[string]bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id := range c.backoff {
+		if !active[id] {
+			delete(c.backoff, id)
+		}
+	}
+	for id := range c.restarts {
+		if !active[id] {
+			delete(c.restarts, id)
+		}
+	}
+}
+
+// publish emits a workload event if a stream is configured
+func (c *WorkloadController) publish(ctx context.Context, eventType stream.EventType, w *workload.Workload) {
+	if c.appState.Stream == nil {
+		return
+	}
+
+	event := &stream.Event{
+		Type:   eventType,
+		Source: "weaver.controller",
+		ID:     w.ID,
+		Data: map[string]interface{}{
+			"name":      w.Name,
+			"namespace": w.Namespace,
+			"phase":     string(w.Status.Phase),
+			"provider":  w.Status.Provider,
+		},
+	}
+
+	if err := stream.PublishEvent(ctx, c.appState.Stream, stream.SubjectWorkloads, w.ID, event); err != nil {
+		c.logger.Warnf("Failed to publish %s event for workload %s: %v", eventType, w.ID, err)
+	}
+}
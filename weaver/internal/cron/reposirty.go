@@ -0,0 +1,12 @@
+package cron
+
+import "context"
+
+type Repository interface {
+	Create(ctx context.Context, cw *CronWorkload) error
+	Get(ctx context.Context, namespace, name string) (*CronWorkload, error)
+	Update(ctx context.Context, cw *CronWorkload) error
+	UpdateStatus(ctx context.Context, cw *CronWorkload) error
+	Delete(ctx context.Context, namespace, name string) error
+	List(ctx context.Context, namespace string, labels map[string]string) ([]*CronWorkload, error) // all namespaces when empty
+}
@@ -0,0 +1,179 @@
+package cron
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/codecflow/fabric/pkg/workload"
+)
+
+// Labels and annotations set on the runs of a cron workload
+const (
+	LabelCronWorkload      = "fabric.cron-workload"
+	AnnotationScheduleTime = "fabric.cron-workload/schedule-time"
+)
+
+// MaxNameLength leaves room for the suffix that run names add to the name of
+// their cron workload
+const MaxNameLength = 52
+
+const (
+	defaultSuccessfulRunsHistoryLimit int32 = 3
+	defaultFailedRunsHistoryLimit     int32 = 1
+)
+
+// ConcurrencyPolicy decides what happens when a run is due while an earlier
+// run is still active
+type ConcurrencyPolicy string
+
+const (
+	ConcurrencyAllow   ConcurrencyPolicy = "Allow"   // runs may overlap
+	ConcurrencyForbid  ConcurrencyPolicy = "Forbid"  // the new run waits for the active one
+	ConcurrencyReplace ConcurrencyPolicy = "Replace" // the active run is deleted for the new one
+)
+
+// Spec defines when and what a cron workload runs
+type Spec struct {
+	Schedule string `json:"schedule"`           // five-field cron expression or a macro such as @daily
+	TimeZone string `json:"timeZone,omitempty"` // IANA name, UTC when empty
+
+	Template          Template          `json:"template"`
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// StartingDeadlineSeconds bounds how late a run may start, e.g. after
+	// Weaver was down; later runs are skipped. Unset starts them however late.
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// Finished runs kept for inspection, the oldest are deleted first
+	SuccessfulRunsHistoryLimit *int32 `json:"successfulRunsHistoryLimit,omitempty"` // default 3
+	FailedRunsHistoryLimit     *int32 `json:"failedRunsHistoryLimit,omitempty"`     // default 1
+
+	// Suspend stops new runs; active runs continue
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// Template describes the workload created for every run
+type Template struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Spec        workload.Spec     `json:"spec"`
+}
+
+// Status represents the runs of a cron workload
+type Status struct {
+	Active []string `json:"active,omitempty"` // IDs of unfinished runs
+
+	LastScheduleTime   *time.Time `json:"lastScheduleTime,omitempty"`
+	LastSuccessfulTime *time.Time `json:"lastSuccessfulTime,omitempty"`
+	NextScheduleTime   *time.Time `json:"nextScheduleTime,omitempty"`
+}
+
+// CronWorkload creates a workload from its template on a cron schedule
+type CronWorkload struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	Spec   Spec   `json:"spec"`
+	Status Status `json:"status"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Default fills in the settings left unset. Runs are jobs unless the template
+// says otherwise.
+func (s *Spec) Default() {
+	if s.ConcurrencyPolicy == "" {
+		s.ConcurrencyPolicy = ConcurrencyAllow
+	}
+	if s.SuccessfulRunsHistoryLimit == nil {
+		limit := defaultSuccessfulRunsHistoryLimit
+		s.SuccessfulRunsHistoryLimit = &limit
+	}
+	if s.FailedRunsHistoryLimit == nil {
+		limit := defaultFailedRunsHistoryLimit
+		s.FailedRunsHistoryLimit = &limit
+	}
+	if s.Template.Spec.Kind == "" {
+		s.Template.Spec.Kind = workload.KindJob
+	}
+	s.Template.Spec.Default()
+}
+
+// Validate checks the schedule, the policies and the template
+func (s *Spec) Validate() error {
+	if _, err := Parse(s.Schedule, s.TimeZone); err != nil {
+		return err
+	}
+
+	switch s.ConcurrencyPolicy {
+	case "", ConcurrencyAllow, ConcurrencyForbid, ConcurrencyReplace:
+	default:
+		return fmt.Errorf("unknown concurrency policy %q", s.ConcurrencyPolicy)
+	}
+
+	if s.StartingDeadlineSeconds != nil && *s.StartingDeadlineSeconds < 0 {
+		return fmt.Errorf("startingDeadlineSeconds must not be negative")
+	}
+	if s.SuccessfulRunsHistoryLimit != nil && *s.SuccessfulRunsHistoryLimit < 0 {
+		return fmt.Errorf("successfulRunsHistoryLimit must not be negative")
+	}
+	if s.FailedRunsHistoryLimit != nil && *s.FailedRunsHistoryLimit < 0 {
+		return fmt.Errorf("failedRunsHistoryLimit must not be negative")
+	}
+
+	// Services never finish, so they would count as active forever
+	if !s.Template.Spec.IsJob() {
+		return fmt.Errorf("the template must describe a workload of kind %s", workload.KindJob)
+	}
+	if err := s.Template.Spec.Validate(); err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+
+	return nil
+}
+
+// Runs selects the workloads created by the cron workload
+func (cw *CronWorkload) Runs() workload.Filter {
+	return workload.Filter{
+		Namespace: cw.Namespace,
+		Labels: workload.Selector{{
+			Key:      LabelCronWorkload,
+			Operator: workload.OperatorEquals,
+			Values:   []string{cw.Name},
+		}},
+	}
+}
+
+// NewRun builds the workload of the run scheduled at the given time from the
+// template, labelled with the name of the cron workload
+func (cw *CronWorkload) NewRun(scheduled time.Time) *workload.Workload {
+	labels := make(map[string]string, len(cw.Spec.Template.Labels)+1)
+	for key, value := range cw.Spec.Template.Labels {
+		labels[key] = value
+	}
+	labels[LabelCronWorkload] = cw.Name
+
+	annotations := make(map[string]string, len(cw.Spec.Template.Annotations)+1)
+	for key, value := range cw.Spec.Template.Annotations {
+		annotations[key] = value
+	}
+	annotations[AnnotationScheduleTime] = scheduled.UTC().Format(time.RFC3339)
+
+	return &workload.Workload{
+		Name:        cw.RunName(scheduled),
+		Namespace:   cw.Namespace,
+		Labels:      labels,
+		Annotations: annotations,
+		Spec:        cw.Spec.Template.Spec,
+	}
+}
+
+// RunName names the run scheduled at the given time. Names are unique per
+// schedule time, so a run is never created twice.
+func (cw *CronWorkload) RunName(scheduled time.Time) string {
+	return fmt.Sprintf("%s-%d", cw.Name, scheduled.Unix()/60)
+}
@@ -0,0 +1,253 @@
+package finalizer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/namespace"
+	"github.com/codecflow/fabric/weaver/internal/repository"
+	"github.com/codecflow/fabric/weaver/internal/state"
+	"github.com/codecflow/fabric/weaver/internal/storage"
+	"github.com/codecflow/fabric/weaver/services/provider"
+	"github.com/codecflow/fabric/weaver/services/stream"
+)
+
+// startAttempts bounds how often Start reloads a workload that changed while
+// it was being marked as terminating
+const startAttempts = 3
+
+// Runner deletes workloads in two phases: Start marks a workload as
+// terminating and Run tears down what it holds, removing the workload once
+// every finalizer has succeeded
+type Runner struct {
+	appState *state.State
+	logger   *logrus.Logger
+}
+
+// New creates a new finalizer runner
+func New(appState *state.State, logger *logrus.Logger) *Runner {
+	return &Runner{
+		appState: appState,
+		logger:   logger,
+	}
+}
+
+// For returns the finalizers a workload is deleted with, in the order they run.
+// Traffic is cut before the workload is torn down, and its data is removed last.
+func For(w *workload.Workload) []string {
+	finalizers := []string{workload.FinalizerRoute, workload.FinalizerProvider}
+	if provider.HasSecrets(w) {
+		finalizers = append(finalizers, workload.FinalizerSecrets)
+	}
+	return append(finalizers, workload.FinalizerStorage)
+}
+
+// Start marks a workload as terminating. Workloads that are already
+// terminating are left unchanged. The deletion is applied on top of changes
+// made since the workload was read, such as status updates.
+func (r *Runner) Start(ctx context.Context, w *workload.Workload) error {
+	for attempt := 1; ; attempt++ {
+		if w.Terminating() {
+			return nil
+		}
+
+		now := time.Now()
+		w.DeletedAt = &now
+		w.Finalizers = For(w)
+		w.Status.Phase = workload.PhaseTerminating
+		w.Status.Reason = ""
+		w.Status.Message = "Deleting workload"
+		w.UpdatedAt = now
+
+		err := r.appState.Repository.Workload.Update(ctx, w)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, repository.ErrConflict) || attempt == startAttempts {
+			return fmt.Errorf("failed to mark workload as terminating: %w", err)
+		}
+
+		latest, err := r.appState.Repository.Workload.Get(ctx, w.ID)
+		if err != nil {
+			return fmt.Errorf("failed to mark workload as terminating: %w", err)
+		}
+		*w = *latest
+	}
+
+	// A terminating workload is never scheduled
+	if r.appState.Queue != nil {
+		r.appState.Queue.Remove(w.ID)
+	}
+
+	r.logger.Infof("Deleting workload %s/%s", w.Namespace, w.Name)
+	return nil
+}
+
+// Run runs the pending finalizers of a terminating workload in order and
+// removes the workload once none are left. Completed finalizers are recorded,
+// so a failed run continues where it stopped.
+func (r *Runner) Run(ctx context.Context, w *workload.Workload) error {
+	for len(w.Finalizers) > 0 {
+		name := w.Finalizers[0]
+		if err := r.finalize(ctx, name, w); err != nil {
+			return fmt.Errorf("finalizer %s failed: %w", name, err)
+		}
+
+		w.RemoveFinalizer(name)
+		w.UpdatedAt = time.Now()
+		if err := r.appState.Repository.Workload.Update(ctx, w); err != nil {
+			return fmt.Errorf("failed to update workload: %w", err)
+		}
+	}
+
+	return r.Remove(ctx, w)
+}
+
+// Remove deletes a workload from the repository, whether or not its finalizers
+// have run, and releases its share of the namespace quota
+func (r *Runner) Remove(ctx context.Context, w *workload.Workload) error {
+	if err := r.appState.Repository.Workload.Delete(ctx, w.ID); err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("failed to delete workload: %w", err)
+	}
+
+	if r.appState.Repository.Namespace != nil {
+		if err := namespace.RefreshUsage(ctx, r.appState.Repository.Namespace, r.appState.Repository.Workload, w.Namespace); err != nil {
+			r.logger.Warnf("Failed to refresh usage of namespace %s: %v", w.Namespace, err)
+		}
+	}
+	if r.appState.Queue != nil {
+		r.appState.Queue.Remove(w.ID)
+	}
+
+	r.publish(ctx, w)
+	r.logger.Infof("Deleted workload %s/%s", w.Namespace, w.Name)
+
+	return nil
+}
+
+// finalize runs a single finalizer
+func (r *Runner) finalize(ctx context.Context, name string, w *workload.Workload) error {
+	switch name {
+	case workload.FinalizerRoute:
+		if r.appState.Proxy != nil {
+			r.appState.Proxy.RemoveRoute(w)
+		}
+		return nil
+	case workload.FinalizerProvider:
+		return r.deleteFromProvider(ctx, w)
+	case workload.FinalizerSecrets:
+		return r.unmountSecrets(ctx, w)
+	case workload.FinalizerStorage:
+		return r.deleteContent(ctx, w)
+	}
+
+	return fmt.Errorf("unknown finalizer")
+}
+
+// deleteFromProvider removes the provider-side resource of a provisioned workload
+func (r *Runner) deleteFromProvider(ctx context.Context, w *workload.Workload) error {
+	if w.Status.ProviderRef == nil {
+		return nil
+	}
+
+	p, err := r.providerFor(w)
+	if err != nil {
+		return err
+	}
+
+	if err := p.DeleteWorkload(ctx, w); err != nil && !errors.Is(err, provider.ErrNoProviderReference) {
+		return fmt.Errorf("failed to delete workload from provider %s: %w", w.Status.Provider, err)
+	}
+
+	// Capacity freed by the workload may let queued workloads be scheduled
+	if r.appState.Queue != nil {
+		r.appState.Queue.CapacityChanged()
+	}
+
+	return nil
+}
+
+// unmountSecrets removes the secrets a provider stored for the workload
+func (r *Runner) unmountSecrets(ctx context.Context, w *workload.Workload) error {
+	if w.Status.Provider == "" {
+		return nil
+	}
+
+	p, err := r.providerFor(w)
+	if err != nil {
+		return err
+	}
+
+	unmounter, ok := p.(provider.SecretUnmounter)
+	if !ok {
+		return nil
+	}
+	return unmounter.UnmountSecrets(ctx, w)
+}
+
+// deleteContent removes the snapshots and volume content stored for the workload.
+// Content a volume was created from is shared and kept.
+func (r *Runner) deleteContent(ctx context.Context, w *workload.Workload) error {
+	if r.appState.Storage == nil {
+		return nil
+	}
+
+	snapshots, err := r.appState.Storage.ListSnapshots(ctx, w.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	for _, snapshot := range snapshots {
+		if err := r.appState.Storage.DeleteSnapshot(ctx, snapshot.ID); err != nil {
+			return fmt.Errorf("failed to delete snapshot %s: %w", snapshot.ID, err)
+		}
+	}
+
+	contents, err := r.appState.Storage.List(ctx, storage.ContentFilter{WorkloadID: w.ID})
+	if err != nil {
+		return fmt.Errorf("failed to list content: %w", err)
+	}
+	for _, content := range contents {
+		if err := r.appState.Storage.Delete(ctx, content.CID); err != nil {
+			return fmt.Errorf("failed to delete content %s: %w", content.CID, err)
+		}
+	}
+
+	return nil
+}
+
+// providerFor returns the provider a workload was placed on. Teardown waits
+// for a provider that is not available rather than leaving resources behind.
+func (r *Runner) providerFor(w *workload.Workload) (provider.Provider, error) {
+	p, ok := r.appState.GetProvider(w.Status.Provider)
+	if !ok {
+		return nil, fmt.Errorf("provider %s not available", w.Status.Provider)
+	}
+	return p, nil
+}
+
+// publish emits a workload deleted event if a stream is configured
+func (r *Runner) publish(ctx context.Context, w *workload.Workload) {
+	if r.appState.Stream == nil {
+		return
+	}
+
+	event := &stream.Event{
+		Type:   stream.EventWorkloadDeleted,
+		Source: "weaver.workload",
+		ID:     w.ID,
+		Data: map[string]interface{}{
+			"name":      w.Name,
+			"namespace": w.Namespace,
+			"provider":  w.Status.Provider,
+		},
+	}
+
+	if err := stream.PublishEvent(ctx, r.appState.Stream, stream.SubjectWorkloads, w.ID, event); err != nil {
+		r.logger.Warnf("Failed to publish %s event for workload %s: %v", event.Type, w.ID, err)
+	}
+}
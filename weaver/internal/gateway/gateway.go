@@ -0,0 +1,460 @@
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/codecflow/fabric/pkg/proto/weaver"
+)
+
+// OpenAPIPath serves the OpenAPI document describing the gateway
+const OpenAPIPath = "/openapi.json"
+
+// maxBodySize bounds request bodies
+const maxBodySize = 16 << 20
+
+var (
+	marshaler   = protojson.MarshalOptions{}
+	unmarshaler = protojson.UnmarshalOptions{}
+)
+
+// Gateway serves the Weaver service as HTTP/JSON. Requests are dispatched
+// through the generated gRPC handlers, so both APIs share the same server.
+type Gateway struct {
+	server   weaver.WeaverServiceServer
+	logger   *logrus.Logger
+	unary    grpc.UnaryServerInterceptor
+	stream   grpc.StreamServerInterceptor
+	bindings []*binding
+	openAPI  []byte
+}
+
+// binding is a route resolved against the service descriptors
+type binding struct {
+	route
+	segments []string // literal segments and {field} variables
+	verb     string   // custom method suffix of the last segment
+	desc     protoreflect.MethodDescriptor
+	input    protoreflect.MessageType
+	unary    *grpc.MethodDesc
+	stream   *grpc.StreamDesc
+}
+
+// New creates a gateway for a Weaver service implementation. Requests pass
+// through the same interceptors as on the gRPC server.
+func New(server weaver.WeaverServiceServer, logger *logrus.Logger, unary grpc.UnaryServerInterceptor, stream grpc.StreamServerInterceptor) (*Gateway, error) {
+	service := weaver.File_weaver_proto_weaver_weaver_proto.Services().ByName("WeaverService")
+	if service == nil {
+		return nil, fmt.Errorf("weaver service descriptor not found")
+	}
+
+	g := &Gateway{server: server, logger: logger, unary: unary, stream: stream}
+	mapped := make(map[string]bool, len(routes))
+	for _, r := range routes {
+		b, err := newBinding(service, r)
+		if err != nil {
+			return nil, err
+		}
+		g.bindings = append(g.bindings, b)
+		mapped[r.rpc] = true
+	}
+
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		if name := string(methods.Get(i).Name()); !mapped[name] {
+			return nil, fmt.Errorf("rpc %s has no HTTP route", name)
+		}
+	}
+
+	openAPI, err := buildOpenAPI(g.bindings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build OpenAPI document: %w", err)
+	}
+	g.openAPI = openAPI
+
+	return g, nil
+}
+
+// newBinding resolves a route's RPC and parses its path template
+func newBinding(service protoreflect.ServiceDescriptor, r route) (*binding, error) {
+	rpc := service.Methods().ByName(protoreflect.Name(r.rpc))
+	if rpc == nil {
+		return nil, fmt.Errorf("route %s %s: unknown rpc %s", r.method, r.path, r.rpc)
+	}
+
+	input, err := protoregistry.GlobalTypes.FindMessageByName(rpc.Input().FullName())
+	if err != nil {
+		return nil, fmt.Errorf("route %s %s: %w", r.method, r.path, err)
+	}
+
+	b := &binding{route: r, desc: rpc, input: input}
+
+	path := r.path
+	if i := strings.LastIndex(path, ":"); i > strings.LastIndex(path, "/") {
+		path, b.verb = path[:i], path[i+1:]
+	}
+	b.segments = strings.Split(strings.Trim(path, "/"), "/")
+	for _, segment := range b.segments {
+		if name, ok := variable(segment); ok && rpc.Input().Fields().ByName(protoreflect.Name(name)) == nil {
+			return nil, fmt.Errorf("route %s %s: %s has no field %s", r.method, r.path, rpc.Input().FullName(), name)
+		}
+	}
+
+	for i := range weaver.WeaverService_ServiceDesc.Methods {
+		if desc := &weaver.WeaverService_ServiceDesc.Methods[i]; desc.MethodName == r.rpc {
+			b.unary = desc
+		}
+	}
+	for i := range weaver.WeaverService_ServiceDesc.Streams {
+		if desc := &weaver.WeaverService_ServiceDesc.Streams[i]; desc.StreamName == r.rpc {
+			b.stream = desc
+		}
+	}
+	if b.unary == nil && b.stream == nil {
+		return nil, fmt.Errorf("route %s %s: rpc %s has no handler", r.method, r.path, r.rpc)
+	}
+
+	return b, nil
+}
+
+// variable returns the field named by a {field} path segment
+func variable(segment string) (string, bool) {
+	if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+		return segment[1 : len(segment)-1], true
+	}
+	return "", false
+}
+
+// match returns the path variables if the path matches the binding's template
+func (b *binding) match(path string) (map[string]string, bool) {
+	if b.verb != "" {
+		var ok bool
+		if path, ok = strings.CutSuffix(path, ":"+b.verb); !ok {
+			return nil, false
+		}
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) != len(b.segments) {
+		return nil, false
+	}
+
+	vars := make(map[string]string)
+	for i, segment := range segments {
+		value, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, false
+		}
+		if name, ok := variable(b.segments[i]); ok {
+			if value == "" {
+				return nil, false
+			}
+			vars[name] = value
+		} else if value != b.segments[i] {
+			return nil, false
+		}
+	}
+	return vars, true
+}
+
+// ServeHTTP dispatches a request to the RPC its route maps to
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == OpenAPIPath && r.Method == http.MethodGet {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(g.openAPI)
+		return
+	}
+
+	found := false
+	for _, b := range g.bindings {
+		vars, ok := b.match(r.URL.EscapedPath())
+		if !ok {
+			continue
+		}
+		found = true
+		if b.method != r.Method {
+			continue
+		}
+		g.serve(w, r, b, vars)
+		return
+	}
+
+	if found {
+		writeError(w, status.Errorf(codes.Unimplemented, "method %s not allowed on %s", r.Method, r.URL.Path), http.StatusMethodNotAllowed)
+		return
+	}
+	writeError(w, status.Errorf(codes.NotFound, "no route for %s", r.URL.Path), 0)
+}
+
+// serve decodes the request messages and invokes the RPC
+func (g *Gateway) serve(w http.ResponseWriter, r *http.Request, b *binding, vars map[string]string) {
+	ctx := metadata.NewIncomingContext(r.Context(), incomingMetadata(r.Header))
+	if r.TLS != nil {
+		// Client certificates identify callers as they do on the gRPC server
+		ctx = peer.NewContext(ctx, &peer.Peer{
+			Addr:     remoteAddr(r.RemoteAddr),
+			AuthInfo: credentials.TLSInfo{State: *r.TLS},
+		})
+	}
+
+	requests, err := b.decode(w, r, vars)
+	if err != nil {
+		writeError(w, status.Error(codes.InvalidArgument, err.Error()), 0)
+		return
+	}
+
+	if b.stream != nil {
+		stream := newEventStream(ctx, w, requests)
+		if err := g.serveStream(b, stream); err != nil {
+			g.logger.Debugf("Stream %s ended: %v", b.rpc, err)
+			stream.fail(err)
+		}
+		return
+	}
+
+	resp, err := b.unary.Handler(g.server, ctx, func(m interface{}) error {
+		proto.Merge(m.(proto.Message), requests[0])
+		return nil
+	}, g.unary)
+	if err != nil {
+		writeError(w, err, 0)
+		return
+	}
+
+	data, err := marshaler.Marshal(resp.(proto.Message))
+	if err != nil {
+		writeError(w, status.Errorf(codes.Internal, "failed to encode response: %v", err), 0)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// serveStream runs a streaming RPC through the stream interceptor
+func (g *Gateway) serveStream(b *binding, stream *eventStream) error {
+	if g.stream == nil {
+		return b.stream.Handler(g.server, stream)
+	}
+
+	info := &grpc.StreamServerInfo{
+		FullMethod:     fmt.Sprintf("/%s/%s", weaver.WeaverService_ServiceDesc.ServiceName, b.stream.StreamName),
+		IsClientStream: b.stream.ClientStreams,
+		IsServerStream: b.stream.ServerStreams,
+	}
+	return g.stream(g.server, stream, info, b.stream.Handler)
+}
+
+// decode builds the request messages of an RPC from the body, path variables
+// and query parameters. Client-streaming RPCs take a JSON array of messages.
+func (b *binding) decode(w http.ResponseWriter, r *http.Request, vars map[string]string) ([]proto.Message, error) { // nolint:gocyclo
+	var body []byte
+	if b.body {
+		var err error
+		body, err = io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read body: %v", err)
+		}
+	}
+
+	if b.stream != nil && b.stream.ClientStreams {
+		var raw []json.RawMessage
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &raw); err != nil {
+				return nil, fmt.Errorf("body must be a JSON array of %s messages: %v", b.input.Descriptor().Name(), err)
+			}
+		}
+		requests := make([]proto.Message, 0, len(raw))
+		for i, data := range raw {
+			m := b.input.New().Interface()
+			if err := unmarshaler.Unmarshal(data, m); err != nil {
+				return nil, fmt.Errorf("message %d: %v", i, err)
+			}
+			requests = append(requests, m)
+		}
+		return requests, nil
+	}
+
+	m := b.input.New().Interface()
+	if len(body) > 0 {
+		if err := unmarshaler.Unmarshal(body, m); err != nil {
+			return nil, fmt.Errorf("invalid body: %v", err)
+		}
+	}
+
+	// Path variables win over the body, and the query applies without a body
+	params := make(map[string]interface{})
+	if !b.body {
+		for key, values := range r.URL.Query() {
+			if err := setParam(params, b.input.Descriptor(), key, values); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for name, value := range vars {
+		if err := setParam(params, b.input.Descriptor(), name, []string{value}); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(params) > 0 {
+		data, err := json.Marshal(params)
+		if err != nil {
+			return nil, err
+		}
+		fromParams := b.input.New().Interface()
+		if err := unmarshaler.Unmarshal(data, fromParams); err != nil {
+			return nil, fmt.Errorf("invalid parameters: %v", err)
+		}
+		proto.Merge(m, fromParams)
+	}
+
+	return []proto.Message{m}, nil
+}
+
+// setParam stores a parameter in a JSON object for protojson to decode.
+// Dotted keys select nested fields and key[name] selects a map entry.
+func setParam(obj map[string]interface{}, md protoreflect.MessageDescriptor, key string, values []string) error {
+	name, rest, nested := strings.Cut(key, ".")
+
+	var mapKey string
+	if open := strings.Index(name, "["); open >= 0 && strings.HasSuffix(name, "]") {
+		name, mapKey = name[:open], name[open+1:len(name)-1]
+	}
+
+	fd := md.Fields().ByJSONName(name)
+	if fd == nil {
+		fd = md.Fields().ByName(protoreflect.Name(name))
+	}
+	if fd == nil {
+		return fmt.Errorf("unknown parameter %q", key)
+	}
+
+	switch {
+	case fd.IsMap():
+		if mapKey == "" || nested {
+			return fmt.Errorf("parameter %q must be given as %s[key]", key, name)
+		}
+		entries, _ := obj[fd.JSONName()].(map[string]interface{})
+		if entries == nil {
+			entries = make(map[string]interface{})
+			obj[fd.JSONName()] = entries
+		}
+		entries[mapKey] = paramValue(fd.MapValue(), values[len(values)-1])
+	case nested:
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() {
+			return fmt.Errorf("parameter %q does not name a message field", key)
+		}
+		child, _ := obj[fd.JSONName()].(map[string]interface{})
+		if child == nil {
+			child = make(map[string]interface{})
+			obj[fd.JSONName()] = child
+		}
+		return setParam(child, fd.Message(), rest, values)
+	case fd.IsList():
+		list := make([]interface{}, len(values))
+		for i, value := range values {
+			list[i] = paramValue(fd, value)
+		}
+		obj[fd.JSONName()] = list
+	default:
+		obj[fd.JSONName()] = paramValue(fd, values[len(values)-1])
+	}
+
+	return nil
+}
+
+// paramValue types a parameter for protojson, which takes every other scalar
+// and well-known type as a string
+func paramValue(fd protoreflect.FieldDescriptor, value string) interface{} {
+	if fd.Kind() == protoreflect.BoolKind {
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return value
+}
+
+// incomingMetadata passes the request headers on as gRPC metadata
+func incomingMetadata(header http.Header) metadata.MD {
+	md := make(metadata.MD, len(header))
+	for key, values := range header {
+		md.Append(strings.ToLower(key), values...)
+	}
+	return md
+}
+
+// writeError writes an error as a JSON google.rpc.Status. Without an explicit
+// HTTP status one is derived from the gRPC code.
+func writeError(w http.ResponseWriter, err error, httpStatus int) {
+	s := status.Convert(err)
+	if httpStatus == 0 {
+		httpStatus = HTTPStatus(s.Code())
+	}
+
+	data, marshalErr := marshaler.Marshal(s.Proto())
+	if marshalErr != nil {
+		data = []byte(`{"code":13,"message":"failed to encode error"}`)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_, _ = w.Write(data)
+}
+
+// HTTPStatus maps a gRPC status code to the corresponding HTTP status
+func HTTPStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+// remoteAddr is the address of an HTTP client
+type remoteAddr string
+
+func (a remoteAddr) Network() string { return "tcp" }
+func (a remoteAddr) String() string  { return string(a) }
+
+// errStreamClosed is returned to handlers sending after the client went away
+var errStreamClosed = errors.New("event stream closed")
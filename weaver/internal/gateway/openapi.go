@@ -0,0 +1,232 @@
+package gateway
+
+import (
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// schema is an OpenAPI schema object
+type schema map[string]interface{}
+
+// buildOpenAPI describes the gateway routes as an OpenAPI 3.0 document. Schemas
+// are derived from the protobuf descriptors using protojson's naming.
+func buildOpenAPI(bindings []*binding) ([]byte, error) {
+	schemas := map[string]schema{
+		"Status": {
+			"type": "object",
+			"properties": map[string]interface{}{
+				"code":    schema{"type": "integer", "format": "int32"},
+				"message": schema{"type": "string"},
+				"details": schema{"type": "array", "items": schema{"type": "object"}},
+			},
+		},
+	}
+
+	paths := make(map[string]map[string]interface{})
+	for _, b := range bindings {
+		operation := map[string]interface{}{
+			"operationId": b.desc.Name(),
+			"tags":        []string{tag(b.path)},
+			"responses":   responses(b, schemas),
+		}
+
+		var parameters []interface{}
+		for _, segment := range b.segments {
+			if name, ok := variable(segment); ok {
+				fd := b.input.Descriptor().Fields().ByName(protoreflect.Name(name))
+				parameters = append(parameters, schema{
+					"name":     name,
+					"in":       "path",
+					"required": true,
+					"schema":   fieldSchema(fd, schemas),
+				})
+			}
+		}
+
+		switch {
+		case b.body && b.stream != nil && b.stream.ClientStreams:
+			operation["requestBody"] = schema{
+				"required": true,
+				"content": schema{"application/json": schema{"schema": schema{
+					"type":  "array",
+					"items": messageSchema(b.input.Descriptor(), schemas),
+				}}},
+			}
+		case b.body:
+			operation["requestBody"] = schema{
+				"required": true,
+				"content":  schema{"application/json": schema{"schema": messageSchema(b.input.Descriptor(), schemas)}},
+			}
+		default:
+			parameters = append(parameters, queryParameters(b, schemas)...)
+		}
+		if len(parameters) > 0 {
+			operation["parameters"] = parameters
+		}
+
+		if paths[b.path] == nil {
+			paths[b.path] = make(map[string]interface{})
+		}
+		paths[b.path][strings.ToLower(b.method)] = operation
+	}
+
+	return json.MarshalIndent(map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": schema{
+			"title":   "Weaver API",
+			"version": "v1",
+		},
+		"paths":      paths,
+		"components": schema{"schemas": schemas},
+	}, "", "  ")
+}
+
+// tag groups operations by the resource their path starts with
+func tag(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 {
+		return "weaver"
+	}
+	resource, _, _ := strings.Cut(segments[1], ":")
+	return resource
+}
+
+func responses(b *binding, schemas map[string]schema) schema {
+	output := messageSchema(b.desc.Output(), schemas)
+
+	content := schema{"application/json": schema{"schema": output}}
+	description := "OK"
+	if b.stream != nil {
+		// Every event carries one message; errors arrive as "error" events
+		content = schema{"text/event-stream": schema{"schema": output}}
+		description = "Stream of server-sent events"
+	}
+
+	return schema{
+		"200": schema{"description": description, "content": content},
+		"default": schema{
+			"description": "Error",
+			"content":     schema{"application/json": schema{"schema": schema{"$ref": "#/components/schemas/Status"}}},
+		},
+	}
+}
+
+// queryParameters lists the top-level request fields that are not bound to
+// the path. Nested fields are accepted as dotted parameters as well.
+func queryParameters(b *binding, schemas map[string]schema) []interface{} {
+	bound := make(map[string]bool)
+	for _, segment := range b.segments {
+		if name, ok := variable(segment); ok {
+			bound[name] = true
+		}
+	}
+
+	var parameters []interface{}
+	fields := b.input.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if bound[string(fd.Name())] || (fd.Kind() == protoreflect.MessageKind && !fd.IsMap()) {
+			continue
+		}
+
+		parameter := schema{
+			"name":   fd.JSONName(),
+			"in":     "query",
+			"schema": fieldSchema(fd, schemas),
+		}
+		if fd.IsMap() {
+			// Map entries are passed as name[key]=value
+			parameter["style"] = "deepObject"
+			parameter["explode"] = true
+		}
+		parameters = append(parameters, parameter)
+	}
+	return parameters
+}
+
+// messageSchema returns a reference to the schema of a message, adding it and
+// the messages it refers to on first use
+func messageSchema(md protoreflect.MessageDescriptor, schemas map[string]schema) schema {
+	if s, ok := wellKnownSchema(md.FullName()); ok {
+		return s
+	}
+
+	name := schemaName(md.FullName())
+	ref := schema{"$ref": "#/components/schemas/" + name}
+	if _, ok := schemas[name]; ok {
+		return ref
+	}
+
+	properties := make(map[string]interface{})
+	s := schema{"type": "object", "properties": properties}
+	schemas[name] = s
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		properties[fd.JSONName()] = fieldSchema(fd, schemas)
+	}
+
+	return ref
+}
+
+func fieldSchema(fd protoreflect.FieldDescriptor, schemas map[string]schema) schema {
+	if fd.IsMap() {
+		return schema{"type": "object", "additionalProperties": singularSchema(fd.MapValue(), schemas)}
+	}
+	if fd.IsList() {
+		return schema{"type": "array", "items": singularSchema(fd, schemas)}
+	}
+	return singularSchema(fd, schemas)
+}
+
+// singularSchema maps a field's kind to its protojson representation
+func singularSchema(fd protoreflect.FieldDescriptor, schemas map[string]schema) schema {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return schema{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return schema{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return schema{"type": "integer", "format": "uint32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return schema{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return schema{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		return schema{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return schema{"type": "number", "format": "double"}
+	case protoreflect.BytesKind:
+		return schema{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]string, values.Len())
+		for i := range names {
+			names[i] = string(values.Get(i).Name())
+		}
+		return schema{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageSchema(fd.Message(), schemas)
+	}
+	return schema{"type": "string"}
+}
+
+func wellKnownSchema(name protoreflect.FullName) (schema, bool) {
+	switch name {
+	case "google.protobuf.Timestamp":
+		return schema{"type": "string", "format": "date-time"}, true
+	case "google.protobuf.Duration":
+		return schema{"type": "string"}, true
+	case "google.protobuf.Empty":
+		return schema{"type": "object"}, true
+	}
+	return nil, false
+}
+
+// schemaName drops the proto package from a message name
+func schemaName(name protoreflect.FullName) string {
+	return strings.TrimPrefix(string(name), "weaver.")
+}
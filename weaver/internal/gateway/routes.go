@@ -0,0 +1,71 @@
+package gateway
+
+import "net/http"
+
+// route maps an RPC of the Weaver service to an HTTP method and path. Path
+// variables name request fields; a trailing ":verb" marks a custom method.
+type route struct {
+	rpc    string
+	method string
+	path   string
+	body   bool // the request message is read from the body
+}
+
+var routes = []route{
+	// Workload management
+	{"CreateWorkload", http.MethodPost, "/v1/workloads", true},
+	{"ListWorkloads", http.MethodGet, "/v1/workloads", false},
+	{"GetWorkload", http.MethodGet, "/v1/workloads/{id}", false},
+	{"UpdateWorkload", http.MethodPut, "/v1/workloads/{id}", true},
+	{"DeleteWorkload", http.MethodDelete, "/v1/workloads/{id}", false},
+	{"WatchWorkloads", http.MethodGet, "/v1/workloads:watch", false},
+	{"StreamWorkloadLogs", http.MethodGet, "/v1/workloads/{id}/logs", false},
+	{"ExecWorkload", http.MethodPost, "/v1/workloads:exec", true},
+	{"PortForward", http.MethodPost, "/v1/workloads:portForward", true},
+
+	// Namespace management
+	{"CreateNamespace", http.MethodPost, "/v1/namespaces", true},
+	{"ListNamespaces", http.MethodGet, "/v1/namespaces", false},
+	{"GetNamespace", http.MethodGet, "/v1/namespaces/{name}", false},
+	{"UpdateNamespace", http.MethodPut, "/v1/namespaces/{name}", true},
+	{"DeleteNamespace", http.MethodDelete, "/v1/namespaces/{name}", false},
+
+	// Secret management
+	{"CreateSecret", http.MethodPost, "/v1/namespaces/{namespace}/secrets", true},
+	{"ListSecrets", http.MethodGet, "/v1/namespaces/{namespace}/secrets", false},
+	{"GetSecret", http.MethodGet, "/v1/namespaces/{namespace}/secrets/{name}", false},
+	{"UpdateSecret", http.MethodPut, "/v1/namespaces/{namespace}/secrets/{name}", true},
+	{"DeleteSecret", http.MethodDelete, "/v1/namespaces/{namespace}/secrets/{name}", false},
+	{"SyncSecret", http.MethodPost, "/v1/namespaces/{namespace}/secrets/{name}:sync", true},
+
+	// Cron workload management
+	{"CreateCronWorkload", http.MethodPost, "/v1/namespaces/{namespace}/cronWorkloads", true},
+	{"ListCronWorkloads", http.MethodGet, "/v1/namespaces/{namespace}/cronWorkloads", false},
+	{"GetCronWorkload", http.MethodGet, "/v1/namespaces/{namespace}/cronWorkloads/{name}", false},
+	{"UpdateCronWorkload", http.MethodPut, "/v1/namespaces/{namespace}/cronWorkloads/{name}", true},
+	{"DeleteCronWorkload", http.MethodDelete, "/v1/namespaces/{namespace}/cronWorkloads/{name}", false},
+
+	// Provider management
+	{"ListProviders", http.MethodGet, "/v1/providers", false},
+	{"GetProviderRegions", http.MethodGet, "/v1/providers/{provider}/regions", false},
+	{"GetProviderMachineTypes", http.MethodGet, "/v1/providers/{provider}/machineTypes", false},
+
+	// Scheduler
+	{"GetSchedulerStatus", http.MethodGet, "/v1/scheduler/status", false},
+	{"ScheduleWorkload", http.MethodPost, "/v1/scheduler:schedule", true},
+	{"GetRecommendations", http.MethodPost, "/v1/scheduler:recommend", true},
+	{"ExplainScheduling", http.MethodPost, "/v1/scheduler:explain", true},
+	{"ListSchedulingQueue", http.MethodGet, "/v1/scheduler/queue", false},
+	{"GetSchedulerStats", http.MethodGet, "/v1/scheduler/stats", false},
+
+	// Access control
+	{"CreateToken", http.MethodPost, "/v1/tokens", true},
+	{"ListTokens", http.MethodGet, "/v1/tokens", false},
+	{"RevokeToken", http.MethodDelete, "/v1/tokens/{id}", false},
+	{"CreateRoleBinding", http.MethodPost, "/v1/roleBindings", true},
+	{"ListRoleBindings", http.MethodGet, "/v1/roleBindings", false},
+	{"DeleteRoleBinding", http.MethodDelete, "/v1/roleBindings/{id}", false},
+
+	// Health check
+	{"HealthCheck", http.MethodGet, "/v1/health", false},
+}
@@ -0,0 +1,149 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// eventStream adapts an HTTP response to a gRPC server stream. Requests are
+// decoded up front; responses are sent as server-sent events, one JSON
+// message per event.
+type eventStream struct {
+	ctx      context.Context
+	w        http.ResponseWriter
+	requests []proto.Message
+
+	mu      sync.Mutex
+	started bool
+	header  metadata.MD
+}
+
+func newEventStream(ctx context.Context, w http.ResponseWriter, requests []proto.Message) *eventStream {
+	return &eventStream{ctx: ctx, w: w, requests: requests}
+}
+
+func (s *eventStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *eventStream) SetHeader(md metadata.MD) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return fmt.Errorf("headers already sent")
+	}
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *eventStream) SendHeader(md metadata.MD) error {
+	if err := s.SetHeader(md); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.start()
+	return nil
+}
+
+// SetTrailer is a no-op; server-sent events have no trailers
+func (s *eventStream) SetTrailer(metadata.MD) {}
+
+// SendMsg writes a response message as a data event
+func (s *eventStream) SendMsg(m interface{}) error {
+	data, err := marshaler.Marshal(m.(proto.Message))
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.ctx.Err(); err != nil {
+		return errStreamClosed
+	}
+	s.start()
+	return s.write("", data)
+}
+
+// RecvMsg hands out the decoded requests, then reports the end of the stream
+func (s *eventStream) RecvMsg(m interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.requests) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(proto.Message), s.requests[0])
+	s.requests = s.requests[1:]
+	return nil
+}
+
+// fail reports an error returned by the handler. Before the first event it
+// becomes a regular error response, afterwards an error event.
+func (s *eventStream) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.started {
+		writeError(s.w, err, 0)
+		return
+	}
+	if s.ctx.Err() != nil {
+		return
+	}
+
+	data, marshalErr := marshaler.Marshal(status.Convert(err).Proto())
+	if marshalErr != nil {
+		return
+	}
+	_ = s.write("error", data)
+}
+
+// start sends the response headers of the event stream once
+func (s *eventStream) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+
+	header := s.w.Header()
+	for key, values := range s.header {
+		for _, value := range values {
+			header.Add("Grpc-Metadata-"+key, value)
+		}
+	}
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("X-Accel-Buffering", "no")
+	s.w.WriteHeader(http.StatusOK)
+	s.flush()
+}
+
+func (s *eventStream) write(event string, data []byte) error {
+	if event != "" {
+		if _, err := fmt.Fprintf(s.w, "event: %s\n", event); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(s.w, "data: %s\n\n", data); err != nil {
+		return err
+	}
+	s.flush()
+	return nil
+}
+
+func (s *eventStream) flush() {
+	if flusher, ok := s.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
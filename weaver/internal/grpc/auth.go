@@ -0,0 +1,363 @@
+package grpc
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/codecflow/fabric/pkg/proto/weaver"
+	"github.com/codecflow/fabric/weaver/internal/auth"
+	"github.com/codecflow/fabric/weaver/internal/grpc/handlers"
+	"github.com/codecflow/fabric/weaver/internal/repository"
+)
+
+// access is what a request needs: a role in the namespace it acts in. An
+// empty namespace needs a cluster-wide binding and an empty role only needs
+// an authenticated caller. Requests acting as a node need that node's principal.
+type access struct {
+	role      auth.Role
+	namespace string
+	node      string
+}
+
+// accessRule derives the access a request needs
+type accessRule func(ctx context.Context, s *Server, req interface{}) (access, error)
+
+// publicMethods are served without authentication
+var publicMethods = map[string]bool{
+	weaver.WeaverService_HealthCheck_FullMethodName: true,
+}
+
+// accessRules lists the access of every RPC; RPCs without a rule are denied
+var accessRules = map[string]accessRule{
+	// Workload management
+	weaver.WeaverService_CreateWorkload_FullMethodName:     namespaced(auth.RoleEditor, (*weaver.CreateWorkloadRequest).GetNamespace),
+	weaver.WeaverService_GetWorkload_FullMethodName:        ofWorkload(auth.RoleViewer, (*weaver.GetWorkloadRequest).GetId),
+	weaver.WeaverService_ListWorkloads_FullMethodName:      namespaced(auth.RoleViewer, (*weaver.ListWorkloadsRequest).GetNamespace),
+	weaver.WeaverService_UpdateWorkload_FullMethodName:     ofWorkload(auth.RoleEditor, (*weaver.UpdateWorkloadRequest).GetId),
+	weaver.WeaverService_DeleteWorkload_FullMethodName:     deleteWorkloadAccess,
+	weaver.WeaverService_WatchWorkloads_FullMethodName:     namespaced(auth.RoleViewer, (*weaver.WatchWorkloadsRequest).GetNamespace),
+	weaver.WeaverService_StreamWorkloadLogs_FullMethodName: ofWorkload(auth.RoleViewer, (*weaver.StreamWorkloadLogsRequest).GetId),
+	weaver.WeaverService_ExecWorkload_FullMethodName: ofWorkload(auth.RoleEditor, func(req *weaver.ExecRequest) string {
+		return req.GetStart().GetId()
+	}),
+	weaver.WeaverService_PortForward_FullMethodName: ofWorkload(auth.RoleEditor, func(req *weaver.PortForwardRequest) string {
+		return req.GetStart().GetId()
+	}),
+
+	// Namespaces are created and sized by cluster admins
+	weaver.WeaverService_CreateNamespace_FullMethodName: cluster(auth.RoleAdmin),
+	weaver.WeaverService_GetNamespace_FullMethodName:    namespaced(auth.RoleViewer, (*weaver.GetNamespaceRequest).GetName),
+	weaver.WeaverService_ListNamespaces_FullMethodName:  authenticated,
+	weaver.WeaverService_UpdateNamespace_FullMethodName: cluster(auth.RoleAdmin),
+	weaver.WeaverService_DeleteNamespace_FullMethodName: cluster(auth.RoleAdmin),
+
+	// Secret management; revealing values needs the editor role
+	weaver.WeaverService_CreateSecret_FullMethodName: namespaced(auth.RoleEditor, (*weaver.CreateSecretRequest).GetNamespace),
+	weaver.WeaverService_GetSecret_FullMethodName: func(ctx context.Context, s *Server, req interface{}) (access, error) {
+		r := req.(*weaver.GetSecretRequest)
+		return access{role: revealRole(r.Reveal), namespace: defaultNamespace(r.Namespace)}, nil
+	},
+	weaver.WeaverService_ListSecrets_FullMethodName: func(ctx context.Context, s *Server, req interface{}) (access, error) {
+		r := req.(*weaver.ListSecretsRequest)
+		return access{role: revealRole(r.Reveal), namespace: defaultNamespace(r.Namespace)}, nil
+	},
+	weaver.WeaverService_UpdateSecret_FullMethodName: namespaced(auth.RoleEditor, (*weaver.UpdateSecretRequest).GetNamespace),
+	weaver.WeaverService_DeleteSecret_FullMethodName: namespaced(auth.RoleEditor, (*weaver.DeleteSecretRequest).GetNamespace),
+	weaver.WeaverService_SyncSecret_FullMethodName:   namespaced(auth.RoleEditor, (*weaver.SyncSecretRequest).GetNamespace),
+
+	// Cron workload management
+	weaver.WeaverService_CreateCronWorkload_FullMethodName: namespaced(auth.RoleEditor, (*weaver.CreateCronWorkloadRequest).GetNamespace),
+	weaver.WeaverService_GetCronWorkload_FullMethodName:    namespaced(auth.RoleViewer, (*weaver.GetCronWorkloadRequest).GetNamespace),
+	weaver.WeaverService_ListCronWorkloads_FullMethodName:  namespaced(auth.RoleViewer, (*weaver.ListCronWorkloadsRequest).GetNamespace),
+	weaver.WeaverService_UpdateCronWorkload_FullMethodName: namespaced(auth.RoleEditor, (*weaver.UpdateCronWorkloadRequest).GetNamespace),
+	weaver.WeaverService_DeleteCronWorkload_FullMethodName: namespaced(auth.RoleEditor, (*weaver.DeleteCronWorkloadRequest).GetNamespace),
+
+	// Providers and the scheduler describe the cluster, not a namespace
+	weaver.WeaverService_ListProviders_FullMethodName:           authenticated,
+	weaver.WeaverService_GetProviderRegions_FullMethodName:      authenticated,
+	weaver.WeaverService_GetProviderMachineTypes_FullMethodName: authenticated,
+	weaver.WeaverService_GetSchedulerStatus_FullMethodName:      authenticated,
+	weaver.WeaverService_ScheduleWorkload_FullMethodName:        authenticated,
+	weaver.WeaverService_GetRecommendations_FullMethodName:      authenticated,
+	weaver.WeaverService_ExplainScheduling_FullMethodName: func(ctx context.Context, s *Server, req interface{}) (access, error) {
+		r := req.(*weaver.ExplainSchedulingRequest)
+		if r.WorkloadId == "" {
+			return access{}, nil
+		}
+		return s.workloadAccess(ctx, auth.RoleViewer, r.WorkloadId)
+	},
+	weaver.WeaverService_ListSchedulingQueue_FullMethodName: func(ctx context.Context, s *Server, req interface{}) (access, error) {
+		// Without a namespace the queue of every namespace is listed
+		return access{role: auth.RoleViewer, namespace: req.(*weaver.ListSchedulingQueueRequest).Namespace}, nil
+	},
+	weaver.WeaverService_GetSchedulerStats_FullMethodName: authenticated,
+
+	// Callers manage their own tokens; the handler checks the rest
+	weaver.WeaverService_CreateToken_FullMethodName: authenticated,
+	weaver.WeaverService_ListTokens_FullMethodName:  authenticated,
+	weaver.WeaverService_RevokeToken_FullMethodName: authenticated,
+	weaver.WeaverService_CreateRoleBinding_FullMethodName: func(ctx context.Context, s *Server, req interface{}) (access, error) {
+		return access{role: auth.RoleAdmin, namespace: req.(*weaver.CreateRoleBindingRequest).Namespace}, nil
+	},
+	weaver.WeaverService_ListRoleBindings_FullMethodName: func(ctx context.Context, s *Server, req interface{}) (access, error) {
+		return access{role: auth.RoleAdmin, namespace: req.(*weaver.ListRoleBindingsRequest).Namespace}, nil
+	},
+	weaver.WeaverService_DeleteRoleBinding_FullMethodName: func(ctx context.Context, s *Server, req interface{}) (access, error) {
+		return s.roleBindingAccess(ctx, req.(*weaver.DeleteRoleBindingRequest).Id)
+	},
+
+	// Nodes only act as themselves, so they only see their own assignments and secrets
+	weaver.NodeService_RegisterNode_FullMethodName:         asNode((*weaver.RegisterNodeRequest).GetNodeId),
+	weaver.NodeService_UnregisterNode_FullMethodName:       asNode((*weaver.UnregisterNodeRequest).GetNodeId),
+	weaver.NodeService_Heartbeat_FullMethodName:            asNode((*weaver.HeartbeatRequest).GetNodeId),
+	weaver.NodeService_WatchAssignments_FullMethodName:     asNode((*weaver.WatchAssignmentsRequest).GetNodeId),
+	weaver.NodeService_ReportWorkloadStatus_FullMethodName: asNode((*weaver.ReportWorkloadStatusRequest).GetNodeId),
+}
+
+// authenticated only needs an authenticated caller
+func authenticated(context.Context, *Server, interface{}) (access, error) {
+	return access{}, nil
+}
+
+// cluster needs a cluster-wide role
+func cluster(role auth.Role) accessRule {
+	return func(context.Context, *Server, interface{}) (access, error) {
+		return access{role: role}, nil
+	}
+}
+
+// namespaced needs a role in the namespace a request names
+func namespaced[T any](role auth.Role, namespace func(T) string) accessRule {
+	return func(_ context.Context, _ *Server, req interface{}) (access, error) {
+		return access{role: role, namespace: defaultNamespace(namespace(req.(T)))}, nil
+	}
+}
+
+// asNode needs the principal of the node a request names
+func asNode[T any](nodeID func(T) string) accessRule {
+	return func(_ context.Context, _ *Server, req interface{}) (access, error) {
+		id := nodeID(req.(T))
+		if id == "" {
+			return access{}, status.Error(codes.InvalidArgument, "node id is required")
+		}
+		return access{node: id}, nil
+	}
+}
+
+// ofWorkload needs a role in the namespace of the workload a request names
+func ofWorkload[T any](role auth.Role, id func(T) string) accessRule {
+	return func(ctx context.Context, s *Server, req interface{}) (access, error) {
+		return s.workloadAccess(ctx, role, id(req.(T)))
+	}
+}
+
+func (s *Server) workloadAccess(ctx context.Context, role auth.Role, id string) (access, error) {
+	if id == "" {
+		return access{}, status.Error(codes.InvalidArgument, "workload id is required")
+	}
+	if s.appState.Repository == nil || s.appState.Repository.Workload == nil {
+		return access{}, status.Error(codes.Unavailable, "workload repository not available")
+	}
+
+	w, err := s.appState.Repository.Workload.Get(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return access{}, status.Errorf(codes.NotFound, "workload %s not found", id)
+	} else if err != nil {
+		return access{}, status.Errorf(codes.Internal, "failed to get workload: %v", err)
+	}
+
+	return access{role: role, namespace: w.Namespace}, nil
+}
+
+// deleteWorkloadAccess needs the admin role to force delete a workload, since
+// that skips the teardown of its provider resources
+func deleteWorkloadAccess(ctx context.Context, s *Server, req interface{}) (access, error) {
+	r := req.(*weaver.DeleteWorkloadRequest)
+	role := auth.RoleEditor
+	if r.Force {
+		role = auth.RoleAdmin
+	}
+	return s.workloadAccess(ctx, role, r.Id)
+}
+
+func (s *Server) roleBindingAccess(ctx context.Context, id string) (access, error) {
+	if s.appState.Repository == nil || s.appState.Repository.Auth == nil {
+		return access{}, status.Error(codes.Unavailable, "role binding repository not available")
+	}
+
+	binding, err := s.appState.Repository.Auth.GetRoleBinding(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return access{}, status.Errorf(codes.NotFound, "role binding %s not found", id)
+	} else if err != nil {
+		return access{}, status.Errorf(codes.Internal, "failed to get role binding: %v", err)
+	}
+
+	return access{role: auth.RoleAdmin, namespace: binding.Namespace}, nil
+}
+
+func defaultNamespace(namespace string) string {
+	if namespace == "" {
+		return handlers.DefaultNamespace
+	}
+	return namespace
+}
+
+func revealRole(reveal bool) auth.Role {
+	if reveal {
+		return auth.RoleEditor
+	}
+	return auth.RoleViewer
+}
+
+// authorizeUnary authenticates the caller of a unary RPC and checks its role
+func (s *Server) authorizeUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if s.appState.Auth == nil || publicMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	principal, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = auth.NewContext(ctx, principal)
+
+	if err := s.authorize(ctx, principal, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+
+	resp, err := handler(ctx, req)
+	return resp, authStatus(err)
+}
+
+// authorizeStream authenticates the caller of a streaming RPC. Its role is
+// checked against the first request, before the handler sees it.
+func (s *Server) authorizeStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if s.appState.Auth == nil || publicMethods[info.FullMethod] {
+		return handler(srv, ss)
+	}
+
+	principal, err := s.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	stream := &authorizedStream{
+		ServerStream: ss,
+		ctx:          auth.NewContext(ss.Context(), principal),
+	}
+	stream.authorize = func(req interface{}) error {
+		return s.authorize(stream.ctx, principal, info.FullMethod, req)
+	}
+
+	err = handler(srv, stream)
+	// Handlers may wrap the error of a denied request
+	if stream.denied != nil {
+		return stream.denied
+	}
+	return authStatus(err)
+}
+
+// authenticate identifies the caller by its bearer token or client certificate
+func (s *Server) authenticate(ctx context.Context) (*auth.Principal, error) {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get("authorization") {
+			if scheme, credential, ok := strings.Cut(value, " "); ok && strings.EqualFold(scheme, "Bearer") {
+				token = strings.TrimSpace(credential)
+			}
+		}
+	}
+
+	var certs []*x509.Certificate
+	if p, ok := peer.FromContext(ctx); ok {
+		// Only certificates verified against the client CA identify a caller
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(info.State.VerifiedChains) > 0 {
+			certs = info.State.PeerCertificates
+		}
+	}
+
+	principal, err := s.appState.Auth.Authenticate(ctx, token, certs)
+	if errors.Is(err, auth.ErrUnauthenticated) || errors.Is(err, repository.ErrNotFound) {
+		return nil, status.Error(codes.Unauthenticated, "invalid or missing credentials")
+	} else if err != nil {
+		s.logger.Errorf("Failed to authenticate request: %v", err)
+		return nil, status.Error(codes.Internal, "failed to authenticate request")
+	}
+
+	return principal, nil
+}
+
+// authorize checks the caller's role or node identity against the access rule
+// of the method
+func (s *Server) authorize(ctx context.Context, principal *auth.Principal, method string, req interface{}) error {
+	rule, ok := accessRules[method]
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "%s is not available over the API", method)
+	}
+
+	required, err := rule(ctx, s, req)
+	if err != nil {
+		return err
+	}
+	if required.node != "" && principal.Name != auth.NodePrincipal(required.node) {
+		return status.Errorf(codes.PermissionDenied, "%s cannot act as node %s", principal.Name, required.node)
+	}
+	if required.role == "" {
+		return nil
+	}
+
+	err = s.appState.Auth.Authorize(ctx, principal, required.namespace, required.role)
+	if err != nil && !errors.Is(err, auth.ErrPermissionDenied) {
+		s.logger.Errorf("Failed to authorize request: %v", err)
+		return status.Error(codes.Internal, "failed to authorize request")
+	}
+	return authStatus(err)
+}
+
+// authStatus converts authorization errors into gRPC statuses and passes
+// other errors through
+func authStatus(err error) error {
+	switch {
+	case errors.Is(err, auth.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	return err
+}
+
+// authorizedStream authorizes the first request received on a stream
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	authorize func(req interface{}) error
+	checked   bool
+	denied    error
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if s.denied != nil {
+		return s.denied
+	}
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if !s.checked {
+		s.checked = true
+		s.denied = s.authorize(m)
+	}
+	return s.denied
+}
@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/codecflow/fabric/pkg/proto/weaver"
+	"github.com/codecflow/fabric/weaver/internal/auth"
+	"github.com/codecflow/fabric/weaver/internal/repository"
+	"github.com/codecflow/fabric/weaver/internal/state"
+)
+
+// AuthHandler manages API tokens and role bindings. The gRPC server checks
+// the caller's role before a request reaches it; checks that depend on the
+// token a request names are made here.
+type AuthHandler struct {
+	appState *state.State
+	logger   *logrus.Logger
+}
+
+func NewAuthHandler(appState *state.State, logger *logrus.Logger) *AuthHandler {
+	return &AuthHandler{
+		appState: appState,
+		logger:   logger,
+	}
+}
+
+func (h *AuthHandler) CreateToken(ctx context.Context, req *weaver.CreateTokenRequest) (*weaver.CreateTokenResponse, error) {
+	if !h.repositoryAvailable() {
+		return nil, fmt.Errorf("token repository not available")
+	}
+
+	if req.Name == "" {
+		return nil, fmt.Errorf("token name is required")
+	}
+	if req.TtlSeconds < 0 {
+		return nil, fmt.Errorf("invalid ttl %d: must not be negative", req.TtlSeconds)
+	}
+
+	principal, err := h.principal(ctx, req.Principal)
+	if err != nil {
+		return nil, err
+	}
+	if principal == "" {
+		return nil, fmt.Errorf("principal is required")
+	}
+
+	token, secret, err := auth.NewToken(req.Name, principal, time.Duration(req.TtlSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.appState.Repository.Auth.CreateToken(ctx, token); err != nil {
+		return nil, fmt.Errorf("failed to store token: %v", err)
+	}
+
+	h.logger.Infof("Token %s (%s) created for %s", token.ID, token.Name, token.Principal)
+
+	return &weaver.CreateTokenResponse{Token: convertTokenToProto(token), Secret: secret}, nil
+}
+
+func (h *AuthHandler) ListTokens(ctx context.Context, req *weaver.ListTokensRequest) (*weaver.ListTokensResponse, error) {
+	if !h.repositoryAvailable() {
+		return nil, fmt.Errorf("token repository not available")
+	}
+
+	principal, err := h.principal(ctx, req.Principal)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := h.appState.Repository.Auth.ListTokens(ctx, principal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tokens: %v", err)
+	}
+
+	response := &weaver.ListTokensResponse{}
+	for _, token := range tokens {
+		response.Tokens = append(response.Tokens, convertTokenToProto(token))
+	}
+
+	return response, nil
+}
+
+func (h *AuthHandler) RevokeToken(ctx context.Context, req *weaver.RevokeTokenRequest) (*emptypb.Empty, error) {
+	if !h.repositoryAvailable() {
+		return nil, fmt.Errorf("token repository not available")
+	}
+
+	token, err := h.appState.Repository.Auth.GetToken(ctx, req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token: %v", err)
+	}
+
+	if _, err := h.principal(ctx, token.Principal); err != nil {
+		return nil, err
+	}
+
+	if err := h.appState.Repository.Auth.RevokeToken(ctx, token.ID, time.Now()); err != nil {
+		return nil, fmt.Errorf("failed to revoke token: %v", err)
+	}
+
+	h.logger.Infof("Token %s (%s) of %s revoked", token.ID, token.Name, token.Principal)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AuthHandler) CreateRoleBinding(ctx context.Context, req *weaver.CreateRoleBindingRequest) (*weaver.CreateRoleBindingResponse, error) {
+	if !h.repositoryAvailable() {
+		return nil, fmt.Errorf("role binding repository not available")
+	}
+
+	role := auth.Role(req.Role)
+	if !role.Valid() {
+		return nil, fmt.Errorf("invalid role %q: must be viewer, editor or admin", req.Role)
+	}
+	if req.Principal == "" {
+		return nil, fmt.Errorf("principal is required")
+	}
+	if req.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required, %q binds every namespace", auth.AllNamespaces)
+	}
+
+	if req.Namespace != auth.AllNamespaces && h.appState.Repository.Namespace != nil {
+		if _, err := h.appState.Repository.Namespace.Get(ctx, req.Namespace); errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("namespace %s not found", req.Namespace)
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get namespace: %v", err)
+		}
+	}
+
+	existing, err := h.appState.Repository.Auth.ListRoleBindings(ctx, auth.BindingFilter{Principal: req.Principal, Namespace: req.Namespace})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role bindings: %v", err)
+	}
+	for _, binding := range existing {
+		if binding.Role == role {
+			return nil, fmt.Errorf("%s already has the %s role in %s", req.Principal, role, req.Namespace)
+		}
+	}
+
+	binding := &auth.RoleBinding{
+		ID:        generateID(),
+		Principal: req.Principal,
+		Namespace: req.Namespace,
+		Role:      role,
+		CreatedAt: time.Now(),
+	}
+
+	if err := h.appState.Repository.Auth.CreateRoleBinding(ctx, binding); err != nil {
+		return nil, fmt.Errorf("failed to store role binding: %v", err)
+	}
+
+	h.logger.Infof("Granted %s the %s role in %s", binding.Principal, binding.Role, binding.Namespace)
+
+	return &weaver.CreateRoleBindingResponse{RoleBinding: convertRoleBindingToProto(binding)}, nil
+}
+
+func (h *AuthHandler) ListRoleBindings(ctx context.Context, req *weaver.ListRoleBindingsRequest) (*weaver.ListRoleBindingsResponse, error) {
+	if !h.repositoryAvailable() {
+		return nil, fmt.Errorf("role binding repository not available")
+	}
+
+	bindings, err := h.appState.Repository.Auth.ListRoleBindings(ctx, auth.BindingFilter{
+		Principal: req.Principal,
+		Namespace: req.Namespace,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role bindings: %v", err)
+	}
+
+	response := &weaver.ListRoleBindingsResponse{}
+	for _, binding := range bindings {
+		response.RoleBindings = append(response.RoleBindings, convertRoleBindingToProto(binding))
+	}
+
+	return response, nil
+}
+
+func (h *AuthHandler) DeleteRoleBinding(ctx context.Context, req *weaver.DeleteRoleBindingRequest) (*emptypb.Empty, error) {
+	if !h.repositoryAvailable() {
+		return nil, fmt.Errorf("role binding repository not available")
+	}
+
+	binding, err := h.appState.Repository.Auth.GetRoleBinding(ctx, req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role binding: %v", err)
+	}
+
+	if err := h.appState.Repository.Auth.DeleteRoleBinding(ctx, binding.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete role binding: %v", err)
+	}
+
+	h.logger.Infof("Revoked the %s role of %s in %s", binding.Role, binding.Principal, binding.Namespace)
+
+	return &emptypb.Empty{}, nil
+}
+
+// principal resolves the principal a token request acts on, defaulting to the
+// caller. Acting on another principal's tokens needs the cluster admin role.
+func (h *AuthHandler) principal(ctx context.Context, requested string) (string, error) {
+	caller, ok := auth.FromContext(ctx)
+	if h.appState.Auth == nil || !ok {
+		return requested, nil
+	}
+
+	if requested == "" || requested == caller.Name {
+		return caller.Name, nil
+	}
+
+	if !h.appState.Auth.IsClusterAdmin(ctx, caller) {
+		return "", fmt.Errorf("%w: only cluster admins manage the tokens of %s", auth.ErrPermissionDenied, requested)
+	}
+
+	return requested, nil
+}
+
+func (h *AuthHandler) repositoryAvailable() bool {
+	return h.appState.Repository != nil && h.appState.Repository.Auth != nil
+}
@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/codecflow/fabric/pkg/proto/weaver"
+	"github.com/codecflow/fabric/weaver/internal/cron"
+	"github.com/codecflow/fabric/weaver/internal/finalizer"
+	"github.com/codecflow/fabric/weaver/internal/namespace"
+	"github.com/codecflow/fabric/weaver/internal/repository"
+	"github.com/codecflow/fabric/weaver/internal/state"
+)
+
+type CronWorkloadHandler struct {
+	appState *state.State
+	logger   *logrus.Logger
+
+	finalizers *finalizer.Runner
+}
+
+func NewCronWorkloadHandler(appState *state.State, logger *logrus.Logger) *CronWorkloadHandler {
+	return &CronWorkloadHandler{
+		appState:   appState,
+		logger:     logger,
+		finalizers: finalizer.New(appState, logger),
+	}
+}
+
+func (h *CronWorkloadHandler) Create(ctx context.Context, req *weaver.CreateCronWorkloadRequest) (*weaver.CreateCronWorkloadResponse, error) {
+	if !h.repositoryAvailable() {
+		return nil, fmt.Errorf("cron workload repository not available")
+	}
+
+	// Run names add a suffix to the name and must stay DNS labels
+	if !dnsLabel.MatchString(req.Name) || len(req.Name) > cron.MaxNameLength {
+		return nil, fmt.Errorf("invalid cron workload name %q: must be a lowercase DNS label of at most %d characters", req.Name, cron.MaxNameLength)
+	}
+
+	spec := convertCronWorkloadSpec(req.Spec)
+	spec.Default()
+	if err := spec.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid cron workload spec: %v", err)
+	}
+
+	namespaceName := cronNamespace(req.Namespace)
+	if h.appState.Repository.Namespace != nil {
+		ns, err := h.appState.Repository.Namespace.Get(ctx, namespaceName)
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("namespace %s not found", namespaceName)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get namespace: %v", err)
+		}
+		if ns.Status.Phase == namespace.PhaseTerminating {
+			return nil, fmt.Errorf("namespace %s is terminating", ns.Name)
+		}
+	}
+
+	if _, err := h.appState.Repository.Cron.Get(ctx, namespaceName, req.Name); err == nil {
+		return nil, fmt.Errorf("cron workload %s/%s already exists", namespaceName, req.Name)
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get cron workload: %v", err)
+	}
+
+	now := time.Now()
+	cw := &cron.CronWorkload{
+		ID:          generateID(),
+		Name:        req.Name,
+		Namespace:   namespaceName,
+		Labels:      req.Labels,
+		Annotations: req.Annotations,
+		Spec:        spec,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	cw.UpdateNextScheduleTime(now)
+
+	if err := h.appState.Repository.Cron.Create(ctx, cw); err != nil {
+		return nil, fmt.Errorf("failed to store cron workload: %v", err)
+	}
+
+	h.logger.Infof("Cron workload %s/%s created with schedule %q", cw.Namespace, cw.Name, cw.Spec.Schedule)
+
+	return &weaver.CreateCronWorkloadResponse{CronWorkload: convertCronWorkloadToProto(cw)}, nil
+}
+
+func (h *CronWorkloadHandler) Get(ctx context.Context, req *weaver.GetCronWorkloadRequest) (*weaver.GetCronWorkloadResponse, error) {
+	if !h.repositoryAvailable() {
+		return nil, fmt.Errorf("cron workload repository not available")
+	}
+
+	cw, err := h.appState.Repository.Cron.Get(ctx, cronNamespace(req.Namespace), req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cron workload: %v", err)
+	}
+
+	return &weaver.GetCronWorkloadResponse{CronWorkload: convertCronWorkloadToProto(cw)}, nil
+}
+
+func (h *CronWorkloadHandler) List(ctx context.Context, req *weaver.ListCronWorkloadsRequest) (*weaver.ListCronWorkloadsResponse, error) {
+	if !h.repositoryAvailable() {
+		return nil, fmt.Errorf("cron workload repository not available")
+	}
+
+	cronWorkloads, err := h.appState.Repository.Cron.List(ctx, cronNamespace(req.Namespace), req.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cron workloads: %v", err)
+	}
+
+	response := &weaver.ListCronWorkloadsResponse{}
+	for _, cw := range cronWorkloads {
+		response.CronWorkloads = append(response.CronWorkloads, convertCronWorkloadToProto(cw))
+	}
+
+	return response, nil
+}
+
+func (h *CronWorkloadHandler) Update(ctx context.Context, req *weaver.UpdateCronWorkloadRequest) (*weaver.UpdateCronWorkloadResponse, error) {
+	if !h.repositoryAvailable() {
+		return nil, fmt.Errorf("cron workload repository not available")
+	}
+
+	spec := convertCronWorkloadSpec(req.Spec)
+	spec.Default()
+	if err := spec.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid cron workload spec: %v", err)
+	}
+
+	cw, err := h.appState.Repository.Cron.Get(ctx, cronNamespace(req.Namespace), req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cron workload: %v", err)
+	}
+
+	// Runs already created keep the template they were created from
+	now := time.Now()
+	cw.Spec = spec
+	cw.Labels = req.Labels
+	cw.Annotations = req.Annotations
+	cw.UpdatedAt = now
+
+	if err := h.appState.Repository.Cron.Update(ctx, cw); err != nil {
+		return nil, fmt.Errorf("failed to update cron workload: %v", err)
+	}
+
+	cw.UpdateNextScheduleTime(now)
+	if err := h.appState.Repository.Cron.UpdateStatus(ctx, cw); err != nil {
+		h.logger.Warnf("Failed to update next schedule time of cron workload %s/%s: %v", cw.Namespace, cw.Name, err)
+	}
+
+	h.logger.Infof("Cron workload %s/%s updated", cw.Namespace, cw.Name)
+
+	return &weaver.UpdateCronWorkloadResponse{CronWorkload: convertCronWorkloadToProto(cw)}, nil
+}
+
+// Delete deletes a cron workload and then its runs, so no new run is created
+// while they are torn down
+func (h *CronWorkloadHandler) Delete(ctx context.Context, req *weaver.DeleteCronWorkloadRequest) (*emptypb.Empty, error) {
+	if !h.repositoryAvailable() {
+		return nil, fmt.Errorf("cron workload repository not available")
+	}
+
+	cw, err := h.appState.Repository.Cron.Get(ctx, cronNamespace(req.Namespace), req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cron workload: %v", err)
+	}
+
+	if err := h.appState.Repository.Cron.Delete(ctx, cw.Namespace, cw.Name); err != nil {
+		return nil, fmt.Errorf("failed to delete cron workload: %v", err)
+	}
+
+	h.logger.Infof("Cron workload %s/%s deleted", cw.Namespace, cw.Name)
+
+	if h.appState.Repository.Workload == nil {
+		return &emptypb.Empty{}, nil
+	}
+
+	runs, err := h.appState.Repository.Workload.List(ctx, cw.Runs())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list runs of cron workload: %v", err)
+	}
+
+	for _, w := range runs {
+		if err := h.finalizers.Start(ctx, w); err != nil {
+			return nil, fmt.Errorf("failed to delete run %s: %v", w.Name, err)
+		}
+		if err := h.finalizers.Run(ctx, w); err != nil {
+			h.logger.Warnf("Run %s/%s is terminating, finalization will be retried: %v", w.Namespace, w.Name, err)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *CronWorkloadHandler) repositoryAvailable() bool {
+	return h.appState.Repository != nil && h.appState.Repository.Cron != nil
+}
+
+// cronNamespace defaults an empty namespace to the default namespace
+func cronNamespace(namespace string) string {
+	if namespace == "" {
+		return DefaultNamespace
+	}
+	return namespace
+}
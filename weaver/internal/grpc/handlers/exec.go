@@ -0,0 +1,227 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/codecflow/fabric/pkg/proto/weaver"
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/services/provider"
+)
+
+// Exec runs a command in a workload container, relaying its input and output
+func (h *WorkloadHandler) Exec(srv grpc.BidiStreamingServer[weaver.ExecRequest, weaver.ExecResponse]) error {
+	req, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+	start := req.GetStart()
+	if start == nil {
+		return fmt.Errorf("first exec request must start the command")
+	}
+	if len(start.Command) == 0 {
+		return fmt.Errorf("command is required")
+	}
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	w, p, err := h.provisionedWorkload(ctx, start.Id)
+	if err != nil {
+		return err
+	}
+	executor, ok := p.(provider.ExecProvider)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "provider %s does not support exec", w.Status.Provider)
+	}
+
+	container, err := provider.ResolveContainer(w, start.Container)
+	if err != nil {
+		return err
+	}
+
+	var mu sync.Mutex
+	send := func(resp *weaver.ExecResponse) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return srv.Send(resp)
+	}
+
+	stdinReader, stdinWriter := io.Pipe()
+	// Unblocks the relay if the command exits without reading all input
+	defer func() { _ = stdinReader.Close() }()
+	resize := make(chan provider.TerminalSize, 1)
+
+	go func() {
+		for {
+			req, err := srv.Recv()
+			if err != nil {
+				if err == io.EOF {
+					_ = stdinWriter.Close()
+				} else {
+					_ = stdinWriter.CloseWithError(err)
+				}
+				return
+			}
+
+			switch payload := req.Payload.(type) {
+			case *weaver.ExecRequest_Stdin:
+				if start.Stdin {
+					_, _ = stdinWriter.Write(payload.Stdin)
+				}
+			case *weaver.ExecRequest_CloseStdin:
+				_ = stdinWriter.Close()
+			case *weaver.ExecRequest_Resize:
+				size := provider.TerminalSize{
+					Width:  uint16(payload.Resize.Width),  // nolint:gosec
+					Height: uint16(payload.Resize.Height), // nolint:gosec
+				}
+				// Only the latest size matters when the provider falls behind
+				select {
+				case <-resize:
+				default:
+				}
+				resize <- size
+			}
+		}
+	}()
+
+	streams := provider.ExecStreams{
+		Stdout: execWriter(func(data []byte) error {
+			return send(&weaver.ExecResponse{Payload: &weaver.ExecResponse_Stdout{Stdout: data}})
+		}),
+		Stderr: execWriter(func(data []byte) error {
+			return send(&weaver.ExecResponse{Payload: &weaver.ExecResponse_Stderr{Stderr: data}})
+		}),
+	}
+	if start.Stdin {
+		streams.Stdin = stdinReader
+	}
+	if start.Tty {
+		streams.Resize = resize
+	}
+
+	opts := provider.ExecOptions{
+		Container: container,
+		Command:   start.Command,
+		TTY:       start.Tty,
+		Stdin:     start.Stdin,
+	}
+
+	code, err := executor.Exec(ctx, w, opts, streams)
+	if err != nil {
+		return fmt.Errorf("failed to exec: %v", err)
+	}
+
+	return send(&weaver.ExecResponse{Payload: &weaver.ExecResponse_Exit{Exit: &weaver.ExecExit{ExitCode: int32(code)}}}) // nolint:gosec
+}
+
+// PortForward forwards one connection to a port of a workload
+func (h *WorkloadHandler) PortForward(srv grpc.BidiStreamingServer[weaver.PortForwardRequest, weaver.PortForwardResponse]) error {
+	req, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+	start := req.GetStart()
+	if start == nil {
+		return fmt.Errorf("first port forward request must select the port")
+	}
+	if start.Port == 0 || start.Port > 65535 {
+		return fmt.Errorf("invalid port %d", start.Port)
+	}
+
+	ctx := srv.Context()
+	w, p, err := h.provisionedWorkload(ctx, start.Id)
+	if err != nil {
+		return err
+	}
+	forwarder, ok := p.(provider.PortForwardProvider)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "provider %s does not support port forwarding", w.Status.Provider)
+	}
+
+	conn := &forwardConn{
+		recv: func() ([]byte, error) {
+			req, err := srv.Recv()
+			if err != nil {
+				return nil, err
+			}
+			return req.GetData(), nil
+		},
+		send: func(data []byte) error {
+			return srv.Send(&weaver.PortForwardResponse{Data: data})
+		},
+	}
+
+	if err := forwarder.PortForward(ctx, w, uint16(start.Port), conn); err != nil {
+		return fmt.Errorf("failed to forward port %d: %v", start.Port, err)
+	}
+
+	return nil
+}
+
+// provisionedWorkload returns a workload and the provider running it
+func (h *WorkloadHandler) provisionedWorkload(ctx context.Context, id string) (*workload.Workload, provider.Provider, error) {
+	if h.appState.Repository.Workload == nil {
+		return nil, nil, fmt.Errorf("workload repository not available")
+	}
+
+	w, err := h.appState.Repository.Workload.Get(ctx, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get workload: %v", err)
+	}
+	if w.Status.Provider == "" {
+		return nil, nil, fmt.Errorf("workload %s has not been provisioned yet", w.Name)
+	}
+
+	p, ok := h.appState.GetProvider(w.Status.Provider)
+	if !ok {
+		return nil, nil, fmt.Errorf("provider %s not found", w.Status.Provider)
+	}
+
+	return w, p, nil
+}
+
+// execWriter sends everything written as one message
+type execWriter func(data []byte) error
+
+func (f execWriter) Write(p []byte) (int, error) {
+	if err := f(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// forwardConn presents a port forward stream as a connection
+type forwardConn struct {
+	recv    func() ([]byte, error)
+	send    func(data []byte) error
+	pending []byte
+}
+
+func (c *forwardConn) Read(p []byte) (int, error) {
+	for len(c.pending) == 0 {
+		data, err := c.recv()
+		if err != nil {
+			return 0, err
+		}
+		c.pending = data
+	}
+
+	n := copy(p, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+func (c *forwardConn) Write(p []byte) (int, error) {
+	if err := c.send(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/codecflow/fabric/pkg/proto/weaver"
+	"github.com/codecflow/fabric/pkg/secret"
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/auth"
+	"github.com/codecflow/fabric/weaver/internal/namespace"
+	"github.com/codecflow/fabric/weaver/internal/repository"
+	"github.com/codecflow/fabric/weaver/internal/state"
+)
+
+// DefaultNamespace is used by workloads that do not name a namespace
+const DefaultNamespace = "default"
+
+// dnsLabel matches DNS labels. Namespaces are used as tailnet tags and secrets as
+// provider-side object names, which both need to be DNS labels.
+var dnsLabel = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
+type NamespaceHandler struct {
+	appState *state.State
+	logger   *logrus.Logger
+}
+
+func NewNamespaceHandler(appState *state.State, logger *logrus.Logger) *NamespaceHandler {
+	return &NamespaceHandler{
+		appState: appState,
+		logger:   logger,
+	}
+}
+
+func (h *NamespaceHandler) Create(ctx context.Context, req *weaver.CreateNamespaceRequest) (*weaver.CreateNamespaceResponse, error) {
+	if !h.repositoryAvailable() {
+		return nil, fmt.Errorf("namespace repository not available")
+	}
+
+	if !dnsLabel.MatchString(req.Name) {
+		return nil, fmt.Errorf("invalid namespace name %q: must be a lowercase DNS label", req.Name)
+	}
+
+	spec := convertNamespaceSpec(req.Spec)
+	if err := spec.Quotas.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid namespace spec: %v", err)
+	}
+
+	if _, err := h.appState.Repository.Namespace.Get(ctx, req.Name); err == nil {
+		return nil, fmt.Errorf("namespace %s already exists", req.Name)
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get namespace: %v", err)
+	}
+
+	now := time.Now()
+	ns := &namespace.Namespace{
+		ID:          generateID(),
+		Name:        req.Name,
+		Labels:      req.Labels,
+		Annotations: req.Annotations,
+		Spec:        spec,
+		Status: namespace.Status{
+			Phase: namespace.PhaseActive,
+			Usage: namespace.NewResourceUsage(namespace.Usage(nil)),
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := h.appState.Repository.Namespace.Create(ctx, ns); err != nil {
+		return nil, fmt.Errorf("failed to store namespace: %v", err)
+	}
+
+	h.logger.Infof("Namespace %s created", ns.Name)
+
+	return &weaver.CreateNamespaceResponse{Namespace: convertNamespaceToProto(ns)}, nil
+}
+
+func (h *NamespaceHandler) Get(ctx context.Context, req *weaver.GetNamespaceRequest) (*weaver.GetNamespaceResponse, error) {
+	if !h.repositoryAvailable() {
+		return nil, fmt.Errorf("namespace repository not available")
+	}
+
+	ns, err := h.appState.Repository.Namespace.Get(ctx, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace: %v", err)
+	}
+
+	return &weaver.GetNamespaceResponse{Namespace: convertNamespaceToProto(ns)}, nil
+}
+
+func (h *NamespaceHandler) List(ctx context.Context, req *weaver.ListNamespacesRequest) (*weaver.ListNamespacesResponse, error) {
+	if !h.repositoryAvailable() {
+		return nil, fmt.Errorf("namespace repository not available")
+	}
+
+	namespaces, err := h.appState.Repository.Namespace.List(ctx, req.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %v", err)
+	}
+
+	response := &weaver.ListNamespacesResponse{}
+	for _, ns := range namespaces {
+		response.Namespaces = append(response.Namespaces, convertNamespaceToProto(ns))
+	}
+
+	return response, nil
+}
+
+func (h *NamespaceHandler) Update(ctx context.Context, req *weaver.UpdateNamespaceRequest) (*weaver.UpdateNamespaceResponse, error) {
+	if !h.repositoryAvailable() {
+		return nil, fmt.Errorf("namespace repository not available")
+	}
+
+	spec := convertNamespaceSpec(req.Spec)
+	if err := spec.Quotas.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid namespace spec: %v", err)
+	}
+
+	ns, err := h.appState.Repository.Namespace.Get(ctx, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace: %v", err)
+	}
+	if ns.Status.Phase == namespace.PhaseTerminating {
+		return nil, fmt.Errorf("namespace %s is terminating", ns.Name)
+	}
+
+	// Lowered quotas apply to new workloads; existing workloads keep running
+	ns.Spec = spec
+	ns.Labels = req.Labels
+	ns.Annotations = req.Annotations
+	ns.UpdatedAt = time.Now()
+
+	if err := h.appState.Repository.Namespace.Update(ctx, ns); err != nil {
+		return nil, fmt.Errorf("failed to update namespace: %v", err)
+	}
+
+	h.logger.Infof("Namespace %s updated", ns.Name)
+
+	return &weaver.UpdateNamespaceResponse{Namespace: convertNamespaceToProto(ns)}, nil
+}
+
+func (h *NamespaceHandler) Delete(ctx context.Context, req *weaver.DeleteNamespaceRequest) (*emptypb.Empty, error) {
+	if !h.repositoryAvailable() {
+		return nil, fmt.Errorf("namespace repository not available")
+	}
+
+	if req.Name == DefaultNamespace {
+		return nil, fmt.Errorf("the %s namespace cannot be deleted", DefaultNamespace)
+	}
+
+	if _, err := h.appState.Repository.Namespace.Get(ctx, req.Name); err != nil {
+		return nil, fmt.Errorf("failed to get namespace: %v", err)
+	}
+
+	if h.appState.Repository.Workload != nil {
+		workloads, err := h.appState.Repository.Workload.List(ctx, workload.Filter{Namespace: req.Name})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list workloads: %v", err)
+		}
+		if len(workloads) > 0 {
+			return nil, fmt.Errorf("namespace %s still has %d workloads", req.Name, len(workloads))
+		}
+	}
+
+	// Cron workloads would keep creating workloads in the namespace
+	if h.appState.Repository.Cron != nil {
+		cronWorkloads, err := h.appState.Repository.Cron.List(ctx, req.Name, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list cron workloads: %v", err)
+		}
+		if len(cronWorkloads) > 0 {
+			return nil, fmt.Errorf("namespace %s still has %d cron workloads", req.Name, len(cronWorkloads))
+		}
+	}
+
+	if h.appState.Repository.Secret != nil {
+		secrets, err := h.appState.Repository.Secret.List(ctx, secret.Filter{Namespace: req.Name})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list secrets: %v", err)
+		}
+		if len(secrets.Items) > 0 {
+			return nil, fmt.Errorf("namespace %s still has %d secrets", req.Name, len(secrets.Items))
+		}
+	}
+
+	if err := h.appState.Repository.Namespace.Delete(ctx, req.Name); err != nil {
+		return nil, fmt.Errorf("failed to delete namespace: %v", err)
+	}
+
+	h.removeRoleBindings(ctx, req.Name)
+
+	h.logger.Infof("Namespace %s deleted", req.Name)
+
+	return &emptypb.Empty{}, nil
+}
+
+// removeRoleBindings deletes the role bindings of a deleted namespace, so a
+// namespace created later under the same name starts without them
+func (h *NamespaceHandler) removeRoleBindings(ctx context.Context, name string) {
+	if h.appState.Repository.Auth == nil {
+		return
+	}
+
+	bindings, err := h.appState.Repository.Auth.ListRoleBindings(ctx, auth.BindingFilter{Namespace: name})
+	if err != nil {
+		h.logger.Warnf("Failed to list role bindings of namespace %s: %v", name, err)
+		return
+	}
+
+	for _, binding := range bindings {
+		if err := h.appState.Repository.Auth.DeleteRoleBinding(ctx, binding.ID); err != nil {
+			h.logger.Warnf("Failed to delete role binding %s of namespace %s: %v", binding.ID, name, err)
+		}
+	}
+}
+
+func (h *NamespaceHandler) repositoryAvailable() bool {
+	return h.appState.Repository != nil && h.appState.Repository.Namespace != nil
+}
@@ -0,0 +1,528 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/codecflow/fabric/pkg/proto/weaver"
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/node"
+	"github.com/codecflow/fabric/weaver/internal/repository"
+	"github.com/codecflow/fabric/weaver/internal/state"
+	"github.com/codecflow/fabric/weaver/services/provider"
+	"github.com/codecflow/fabric/weaver/services/stream"
+)
+
+const (
+	heartbeatInterval      = 15 * time.Second
+	assignmentPollInterval = 5 * time.Second
+)
+
+type NodeHandler struct {
+	appState *state.State
+	logger   *logrus.Logger
+}
+
+func NewNodeHandler(appState *state.State, logger *logrus.Logger) *NodeHandler {
+	return &NodeHandler{
+		appState: appState,
+		logger:   logger,
+	}
+}
+
+func (h *NodeHandler) Register(ctx context.Context, req *weaver.RegisterNodeRequest) (*weaver.RegisterNodeResponse, error) {
+	if !h.registryAvailable() {
+		return nil, fmt.Errorf("node registry not available")
+	}
+
+	nodeID := req.NodeId
+	if nodeID == "" {
+		nodeID = generateID()
+	}
+
+	capacity := convertNodeResources(req.Capacity)
+	if _, err := node.ParseResources(capacity); err != nil {
+		return nil, fmt.Errorf("invalid node capacity: %v", err)
+	}
+
+	now := time.Now()
+	n, err := h.appState.Repository.Node.Get(ctx, nodeID)
+	exists := err == nil
+	if err != nil {
+		if !errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("failed to get node: %v", err)
+		}
+		n = &node.Node{
+			ID:        nodeID,
+			CreatedAt: now,
+		}
+	}
+
+	// Registration refreshes everything the node reports about itself; allocations are kept
+	n.Name = req.Name
+	n.Region = req.Region
+	n.Zone = req.Zone
+	n.Labels = req.Labels
+	n.Taints = convertNodeTaints(req.Taints)
+	n.Address = req.Address
+	n.AgentPort = req.AgentPort
+	n.Version = req.Version
+	n.Capacity = capacity
+	n.Status = node.Status{Phase: node.PhaseReady}
+	n.LastHeartbeat = now
+	n.UpdatedAt = now
+
+	if exists {
+		err = h.appState.Repository.Node.Update(ctx, n)
+	} else {
+		err = h.appState.Repository.Node.Create(ctx, n)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to store node: %v", err)
+	}
+
+	h.logger.Infof("Node %s (%s) registered from %s", nodeID, req.Name, req.Address)
+	h.publish(ctx, stream.EventNodeJoined, nodeID, map[string]interface{}{
+		"name":    req.Name,
+		"region":  req.Region,
+		"zone":    req.Zone,
+		"address": req.Address,
+		"version": req.Version,
+	})
+
+	// Unschedulable workloads may fit on the new node
+	if h.appState.Queue != nil {
+		h.appState.Queue.CapacityChanged()
+	}
+
+	return &weaver.RegisterNodeResponse{
+		NodeId:                   nodeID,
+		HeartbeatIntervalSeconds: int32(heartbeatInterval / time.Second),
+	}, nil
+}
+
+// Unregister takes a node out of scheduling. The node is kept as NotReady until
+// the node controller has moved its workloads elsewhere, then it is removed.
+func (h *NodeHandler) Unregister(ctx context.Context, req *weaver.UnregisterNodeRequest) (*emptypb.Empty, error) {
+	if !h.registryAvailable() {
+		return nil, fmt.Errorf("node registry not available")
+	}
+
+	n, err := h.appState.Repository.Node.Get(ctx, req.NodeId)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return &emptypb.Empty{}, nil
+		}
+		return nil, fmt.Errorf("failed to get node: %v", err)
+	}
+
+	n.Status = node.Status{
+		Phase:   node.PhaseNotReady,
+		Reason:  node.ReasonUnregistered,
+		Message: "Node unregistered, workloads are being rescheduled",
+	}
+	n.UpdatedAt = time.Now()
+	if err := h.appState.Repository.Node.UpdateStatus(ctx, n); err != nil {
+		return nil, fmt.Errorf("failed to update node status: %v", err)
+	}
+
+	if h.appState.Repository.Workload != nil {
+		if err := h.releaseWorkloads(ctx, req.NodeId); err != nil {
+			// The node controller marks them on its next pass
+			h.logger.Warnf("Failed to mark workloads of unregistered node %s: %v", req.NodeId, err)
+		}
+	}
+
+	h.logger.Infof("Node %s unregistered", req.NodeId)
+	h.publish(ctx, stream.EventNodeLeft, req.NodeId, nil)
+
+	return &emptypb.Empty{}, nil
+}
+
+// releaseWorkloads marks the active workloads of an unregistered node as unknown,
+// so the node controller reschedules them
+func (h *NodeHandler) releaseWorkloads(ctx context.Context, nodeID string) error {
+	workloads, err := h.appState.Repository.Workload.ListByNode(ctx, nodeID)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range workloads {
+		switch w.Status.Phase {
+		case workload.PhaseScheduled, workload.PhaseRunning:
+		default:
+			continue
+		}
+
+		w.Status.Phase = workload.PhaseUnknown
+		w.Status.Reason = node.ReasonUnregistered
+		w.Status.Message = fmt.Sprintf("Node %s unregistered", nodeID)
+		w.UpdatedAt = time.Now()
+
+		err := h.appState.Repository.Workload.Update(ctx, w)
+		if errors.Is(err, repository.ErrConflict) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update workload %s: %w", w.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (h *NodeHandler) Heartbeat(ctx context.Context, req *weaver.HeartbeatRequest) (*weaver.HeartbeatResponse, error) {
+	if !h.registryAvailable() {
+		return nil, fmt.Errorf("node registry not available")
+	}
+
+	err := h.appState.Repository.Node.Heartbeat(ctx, req.NodeId, time.Now())
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return &weaver.HeartbeatResponse{Registered: false}, nil
+		}
+		return nil, fmt.Errorf("failed to record heartbeat: %v", err)
+	}
+
+	resp := &weaver.HeartbeatResponse{Registered: true}
+	if h.appState.Repository.Workload == nil {
+		return resp, nil
+	}
+
+	fenced, err := h.reconcileReported(ctx, req.NodeId, req.WorkloadIds)
+	if err != nil {
+		// The heartbeat itself was recorded; fencing is retried on the next one
+		h.logger.Warnf("Failed to reconcile workloads reported by node %s: %v", req.NodeId, err)
+		return resp, nil
+	}
+	resp.FencedWorkloadIds = fenced
+
+	return resp, nil
+}
+
+// reconcileReported compares the workloads a node says it runs with the ones it owns.
+// Workloads the node no longer owns are returned so it stops them, and owned workloads
+// that were marked Unknown while the node was unreachable are restored to Running.
+func (h *NodeHandler) reconcileReported(ctx context.Context, nodeID string, reported []string) ([]string, error) {
+	owned, err := h.appState.Repository.Workload.ListByNode(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	active := make(map[string]*workload.Workload, len(owned))
+	for _, w := range owned {
+		if assigned(w.Status.Phase) {
+			active[w.ID] = w
+		}
+	}
+
+	var fenced []string
+	for _, id := range reported {
+		w, ok := active[id]
+		if !ok {
+			fenced = append(fenced, id)
+			continue
+		}
+
+		if w.Status.Phase != workload.PhaseUnknown {
+			continue
+		}
+
+		w.Status.Phase = workload.PhaseRunning
+		w.Status.Reason = ""
+		w.Status.Message = fmt.Sprintf("Node %s is reachable again", nodeID)
+		w.UpdatedAt = time.Now()
+		if err := h.appState.Repository.Workload.Update(ctx, w); err != nil {
+			return nil, updateError(w, err)
+		}
+		h.logger.Infof("Workload %s recovered on node %s", w.ID, nodeID)
+	}
+
+	if len(fenced) > 0 {
+		h.logger.Warnf("Fencing %d workloads on node %s that are no longer assigned to it", len(fenced), nodeID)
+	}
+
+	return fenced, nil
+}
+
+// WatchAssignments streams the full set of workloads placed on a node whenever it changes
+func (h *NodeHandler) WatchAssignments(req *weaver.WatchAssignmentsRequest, srv grpc.ServerStreamingServer[weaver.WorkloadAssignments]) error {
+	if !h.registryAvailable() || h.appState.Repository.Workload == nil {
+		return fmt.Errorf("node registry not available")
+	}
+
+	ctx := srv.Context()
+	if _, err := h.appState.Repository.Node.Get(ctx, req.NodeId); err != nil {
+		return fmt.Errorf("node %s is not registered: %v", req.NodeId, err)
+	}
+
+	ticker := time.NewTicker(assignmentPollInterval)
+	defer ticker.Stop()
+
+	h.logger.Infof("Node %s is watching assignments", req.NodeId)
+
+	var last string
+	for {
+		assignments, err := h.assignments(ctx, req.NodeId)
+		if err != nil {
+			h.logger.Warnf("Failed to list assignments for node %s: %v", req.NodeId, err)
+		} else if fingerprint := assignmentsFingerprint(assignments); fingerprint != last {
+			assignments.Timestamp = timestamppb.Now()
+			if err := srv.Send(assignments); err != nil {
+				return fmt.Errorf("failed to send assignments: %v", err)
+			}
+			last = fingerprint
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *NodeHandler) ReportWorkloadStatus(ctx context.Context, req *weaver.ReportWorkloadStatusRequest) (*emptypb.Empty, error) {
+	if h.appState.Repository == nil || h.appState.Repository.Workload == nil {
+		return nil, fmt.Errorf("workload repository not available")
+	}
+
+	w, err := h.appState.Repository.Workload.Get(ctx, req.WorkloadId)
+	if err != nil {
+		// The workload may have been deleted while the node was still running it
+		if errors.Is(err, repository.ErrNotFound) {
+			h.logger.Debugf("Ignoring status report from node %s for unknown workload %s", req.NodeId, req.WorkloadId)
+			return &emptypb.Empty{}, nil
+		}
+		return nil, fmt.Errorf("failed to get workload: %v", err)
+	}
+
+	if w.Status.NodeID != req.NodeId {
+		return nil, fmt.Errorf("workload %s is not assigned to node %s", req.WorkloadId, req.NodeId)
+	}
+
+	// The node is stopping a terminating workload; its phase stays Terminating
+	if w.Terminating() {
+		h.logger.Debugf("Ignoring status report from node %s for terminating workload %s", req.NodeId, req.WorkloadId)
+		return &emptypb.Empty{}, nil
+	}
+
+	reported := time.Now()
+	if req.Timestamp != nil {
+		reported = req.Timestamp.AsTime()
+	}
+
+	previous := w.Status.Phase
+	w.Status.Phase = convertNodeStatus(req.Status)
+	if req.ContainerId != "" {
+		w.Status.ContainerID = req.ContainerId
+	}
+	if req.Message != "" {
+		w.Status.Message = req.Message
+	}
+	if req.RestartCount > w.Status.RestartCount {
+		w.Status.RestartCount = req.RestartCount
+	}
+	// Nodes report the exit code of the last exited container
+	w.Status.ExitCode = req.ExitCode
+
+	switch w.Status.Phase {
+	case workload.PhaseRunning:
+		if w.Status.StartTime == nil {
+			w.Status.StartTime = &reported
+		}
+		// The node reports why a restarting container is not running yet
+		w.Status.Reason = req.Reason
+	case workload.PhaseSucceeded, workload.PhaseFailed:
+		if w.Status.FinishTime == nil {
+			w.Status.FinishTime = &reported
+		}
+		if req.ExitCode != 0 {
+			w.Status.Reason = fmt.Sprintf("ExitCode%d", req.ExitCode)
+		}
+		// Only the job as a whole finishes; a run that leaves it incomplete is
+		// retried, unassigning the workload from the node until it is scheduled again
+		if w.Spec.IsJob() && previous != workload.PhaseSucceeded && previous != workload.PhaseFailed {
+			w.EndJobRun(req.ExitCode, w.Status.Phase == workload.PhaseFailed, reported)
+		}
+	}
+
+	// A report racing with another change is aborted and retried by the node
+	w.UpdatedAt = time.Now()
+	if err := h.appState.Repository.Workload.Update(ctx, w); err != nil {
+		return nil, updateError(w, err)
+	}
+
+	if w.Status.Phase != previous {
+		h.logger.Infof("Node %s reported workload %s as %s", req.NodeId, w.ID, w.Status.Phase)
+
+		switch w.Status.Phase {
+		case workload.PhaseRunning:
+			h.publishWorkload(ctx, stream.EventWorkloadStarted, w)
+		case workload.PhaseSucceeded:
+			h.publishWorkload(ctx, stream.EventWorkloadStopped, w)
+		case workload.PhaseFailed:
+			h.publishWorkload(ctx, stream.EventWorkloadFailed, w)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// registryAvailable reports whether nodes can be persisted
+func (h *NodeHandler) registryAvailable() bool {
+	return h.appState.Repository != nil && h.appState.Repository.Node != nil
+}
+
+// assignments builds the set of workloads a node should be running
+func (h *NodeHandler) assignments(ctx context.Context, nodeID string) (*weaver.WorkloadAssignments, error) {
+	workloads, err := h.appState.Repository.Workload.ListByNode(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &weaver.WorkloadAssignments{}
+	for _, w := range workloads {
+		if !assigned(w.Status.Phase) {
+			continue
+		}
+
+		assignment := &weaver.WorkloadAssignment{
+			Id:        w.ID,
+			Name:      w.Name,
+			Namespace: w.Namespace,
+			Spec:      convertWorkloadSpecToProto(&w.Spec),
+		}
+
+		if err := h.resolveSecrets(ctx, w, assignment); err != nil {
+			// Leaving a running workload out would stop it on the node, so keep
+			// the node on its last assignment set instead
+			if w.Status.Phase != workload.PhaseScheduled {
+				return nil, err
+			}
+			h.logger.Warnf("Withholding workload %s from node %s: %v", w.ID, nodeID, err)
+			continue
+		}
+
+		result.Workloads = append(result.Workloads, assignment)
+	}
+
+	return result, nil
+}
+
+// resolveSecrets adds the secret values a workload references to its assignment.
+// They are sent to the node only and never stored.
+func (h *NodeHandler) resolveSecrets(ctx context.Context, w *workload.Workload, assignment *weaver.WorkloadAssignment) error {
+	var source provider.SecretSource
+	if h.appState.Secrets != nil {
+		source = h.appState.Secrets
+	}
+
+	resolved, err := provider.ResolveSecrets(ctx, source, w)
+	if err != nil {
+		return err
+	}
+
+	if len(resolved.EnvVars) > 0 {
+		assignment.SecretEnv = make(map[string]string, len(resolved.EnvVars))
+		for _, v := range resolved.EnvVars {
+			assignment.SecretEnv[v.Name] = string(v.Value)
+		}
+	}
+	for _, f := range resolved.Files {
+		assignment.SecretFiles = append(assignment.SecretFiles, &weaver.SecretFile{
+			Path:  f.Path,
+			Value: f.Value,
+		})
+	}
+
+	return nil
+}
+
+// assigned reports whether a workload in the given phase should be running on its node.
+// Unknown is included so a node that was briefly unreachable keeps its workloads.
+func assigned(phase workload.Phase) bool {
+	switch phase {
+	case workload.PhaseScheduled, workload.PhaseRunning, workload.PhaseUnknown:
+		return true
+	}
+	return false
+}
+
+// assignmentsFingerprint hashes the assigned workload IDs and specs so unchanged sets are not resent
+func assignmentsFingerprint(assignments *weaver.WorkloadAssignments) string {
+	hash := sha256.New()
+	for _, a := range assignments.Workloads {
+		data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(a.Spec)
+		hash.Write([]byte(a.Id))
+		hash.Write(data)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// convertNodeStatus maps a Shuttle instance status to a workload phase
+func convertNodeStatus(status string) workload.Phase {
+	switch status {
+	case "Running", "Restarting":
+		return workload.PhaseRunning
+	case "Stopped":
+		return workload.PhaseSucceeded
+	case "Failed":
+		return workload.PhaseFailed
+	case "Pending":
+		return workload.PhaseScheduled
+	default:
+		return workload.PhaseUnknown
+	}
+}
+
+// publish emits a node event if a stream is configured
+func (h *NodeHandler) publish(ctx context.Context, eventType stream.EventType, nodeID string, data map[string]interface{}) {
+	if h.appState.Stream == nil {
+		return
+	}
+
+	event := &stream.Event{
+		Type:   eventType,
+		Source: "weaver.node",
+		ID:     nodeID,
+		Data:   data,
+	}
+
+	if err := stream.PublishEvent(ctx, h.appState.Stream, stream.SubjectEvents, "node", event); err != nil {
+		h.logger.Warnf("Failed to publish %s event for node %s: %v", eventType, nodeID, err)
+	}
+}
+
+// publishWorkload emits a workload event reported by a node
+func (h *NodeHandler) publishWorkload(ctx context.Context, eventType stream.EventType, w *workload.Workload) {
+	if h.appState.Stream == nil {
+		return
+	}
+
+	event := &stream.Event{
+		Type:   eventType,
+		Source: "weaver.node",
+		ID:     w.ID,
+		Data: map[string]interface{}{
+			"name":      w.Name,
+			"namespace": w.Namespace,
+			"phase":     string(w.Status.Phase),
+			"nodeId":    w.Status.NodeID,
+		},
+	}
+
+	if err := stream.PublishEvent(ctx, h.appState.Stream, stream.SubjectWorkloads, w.ID, event); err != nil {
+		h.logger.Warnf("Failed to publish %s event for workload %s: %v", eventType, w.ID, err)
+	}
+}
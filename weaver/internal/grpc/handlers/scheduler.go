@@ -3,13 +3,17 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/codecflow/fabric/pkg/proto/weaver"
 	"github.com/codecflow/fabric/pkg/workload"
 	"github.com/codecflow/fabric/weaver/internal/state"
-	"github.com/codecflow/fabric/weaver/weaver/proto/weaver"
+	"github.com/codecflow/fabric/weaver/services/scheduler"
 )
 
 type SchedulerHandler struct {
@@ -53,6 +57,9 @@ func (h *SchedulerHandler) Schedule(ctx context.Context, req *weaver.ScheduleWor
 		ID:   generateID(),
 		Spec: convertWorkloadSpec(req.Spec),
 	}
+	if _, err := w.Spec.Resources.Quantity(); err != nil {
+		return nil, fmt.Errorf("invalid resource requests: %v", err)
+	}
 
 	result, err := h.appState.Scheduler.Schedule(ctx, w)
 	if err != nil {
@@ -82,6 +89,9 @@ func (h *SchedulerHandler) GetRecommendations(ctx context.Context, req *weaver.G
 		ID:   generateID(),
 		Spec: convertWorkloadSpec(req.Spec),
 	}
+	if _, err := w.Spec.Resources.Quantity(); err != nil {
+		return nil, fmt.Errorf("invalid resource requests: %v", err)
+	}
 
 	recommendations, err := h.appState.Scheduler.GetRecommendations(ctx, w)
 	if err != nil {
@@ -96,7 +106,7 @@ func (h *SchedulerHandler) GetRecommendations(ctx context.Context, req *weaver.G
 			Zone:             "",
 			CostPerHour:      rec.EstimatedCost.HourlyCost,
 			PerformanceScore: rec.Score,
-			Reason:           fmt.Sprintf("Score: %.2f, Confidence: %.2f", rec.Score, rec.Confidence),
+			Reason:           fmt.Sprintf("Score: %.2f, Confidence: %.2f, %s", rec.Score, rec.Confidence, strings.Join(rec.Pros, ", ")),
 		})
 	}
 
@@ -105,6 +115,85 @@ func (h *SchedulerHandler) GetRecommendations(ctx context.Context, req *weaver.G
 	}, nil
 }
 
+func (h *SchedulerHandler) Explain(ctx context.Context, req *weaver.ExplainSchedulingRequest) (*weaver.ExplainSchedulingResponse, error) {
+	if h.appState.Scheduler == nil {
+		return nil, fmt.Errorf("scheduler not configured")
+	}
+
+	explainer, ok := h.appState.Scheduler.(scheduler.Explainer)
+	if !ok {
+		return nil, fmt.Errorf("scheduler does not support explanations")
+	}
+
+	response := &weaver.ExplainSchedulingResponse{}
+
+	var w *workload.Workload
+	switch {
+	case req.WorkloadId != "":
+		existing, err := h.appState.Repository.Workload.Get(ctx, req.WorkloadId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get workload: %v", err)
+		}
+		w = existing
+		response.Status = convertWorkloadStatus(&w.Status)
+	case req.Spec != nil:
+		w = &workload.Workload{
+			ID:   generateID(),
+			Spec: convertWorkloadSpec(req.Spec),
+		}
+		if _, err := w.Spec.Resources.Quantity(); err != nil {
+			return nil, fmt.Errorf("invalid resource requests: %v", err)
+		}
+	default:
+		return nil, fmt.Errorf("workload_id or spec is required")
+	}
+
+	evaluations, err := explainer.Explain(ctx, w)
+	if err != nil {
+		return nil, fmt.Errorf("failed to explain scheduling: %v", err)
+	}
+
+	for _, e := range evaluations {
+		response.Candidates = append(response.Candidates, convertCandidateEvaluation(e))
+	}
+	if feasible := scheduler.FeasibleEvaluations(evaluations); len(feasible) > 0 {
+		response.SelectedProvider = feasible[0].Provider
+		response.SelectedRegion = feasible[0].Region
+	}
+
+	return response, nil
+}
+
+func (h *SchedulerHandler) ListQueue(ctx context.Context, req *weaver.ListSchedulingQueueRequest) (*weaver.ListSchedulingQueueResponse, error) {
+	if h.appState.Queue == nil {
+		return nil, fmt.Errorf("scheduling queue not configured")
+	}
+
+	now := time.Now()
+	items, positions := h.appState.Queue.List(req.Namespace)
+
+	response := &weaver.ListSchedulingQueueResponse{}
+	for i, item := range items {
+		queued := &weaver.QueuedWorkload{
+			WorkloadId:    item.WorkloadID,
+			Name:          item.Name,
+			Namespace:     item.Namespace,
+			Priority:      item.Priority,
+			Position:      int32(positions[i]), // nolint:gosec
+			AgeSeconds:    int64(now.Sub(item.EnqueuedAt).Seconds()),
+			Attempts:      int32(item.Attempts), // nolint:gosec
+			LastError:     item.LastError,
+			Unschedulable: item.Unschedulable,
+		}
+		if !item.NextAttempt.IsZero() {
+			queued.NextAttempt = timestamppb.New(item.NextAttempt)
+		}
+		response.Workloads = append(response.Workloads, queued)
+	}
+
+	return response, nil
+}
+
 func (h *SchedulerHandler) GetStats(ctx context.Context, req *emptypb.Empty) (*weaver.GetSchedulerStatsResponse, error) {
 	if h.appState.Scheduler == nil {
 		return nil, fmt.Errorf("scheduler not configured")
@@ -116,14 +205,9 @@ func (h *SchedulerHandler) GetStats(ctx context.Context, req *emptypb.Empty) (*w
 	}
 
 	var pendingWorkloads int32
-	workloads, err := h.appState.Repository.Workload.List(ctx, "", nil)
+	workloads, err := h.appState.Repository.Workload.ListByPhase(ctx, workload.PhasePending, workload.PhaseScheduled)
 	if err == nil {
-		for _, w := range workloads {
-			if w.Status.Phase == workload.PhasePending ||
-				w.Status.Phase == workload.PhaseScheduled {
-				pendingWorkloads++
-			}
-		}
+		pendingWorkloads = int32(len(workloads)) // nolint:gosec
 	}
 
 	return &weaver.GetSchedulerStatsResponse{
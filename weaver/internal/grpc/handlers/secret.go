@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/codecflow/fabric/pkg/proto/weaver"
+	"github.com/codecflow/fabric/pkg/secret"
+	"github.com/codecflow/fabric/weaver/internal/repository"
+	"github.com/codecflow/fabric/weaver/internal/state"
+	"github.com/codecflow/fabric/weaver/services/stream"
+)
+
+type SecretHandler struct {
+	appState *state.State
+	logger   *logrus.Logger
+}
+
+func NewSecretHandler(appState *state.State, logger *logrus.Logger) *SecretHandler {
+	return &SecretHandler{
+		appState: appState,
+		logger:   logger,
+	}
+}
+
+func (h *SecretHandler) Create(ctx context.Context, req *weaver.CreateSecretRequest) (*weaver.CreateSecretResponse, error) {
+	if h.appState.Secrets == nil {
+		return nil, fmt.Errorf("secret management not configured")
+	}
+
+	if !dnsLabel.MatchString(req.Name) {
+		return nil, fmt.Errorf("invalid secret name %q: must be a lowercase DNS label", req.Name)
+	}
+
+	spec, err := secretSpec(req.Type, req.Data, req.ExternalRef)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceName := secretNamespace(req.Namespace)
+	if h.appState.Repository != nil && h.appState.Repository.Namespace != nil {
+		if _, err := h.appState.Repository.Namespace.Get(ctx, namespaceName); errors.Is(err, repository.ErrNotFound) {
+			return nil, fmt.Errorf("namespace %s not found", namespaceName)
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get namespace: %v", err)
+		}
+	}
+
+	if _, err := h.appState.Secrets.Get(ctx, namespaceName, req.Name); err == nil {
+		return nil, fmt.Errorf("secret %s/%s already exists", namespaceName, req.Name)
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get secret: %v", err)
+	}
+
+	s, err := h.appState.Secrets.Create(ctx, namespaceName, req.Name, spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create secret: %v", err)
+	}
+
+	h.logger.Infof("Secret %s/%s created", s.Namespace, s.Name)
+	h.publish(ctx, stream.EventSecretCreated, s)
+
+	return &weaver.CreateSecretResponse{Secret: convertSecretToProto(s, false)}, nil
+}
+
+func (h *SecretHandler) Get(ctx context.Context, req *weaver.GetSecretRequest) (*weaver.GetSecretResponse, error) {
+	if h.appState.Secrets == nil {
+		return nil, fmt.Errorf("secret management not configured")
+	}
+	if req.Reveal && !h.appState.RevealSecrets {
+		return nil, fmt.Errorf("revealing secret values is not allowed")
+	}
+
+	namespaceName := secretNamespace(req.Namespace)
+
+	get := h.appState.Secrets.Get
+	if req.Reveal {
+		get = h.appState.Secrets.Reveal
+	}
+
+	s, err := get(ctx, namespaceName, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret: %v", err)
+	}
+
+	if req.Reveal {
+		h.logger.Infof("Secret %s/%s revealed", s.Namespace, s.Name)
+	}
+
+	return &weaver.GetSecretResponse{Secret: convertSecretToProto(s, req.Reveal)}, nil
+}
+
+func (h *SecretHandler) List(ctx context.Context, req *weaver.ListSecretsRequest) (*weaver.ListSecretsResponse, error) {
+	if h.appState.Secrets == nil {
+		return nil, fmt.Errorf("secret management not configured")
+	}
+	if req.Reveal && !h.appState.RevealSecrets {
+		return nil, fmt.Errorf("revealing secret values is not allowed")
+	}
+
+	filter := secret.Filter{
+		Namespace: secretNamespace(req.Namespace),
+		Type:      secret.SecretType(req.Type),
+	}
+
+	list := h.appState.Secrets.List
+	if req.Reveal {
+		list = h.appState.Secrets.RevealList
+	}
+
+	secrets, err := list(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secrets: %v", err)
+	}
+
+	if req.Reveal {
+		h.logger.Infof("%d secrets in namespace %s revealed", len(secrets.Items), filter.Namespace)
+	}
+
+	response := &weaver.ListSecretsResponse{}
+	for i := range secrets.Items {
+		response.Secrets = append(response.Secrets, convertSecretToProto(&secrets.Items[i], req.Reveal))
+	}
+
+	return response, nil
+}
+
+func (h *SecretHandler) Update(ctx context.Context, req *weaver.UpdateSecretRequest) (*weaver.UpdateSecretResponse, error) {
+	if h.appState.Secrets == nil {
+		return nil, fmt.Errorf("secret management not configured")
+	}
+
+	spec, err := secretSpec(req.Type, req.Data, req.ExternalRef)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := h.appState.Secrets.Update(ctx, secretNamespace(req.Namespace), req.Name, spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update secret: %v", err)
+	}
+
+	h.logger.Infof("Secret %s/%s updated", s.Namespace, s.Name)
+	h.publish(ctx, stream.EventSecretUpdated, s)
+
+	return &weaver.UpdateSecretResponse{Secret: convertSecretToProto(s, false)}, nil
+}
+
+func (h *SecretHandler) Delete(ctx context.Context, req *weaver.DeleteSecretRequest) (*emptypb.Empty, error) {
+	if h.appState.Secrets == nil {
+		return nil, fmt.Errorf("secret management not configured")
+	}
+
+	namespaceName := secretNamespace(req.Namespace)
+
+	s, err := h.appState.Secrets.Get(ctx, namespaceName, req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret: %v", err)
+	}
+
+	if err := h.appState.Secrets.Delete(ctx, namespaceName, req.Name); err != nil {
+		return nil, fmt.Errorf("failed to delete secret: %v", err)
+	}
+
+	h.logger.Infof("Secret %s/%s deleted", namespaceName, req.Name)
+	h.publish(ctx, stream.EventSecretDeleted, s)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *SecretHandler) Sync(ctx context.Context, req *weaver.SyncSecretRequest) (*weaver.SyncSecretResponse, error) {
+	if h.appState.Secrets == nil {
+		return nil, fmt.Errorf("secret management not configured")
+	}
+
+	s, err := h.appState.Secrets.SyncExternal(ctx, secretNamespace(req.Namespace), req.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sync secret: %v", err)
+	}
+
+	if s.Status.SyncError != "" {
+		h.logger.Warnf("Failed to sync secret %s/%s: %s", s.Namespace, s.Name, s.Status.SyncError)
+	} else {
+		h.publish(ctx, stream.EventSecretUpdated, s)
+	}
+
+	return &weaver.SyncSecretResponse{Secret: convertSecretToProto(s, false)}, nil
+}
+
+// secretSpec builds and validates a secret spec from a request
+func secretSpec(secretType string, data map[string][]byte, ref *weaver.ExternalSecretRef) (*secret.Spec, error) {
+	spec := &secret.Spec{
+		Type:        secret.SecretType(secretType),
+		ExternalRef: convertExternalSecretRef(ref),
+	}
+	if spec.Type == "" {
+		spec.Type = secret.TypeOpaque
+	}
+	if !spec.Type.Valid() {
+		return nil, fmt.Errorf("invalid secret type %q", secretType)
+	}
+
+	// Data is left nil when not given so updates keep the stored values
+	if len(data) > 0 {
+		spec.Data = data
+	}
+	if spec.ExternalRef != nil && spec.ExternalRef.Provider == "" {
+		return nil, fmt.Errorf("external secret reference requires a provider")
+	}
+
+	return spec, nil
+}
+
+// secretNamespace defaults an empty namespace to the default namespace
+func secretNamespace(namespace string) string {
+	if namespace == "" {
+		return DefaultNamespace
+	}
+	return namespace
+}
+
+// publish emits a secret event if a stream is configured. Events never carry values.
+func (h *SecretHandler) publish(ctx context.Context, eventType stream.EventType, s *secret.Secret) {
+	if h.appState.Stream == nil {
+		return
+	}
+
+	event := &stream.Event{
+		Type:   eventType,
+		Source: "weaver.secret",
+		ID:     s.ID,
+		Data: map[string]interface{}{
+			"name":      s.Name,
+			"namespace": s.Namespace,
+			"type":      string(s.Spec.Type),
+		},
+	}
+
+	if err := stream.PublishEvent(ctx, h.appState.Stream, stream.SubjectEvents, "secret", event); err != nil {
+		h.logger.Warnf("Failed to publish %s event for secret %s/%s: %v", eventType, s.Namespace, s.Name, err)
+	}
+}
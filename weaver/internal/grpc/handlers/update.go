@@ -0,0 +1,326 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/codecflow/fabric/pkg/proto/weaver"
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/namespace"
+	"github.com/codecflow/fabric/weaver/internal/repository"
+	"github.com/codecflow/fabric/weaver/services/provider"
+	"github.com/codecflow/fabric/weaver/services/stream"
+)
+
+// Update replaces the spec, labels and annotations of a workload. Changes the
+// provider can make to the running workload are applied in place; any other
+// change recreates it. An update that races with another change of the
+// workload, such as a status update, fails as aborted and can be retried.
+func (h *WorkloadHandler) Update(ctx context.Context, req *weaver.UpdateWorkloadRequest) (*weaver.UpdateWorkloadResponse, error) {
+	if h.appState.Repository.Workload == nil {
+		return nil, fmt.Errorf("workload repository not available")
+	}
+
+	spec := convertWorkloadSpec(req.Spec)
+	spec.Default()
+	if err := spec.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid workload spec: %v", err)
+	}
+
+	current, err := h.appState.Repository.Workload.Get(ctx, req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workload: %v", err)
+	}
+	if current.Terminating() {
+		return nil, fmt.Errorf("workload %s is being deleted", current.Name)
+	}
+
+	desired := *current
+	desired.Labels = req.Labels
+	desired.Annotations = req.Annotations
+	desired.Spec = spec
+
+	if err := h.readmit(ctx, current, &desired); err != nil {
+		return nil, err
+	}
+
+	changes := workload.Diff(current, &desired)
+	if len(changes) == 0 {
+		return updateResponse(current, workload.UpdateStrategyNone, nil), nil
+	}
+
+	if !namespace.Counts(current) {
+		for _, change := range changes {
+			if !change.Metadata() {
+				return nil, fmt.Errorf("workload %s has finished, only its labels, annotations and priority can change", current.Name)
+			}
+		}
+	}
+
+	strategy, surge := workload.UpdateStrategyInPlace, false
+	if current.Status.ProviderRef == nil {
+		err = h.updateUnprovisioned(ctx, current, &desired, changes)
+	} else {
+		strategy, surge, err = h.updateProvisioned(ctx, current, &desired, changes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, change := range changes {
+		if change == workload.ChangeResources {
+			h.refreshUsage(ctx, desired.Namespace)
+			break
+		}
+	}
+
+	h.logger.Infof("Updated workload %s/%s (%s): %v", desired.Namespace, desired.Name, strategy, changes)
+	h.publishUpdate(ctx, &desired, strategy, changes, surge)
+
+	return updateResponse(&desired, strategy, changes), nil
+}
+
+// readmit applies the namespace's default placement to an updated workload and
+// checks grown resource requests against the namespace's quotas
+func (h *WorkloadHandler) readmit(ctx context.Context, current, desired *workload.Workload) error {
+	if h.appState.Repository.Namespace == nil {
+		return nil
+	}
+
+	ns, err := h.appState.Repository.Namespace.Get(ctx, current.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get namespace: %v", err)
+	}
+
+	ns.Spec.ApplyDefaultPlacement(&desired.Spec.Placement)
+
+	requests, err := desired.Spec.Resources.Quantity()
+	if err != nil {
+		return fmt.Errorf("invalid workload spec: %v", err)
+	}
+	// Requests were validated when the workload was created
+	previous, _ := current.Spec.Resources.Quantity()
+	if !namespace.Counts(current) || requests.Fits(previous) {
+		return nil
+	}
+
+	existing, err := h.appState.Repository.Workload.List(ctx, workload.Filter{Namespace: ns.Name})
+	if err != nil {
+		return fmt.Errorf("failed to list workloads: %v", err)
+	}
+
+	others := make([]*workload.Workload, 0, len(existing))
+	for _, w := range existing {
+		if w.ID != current.ID {
+			others = append(others, w)
+		}
+	}
+
+	count, used := namespace.Usage(others)
+	if err := ns.Spec.Quotas.Admit(count+1, used.Add(requests)); err != nil {
+		return fmt.Errorf("update rejected by namespace %s: %v", ns.Name, err)
+	}
+
+	return nil
+}
+
+// updateUnprovisioned stores the update of a workload that does not run yet.
+// A scheduled workload whose requirements changed is scheduled again.
+func (h *WorkloadHandler) updateUnprovisioned(ctx context.Context, current, desired *workload.Workload, changes []workload.Change) error {
+	reschedule := false
+	for _, change := range changes {
+		reschedule = reschedule || change == workload.ChangeResources || change == workload.ChangePlacement
+	}
+
+	if reschedule && current.Status.Phase == workload.PhaseScheduled {
+		desired.Status = workload.Status{
+			Phase:   workload.PhasePending,
+			Message: "Rescheduling after update",
+		}
+	}
+
+	if err := h.store(ctx, desired); err != nil {
+		return err
+	}
+
+	if h.appState.Queue != nil && desired.Status.Phase == workload.PhasePending {
+		h.appState.Queue.Add(desired)
+	}
+
+	return nil
+}
+
+// updateProvisioned applies an update to a workload on its provider, in place
+// when the provider supports every change and by recreating it otherwise
+func (h *WorkloadHandler) updateProvisioned(ctx context.Context, current, desired *workload.Workload, changes []workload.Change) (workload.UpdateStrategy, bool, error) {
+	p, ok := h.appState.GetProvider(current.Status.Provider)
+	if !ok {
+		return "", false, fmt.Errorf("provider %s not available", current.Status.Provider)
+	}
+
+	updater, _ := p.(provider.InPlaceUpdater)
+	inPlace, onProvider := true, false
+	for _, change := range changes {
+		applied := updater != nil && updater.UpdatesInPlace(current, change)
+		onProvider = onProvider || applied
+		inPlace = inPlace && (applied || change.Metadata())
+	}
+
+	if inPlace {
+		// Metadata only the provider does not carry is stored without touching it
+		if onProvider {
+			if err := p.UpdateWorkload(ctx, desired); err != nil {
+				return "", false, fmt.Errorf("failed to update workload on provider: %v", err)
+			}
+		}
+		if err := h.store(ctx, desired); err != nil {
+			return "", false, err
+		}
+		return workload.UpdateStrategyInPlace, false, nil
+	}
+
+	surge := current.Spec.AllowsSurge() && desired.Spec.AllowsSurge() &&
+		(desired.Spec.Placement.Provider == "" || desired.Spec.Placement.Provider == current.Status.Provider)
+	if surger, ok := p.(provider.SurgeProvider); !ok || !surger.CanSurge(desired) {
+		surge = false
+	}
+
+	if surge {
+		return workload.UpdateStrategyRecreate, true, h.surge(ctx, p, current, desired)
+	}
+	return workload.UpdateStrategyRecreate, false, h.recreate(ctx, p, current, desired)
+}
+
+// surge creates the replacement of a workload on its provider before stopping
+// the current instance, so the workload keeps running during the update
+func (h *WorkloadHandler) surge(ctx context.Context, p provider.Provider, current, desired *workload.Workload) error {
+	providerName := current.Status.Provider
+	desired.Status = workload.Status{Phase: workload.PhaseScheduled, Provider: providerName}
+
+	if err := p.CreateWorkload(ctx, desired); err != nil {
+		return fmt.Errorf("failed to create replacement on provider %s: %v", providerName, err)
+	}
+
+	// Providers may overwrite status fields; the phase is owned by the controller
+	// until the provider reports the replacement as running.
+	desired.Status.Provider = providerName
+	desired.Status.Phase = workload.PhaseScheduled
+	desired.Status.Message = fmt.Sprintf("Replacement provisioned on provider %s", providerName)
+
+	if err := h.store(ctx, desired); err != nil {
+		if err := p.DeleteWorkload(ctx, desired); err != nil {
+			h.logger.Warnf("Failed to remove replacement of workload %s: %v", desired.ID, err)
+		}
+		return err
+	}
+
+	if err := p.DeleteWorkload(ctx, current); err != nil {
+		h.logger.Warnf("Failed to stop previous instance of workload %s on provider %s: %v", current.ID, providerName, err)
+	}
+
+	return nil
+}
+
+// recreate stops the current instance of a workload and queues it to be
+// scheduled again with the new spec
+func (h *WorkloadHandler) recreate(ctx context.Context, p provider.Provider, current, desired *workload.Workload) error {
+	if err := p.DeleteWorkload(ctx, current); err != nil {
+		return fmt.Errorf("failed to delete workload from provider: %v", err)
+	}
+
+	desired.Status = workload.Status{
+		Phase:   workload.PhasePending,
+		Message: "Recreating after update",
+	}
+	desired.UpdatedAt = time.Now()
+	err := h.appState.Repository.Workload.Update(ctx, desired)
+	if errors.Is(err, repository.ErrConflict) {
+		// The instance is gone, so the update wins over concurrent changes of
+		// its status, unless the workload is being deleted
+		latest, getErr := h.appState.Repository.Workload.Get(ctx, desired.ID)
+		if getErr != nil {
+			return fmt.Errorf("failed to get workload: %v", getErr)
+		}
+		if latest.Terminating() {
+			return fmt.Errorf("workload %s is being deleted", latest.Name)
+		}
+		desired.ResourceVersion = latest.ResourceVersion
+		err = h.appState.Repository.Workload.Update(ctx, desired)
+	}
+	if err != nil {
+		return updateError(desired, err)
+	}
+
+	if h.appState.Queue != nil {
+		h.appState.Queue.Add(desired)
+		// Wakes the controller and lets queued workloads use the freed capacity
+		h.appState.Queue.CapacityChanged()
+	}
+
+	return nil
+}
+
+// store persists an updated workload
+func (h *WorkloadHandler) store(ctx context.Context, w *workload.Workload) error {
+	w.UpdatedAt = time.Now()
+	if err := h.appState.Repository.Workload.Update(ctx, w); err != nil {
+		return updateError(w, err)
+	}
+	return nil
+}
+
+// updateError reports a failed workload update. Updates of a workload that
+// changed since it was read are aborted, so clients retry them.
+func updateError(w *workload.Workload, err error) error {
+	if errors.Is(err, repository.ErrConflict) {
+		return status.Errorf(codes.Aborted, "workload %s was modified concurrently, retry the update", w.Name)
+	}
+	return fmt.Errorf("failed to update workload: %v", err)
+}
+
+// publishUpdate records how an update was applied as a workload event
+func (h *WorkloadHandler) publishUpdate(ctx context.Context, w *workload.Workload, strategy workload.UpdateStrategy, changes []workload.Change, surge bool) {
+	if h.appState.Stream == nil {
+		return
+	}
+
+	event := &stream.Event{
+		Type:   stream.EventWorkloadUpdated,
+		Source: "weaver.workload",
+		ID:     w.ID,
+		Data: map[string]interface{}{
+			"name":      w.Name,
+			"namespace": w.Namespace,
+			"phase":     string(w.Status.Phase),
+			"provider":  w.Status.Provider,
+			"strategy":  string(strategy),
+			"changes":   changeNames(changes),
+			"surge":     surge,
+		},
+	}
+
+	if err := stream.PublishEvent(ctx, h.appState.Stream, stream.SubjectWorkloads, w.ID, event); err != nil {
+		h.logger.Warnf("Failed to publish %s event for workload %s: %v", event.Type, w.ID, err)
+	}
+}
+
+func updateResponse(w *workload.Workload, strategy workload.UpdateStrategy, changes []workload.Change) *weaver.UpdateWorkloadResponse {
+	return &weaver.UpdateWorkloadResponse{
+		Workload: convertWorkloadToProto(w),
+		Strategy: string(strategy),
+		Changes:  changeNames(changes),
+	}
+}
+
+func changeNames(changes []workload.Change) []string {
+	names := make([]string, len(changes))
+	for i, change := range changes {
+		names[i] = string(change)
+	}
+	return names
+}
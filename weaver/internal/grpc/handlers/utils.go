@@ -3,11 +3,17 @@ package handlers
 import (
 	"crypto/rand"
 	"encoding/hex"
+	"sort"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/codecflow/fabric/pkg/proto/weaver"
+	"github.com/codecflow/fabric/pkg/secret"
 	"github.com/codecflow/fabric/pkg/workload"
-	"github.com/codecflow/fabric/weaver/weaver/proto/weaver"
+	"github.com/codecflow/fabric/weaver/internal/auth"
+	"github.com/codecflow/fabric/weaver/internal/cron"
+	"github.com/codecflow/fabric/weaver/internal/namespace"
+	"github.com/codecflow/fabric/weaver/internal/node"
 
 	// todo: service should separated from here.
 	"github.com/codecflow/fabric/weaver/services/scheduler"
@@ -47,6 +53,8 @@ func convertWorkloadSpec(spec *weaver.WorkloadSpec) workload.Spec {
 			MountPath: volume.MountPath,
 			ReadOnly:  volume.ReadOnly,
 			ContentID: volume.ContentId,
+			Size:      volume.Size,
+			Secret:    convertSecretReference(volume.Secret),
 		})
 	}
 
@@ -69,28 +77,123 @@ func convertWorkloadSpec(spec *weaver.WorkloadSpec) workload.Spec {
 	}
 
 	result.Restart = workload.RestartPolicy(spec.RestartPolicy)
+	result.Priority = spec.Priority
+	result.Kind = workload.Kind(spec.Kind)
+	result.Job = convertJobSpec(spec.Job)
 
-	if spec.Placement != nil {
-		result.Placement = workload.PlacementSpec{
-			Provider:   spec.Placement.Provider,
-			Region:     spec.Placement.Region,
-			Zone:       spec.Placement.Zone,
-			NodeLabels: spec.Placement.NodeLabels,
-		}
+	result.Placement = convertPlacementSpec(spec.Placement)
 
-		for _, toleration := range spec.Placement.Tolerations {
-			result.Placement.Tolerations = append(result.Placement.Tolerations, workload.Toleration{
-				Key:      toleration.Key,
-				Operator: toleration.Operator,
-				Value:    toleration.Value,
-				Effect:   toleration.Effect,
-			})
+	for _, source := range spec.EnvFrom {
+		result.EnvFrom = append(result.EnvFrom, secret.EnvFromSource{
+			SecretRef: convertSecretReference(source.SecretRef),
+			Prefix:    source.Prefix,
+		})
+	}
+
+	if len(spec.EnvValueFrom) > 0 {
+		result.EnvValueFrom = make(map[string]workload.EnvVarSource, len(spec.EnvValueFrom))
+		for name, source := range spec.EnvValueFrom {
+			result.EnvValueFrom[name] = workload.EnvVarSource{
+				SecretKeyRef: convertSecretReference(source.GetSecretKeyRef()),
+			}
 		}
 	}
 
 	return result
 }
 
+// convertJobSpec converts protobuf JobSpec to internal JobSpec
+func convertJobSpec(job *weaver.JobSpec) *workload.JobSpec {
+	if job == nil {
+		return nil
+	}
+	return &workload.JobSpec{
+		Completions:             job.Completions,
+		Parallelism:             job.Parallelism,
+		BackoffLimit:            job.BackoffLimit,
+		ActiveDeadlineSeconds:   job.ActiveDeadlineSeconds,
+		TTLSecondsAfterFinished: job.TtlSecondsAfterFinished,
+	}
+}
+
+// convertJobSpecToProto converts internal JobSpec to protobuf JobSpec
+func convertJobSpecToProto(job *workload.JobSpec) *weaver.JobSpec {
+	if job == nil {
+		return nil
+	}
+	return &weaver.JobSpec{
+		Completions:             job.Completions,
+		Parallelism:             job.Parallelism,
+		BackoffLimit:            job.BackoffLimit,
+		ActiveDeadlineSeconds:   job.ActiveDeadlineSeconds,
+		TtlSecondsAfterFinished: job.TTLSecondsAfterFinished,
+	}
+}
+
+// convertJobStatus converts internal JobStatus to protobuf JobStatus
+func convertJobStatus(job *workload.JobStatus) *weaver.JobStatus {
+	if job == nil {
+		return nil
+	}
+
+	result := &weaver.JobStatus{
+		Active:    job.Active,
+		Succeeded: job.Succeeded,
+		Failed:    job.Failed,
+	}
+	if job.StartTime != nil {
+		result.StartTime = timestamppb.New(*job.StartTime)
+	}
+	if job.CompletionTime != nil {
+		result.CompletionTime = timestamppb.New(*job.CompletionTime)
+	}
+	if job.RetryAt != nil {
+		result.RetryAt = timestamppb.New(*job.RetryAt)
+	}
+	return result
+}
+
+// convertSecretReference converts protobuf SecretReference to internal secret Reference
+func convertSecretReference(ref *weaver.SecretReference) *secret.Reference {
+	if ref == nil {
+		return nil
+	}
+	return &secret.Reference{Name: ref.Name, Key: ref.Key}
+}
+
+// convertSecretReferenceToProto converts internal secret Reference to protobuf SecretReference
+func convertSecretReferenceToProto(ref *secret.Reference) *weaver.SecretReference {
+	if ref == nil {
+		return nil
+	}
+	return &weaver.SecretReference{Name: ref.Name, Key: ref.Key}
+}
+
+// convertPlacementSpec converts protobuf PlacementSpec to internal PlacementSpec
+func convertPlacementSpec(placement *weaver.PlacementSpec) workload.PlacementSpec {
+	if placement == nil {
+		return workload.PlacementSpec{}
+	}
+
+	result := workload.PlacementSpec{
+		Provider:   placement.Provider,
+		Region:     placement.Region,
+		Zone:       placement.Zone,
+		NodeLabels: placement.NodeLabels,
+	}
+
+	for _, toleration := range placement.Tolerations {
+		result.Tolerations = append(result.Tolerations, workload.Toleration{
+			Key:      toleration.Key,
+			Operator: toleration.Operator,
+			Value:    toleration.Value,
+			Effect:   toleration.Effect,
+		})
+	}
+
+	return result
+}
+
 // convertWorkloadStatus converts internal WorkloadStatus to protobuf WorkloadStatus
 func convertWorkloadStatus(status *workload.Status) *weaver.WorkloadStatus {
 	if status == nil {
@@ -102,11 +205,13 @@ func convertWorkloadStatus(status *workload.Status) *weaver.WorkloadStatus {
 		Message:      status.Message,
 		Reason:       status.Reason,
 		RestartCount: status.RestartCount,
+		ExitCode:     status.ExitCode,
 		NodeId:       status.NodeID,
 		Provider:     status.Provider,
 		TailscaleIp:  status.TailscaleIP,
 		ContainerId:  status.ContainerID,
 		SnapshotId:   status.SnapshotID,
+		Job:          convertJobStatus(status.Job),
 	}
 
 	if status.StartTime != nil {
@@ -118,7 +223,34 @@ func convertWorkloadStatus(status *workload.Status) *weaver.WorkloadStatus {
 	if status.LastSnapshot != nil {
 		result.LastSnapshot = timestamppb.New(*status.LastSnapshot)
 	}
+	if status.ProviderRef != nil {
+		result.ProviderRef = &weaver.ProviderReference{
+			ExternalId: status.ProviderRef.ExternalID,
+			Name:       status.ProviderRef.Name,
+			Metadata:   status.ProviderRef.Metadata,
+		}
+	}
+
+	return result
+}
 
+// convertWorkloadToProto converts an internal Workload to protobuf Workload
+func convertWorkloadToProto(w *workload.Workload) *weaver.Workload {
+	result := &weaver.Workload{
+		Id:          w.ID,
+		Name:        w.Name,
+		Namespace:   w.Namespace,
+		Labels:      w.Labels,
+		Annotations: w.Annotations,
+		Spec:        convertWorkloadSpecToProto(&w.Spec),
+		Status:      convertWorkloadStatus(&w.Status),
+		Finalizers:  w.Finalizers,
+		CreatedAt:   timestamppb.New(w.CreatedAt),
+		UpdatedAt:   timestamppb.New(w.UpdatedAt),
+	}
+	if w.DeletedAt != nil {
+		result.DeletedAt = timestamppb.New(*w.DeletedAt)
+	}
 	return result
 }
 
@@ -134,6 +266,9 @@ func convertWorkloadSpecToProto(spec *workload.Spec) *weaver.WorkloadSpec {
 		Args:          spec.Args,
 		Env:           spec.Env,
 		RestartPolicy: string(spec.Restart),
+		Priority:      spec.Priority,
+		Kind:          string(spec.Kind),
+		Job:           convertJobSpecToProto(spec.Job),
 	}
 
 	result.Resources = &weaver.ResourceRequests{
@@ -148,6 +283,8 @@ func convertWorkloadSpecToProto(spec *workload.Spec) *weaver.WorkloadSpec {
 			MountPath: volume.MountPath,
 			ReadOnly:  volume.ReadOnly,
 			ContentId: volume.ContentID,
+			Size:      volume.Size,
+			Secret:    convertSecretReferenceToProto(volume.Secret),
 		})
 	}
 
@@ -169,27 +306,360 @@ func convertWorkloadSpecToProto(spec *workload.Spec) *weaver.WorkloadSpec {
 		})
 	}
 
-	if spec.Placement.Provider != "" || spec.Placement.Region != "" || spec.Placement.Zone != "" {
-		result.Placement = &weaver.PlacementSpec{
-			Provider:   spec.Placement.Provider,
-			Region:     spec.Placement.Region,
-			Zone:       spec.Placement.Zone,
-			NodeLabels: spec.Placement.NodeLabels,
+	result.Placement = convertPlacementSpecToProto(&spec.Placement)
+
+	for _, source := range spec.EnvFrom {
+		result.EnvFrom = append(result.EnvFrom, &weaver.EnvFromSource{
+			SecretRef: convertSecretReferenceToProto(source.SecretRef),
+			Prefix:    source.Prefix,
+		})
+	}
+
+	if len(spec.EnvValueFrom) > 0 {
+		result.EnvValueFrom = make(map[string]*weaver.EnvVarSource, len(spec.EnvValueFrom))
+		for name, source := range spec.EnvValueFrom {
+			result.EnvValueFrom[name] = &weaver.EnvVarSource{
+				SecretKeyRef: convertSecretReferenceToProto(source.SecretKeyRef),
+			}
+		}
+	}
+
+	return result
+}
+
+// convertPlacementSpecToProto converts internal PlacementSpec to protobuf PlacementSpec;
+// an empty placement is nil
+func convertPlacementSpecToProto(placement *workload.PlacementSpec) *weaver.PlacementSpec {
+	if placement.Provider == "" && placement.Region == "" && placement.Zone == "" &&
+		len(placement.NodeLabels) == 0 && len(placement.Tolerations) == 0 {
+		return nil
+	}
+
+	result := &weaver.PlacementSpec{
+		Provider:   placement.Provider,
+		Region:     placement.Region,
+		Zone:       placement.Zone,
+		NodeLabels: placement.NodeLabels,
+	}
+
+	for _, toleration := range placement.Tolerations {
+		result.Tolerations = append(result.Tolerations, &weaver.Toleration{
+			Key:      toleration.Key,
+			Operator: toleration.Operator,
+			Value:    toleration.Value,
+			Effect:   toleration.Effect,
+		})
+	}
+
+	return result
+}
+
+// convertNamespaceSpec converts protobuf NamespaceSpec to internal namespace Spec
+func convertNamespaceSpec(spec *weaver.NamespaceSpec) namespace.Spec {
+	if spec == nil {
+		return namespace.Spec{}
+	}
+
+	result := namespace.Spec{
+		DefaultPlacement: convertPlacementSpec(spec.DefaultPlacement),
+	}
+
+	if spec.Quotas != nil {
+		result.Quotas = namespace.ResourceQuotas{
+			MaxWorkloads: int(spec.Quotas.MaxWorkloads),
+			MaxCPU:       spec.Quotas.MaxCpu,
+			MaxMemory:    spec.Quotas.MaxMemory,
+			MaxGPU:       spec.Quotas.MaxGpu,
+			MaxStorage:   spec.Quotas.MaxStorage,
+		}
+	}
+
+	if spec.NetworkPolicy != nil {
+		result.NetworkPolicy = namespace.NetworkPolicy{
+			Isolation: namespace.NetworkIsolation(spec.NetworkPolicy.Isolation),
+			Ingress:   convertNetworkRules(spec.NetworkPolicy.Ingress),
+			Egress:    convertNetworkRules(spec.NetworkPolicy.Egress),
 		}
+	}
+
+	return result
+}
 
-		for _, toleration := range spec.Placement.Tolerations {
-			result.Placement.Tolerations = append(result.Placement.Tolerations, &weaver.Toleration{
-				Key:      toleration.Key,
-				Operator: toleration.Operator,
-				Value:    toleration.Value,
-				Effect:   toleration.Effect,
+// convertNetworkRules converts protobuf NetworkRules to internal network rules
+func convertNetworkRules(rules []*weaver.NetworkRule) []namespace.NetworkRule {
+	var result []namespace.NetworkRule
+	for _, rule := range rules {
+		converted := namespace.NetworkRule{
+			From:      convertNetworkPeers(rule.From),
+			To:        convertNetworkPeers(rule.To),
+			Protocols: rule.Protocols,
+		}
+		for _, port := range rule.Ports {
+			converted.Ports = append(converted.Ports, workload.Port{
+				Name:          port.Name,
+				ContainerPort: port.ContainerPort,
+				Protocol:      port.Protocol,
 			})
 		}
+		result = append(result, converted)
+	}
+	return result
+}
+
+// convertNetworkPeers converts protobuf NetworkPeers to internal network peers
+func convertNetworkPeers(peers []*weaver.NetworkPeer) []namespace.NetworkPeer {
+	var result []namespace.NetworkPeer
+	for _, peer := range peers {
+		converted := namespace.NetworkPeer{
+			NamespaceSelector: peer.NamespaceSelector,
+			WorkloadSelector:  peer.WorkloadSelector,
+		}
+		if peer.IpBlock != nil {
+			converted.IPBlock = &namespace.IPBlock{
+				CIDR:   peer.IpBlock.Cidr,
+				Except: peer.IpBlock.Except,
+			}
+		}
+		result = append(result, converted)
+	}
+	return result
+}
+
+// convertNamespaceToProto converts an internal Namespace to protobuf Namespace
+func convertNamespaceToProto(ns *namespace.Namespace) *weaver.Namespace {
+	return &weaver.Namespace{
+		Id:          ns.ID,
+		Name:        ns.Name,
+		Labels:      ns.Labels,
+		Annotations: ns.Annotations,
+		Spec: &weaver.NamespaceSpec{
+			Quotas: &weaver.ResourceQuotas{
+				MaxWorkloads: int32(ns.Spec.Quotas.MaxWorkloads), // nolint:gosec
+				MaxCpu:       ns.Spec.Quotas.MaxCPU,
+				MaxMemory:    ns.Spec.Quotas.MaxMemory,
+				MaxGpu:       ns.Spec.Quotas.MaxGPU,
+				MaxStorage:   ns.Spec.Quotas.MaxStorage,
+			},
+			NetworkPolicy: &weaver.NetworkPolicy{
+				Isolation: string(ns.Spec.NetworkPolicy.Isolation),
+				Ingress:   convertNetworkRulesToProto(ns.Spec.NetworkPolicy.Ingress),
+				Egress:    convertNetworkRulesToProto(ns.Spec.NetworkPolicy.Egress),
+			},
+			DefaultPlacement: convertPlacementSpecToProto(&ns.Spec.DefaultPlacement),
+		},
+		Status: &weaver.NamespaceStatus{
+			Phase:   string(ns.Status.Phase),
+			Message: ns.Status.Message,
+			Reason:  ns.Status.Reason,
+			Usage: &weaver.ResourceUsage{
+				Workloads: int32(ns.Status.Usage.Workloads), // nolint:gosec
+				Cpu:       ns.Status.Usage.CPU,
+				Memory:    ns.Status.Usage.Memory,
+				Gpu:       ns.Status.Usage.GPU,
+				Storage:   ns.Status.Usage.Storage,
+			},
+			TailscaleTag: ns.Status.TailscaleTag,
+		},
+		CreatedAt: timestamppb.New(ns.CreatedAt),
+		UpdatedAt: timestamppb.New(ns.UpdatedAt),
+	}
+}
+
+// convertNetworkRulesToProto converts internal network rules to protobuf NetworkRules
+func convertNetworkRulesToProto(rules []namespace.NetworkRule) []*weaver.NetworkRule {
+	var result []*weaver.NetworkRule
+	for _, rule := range rules {
+		converted := &weaver.NetworkRule{
+			From:      convertNetworkPeersToProto(rule.From),
+			To:        convertNetworkPeersToProto(rule.To),
+			Protocols: rule.Protocols,
+		}
+		for _, port := range rule.Ports {
+			converted.Ports = append(converted.Ports, &weaver.Port{
+				Name:          port.Name,
+				ContainerPort: port.ContainerPort,
+				Protocol:      port.Protocol,
+			})
+		}
+		result = append(result, converted)
+	}
+	return result
+}
+
+// convertNetworkPeersToProto converts internal network peers to protobuf NetworkPeers
+func convertNetworkPeersToProto(peers []namespace.NetworkPeer) []*weaver.NetworkPeer {
+	var result []*weaver.NetworkPeer
+	for _, peer := range peers {
+		converted := &weaver.NetworkPeer{
+			NamespaceSelector: peer.NamespaceSelector,
+			WorkloadSelector:  peer.WorkloadSelector,
+		}
+		if peer.IPBlock != nil {
+			converted.IpBlock = &weaver.IPBlock{
+				Cidr:   peer.IPBlock.CIDR,
+				Except: peer.IPBlock.Except,
+			}
+		}
+		result = append(result, converted)
+	}
+	return result
+}
+
+// convertExternalSecretRef converts protobuf ExternalSecretRef to an internal secret reference
+func convertExternalSecretRef(ref *weaver.ExternalSecretRef) *secret.ExternalSecretRef {
+	if ref == nil {
+		return nil
+	}
+
+	return &secret.ExternalSecretRef{
+		Provider: ref.Provider,
+		Path:     ref.Path,
+		Version:  ref.Version,
+		Auth:     ref.Auth,
+	}
+}
+
+// convertSecretToProto converts an internal Secret to protobuf Secret. Data is only
+// included when the secret was revealed.
+func convertSecretToProto(s *secret.Secret, revealed bool) *weaver.Secret {
+	result := &weaver.Secret{
+		Id:        s.ID,
+		Name:      s.Name,
+		Namespace: s.Namespace,
+		Type:      string(s.Spec.Type),
+		Status: &weaver.SecretStatus{
+			Phase:       string(s.Status.Phase),
+			Message:     s.Status.Message,
+			Reason:      s.Status.Reason,
+			SyncError:   s.Status.SyncError,
+			SyncVersion: s.Status.SyncVersion,
+		},
+		CreatedAt: timestamppb.New(s.CreatedAt),
+		UpdatedAt: timestamppb.New(s.UpdatedAt),
+		Redacted:  !revealed,
+	}
+
+	for key := range s.Spec.Data {
+		result.Keys = append(result.Keys, key)
+	}
+	sort.Strings(result.Keys)
+
+	if revealed {
+		result.Data = s.Spec.Data
+	}
+
+	if ref := s.Spec.ExternalRef; ref != nil {
+		result.ExternalRef = &weaver.ExternalSecretRef{
+			Provider: ref.Provider,
+			Path:     ref.Path,
+			Version:  ref.Version,
+			Auth:     ref.Auth,
+		}
+	}
+
+	if s.Status.LastSync != nil {
+		result.Status.LastSync = timestamppb.New(*s.Status.LastSync)
 	}
 
 	return result
 }
 
+// convertCronWorkloadSpec converts protobuf CronWorkloadSpec to an internal cron workload spec
+func convertCronWorkloadSpec(spec *weaver.CronWorkloadSpec) cron.Spec {
+	if spec == nil {
+		return cron.Spec{}
+	}
+
+	result := cron.Spec{
+		Schedule:                   spec.Schedule,
+		TimeZone:                   spec.TimeZone,
+		ConcurrencyPolicy:          cron.ConcurrencyPolicy(spec.ConcurrencyPolicy),
+		StartingDeadlineSeconds:    spec.StartingDeadlineSeconds,
+		SuccessfulRunsHistoryLimit: spec.SuccessfulRunsHistoryLimit,
+		FailedRunsHistoryLimit:     spec.FailedRunsHistoryLimit,
+		Suspend:                    spec.Suspend,
+	}
+
+	if template := spec.Template; template != nil {
+		result.Template = cron.Template{
+			Labels:      template.Labels,
+			Annotations: template.Annotations,
+			Spec:        convertWorkloadSpec(template.Spec),
+		}
+	}
+
+	return result
+}
+
+// convertCronWorkloadToProto converts an internal cron workload to protobuf CronWorkload
+func convertCronWorkloadToProto(cw *cron.CronWorkload) *weaver.CronWorkload {
+	result := &weaver.CronWorkload{
+		Id:          cw.ID,
+		Name:        cw.Name,
+		Namespace:   cw.Namespace,
+		Labels:      cw.Labels,
+		Annotations: cw.Annotations,
+		Spec: &weaver.CronWorkloadSpec{
+			Schedule: cw.Spec.Schedule,
+			TimeZone: cw.Spec.TimeZone,
+			Template: &weaver.WorkloadTemplate{
+				Labels:      cw.Spec.Template.Labels,
+				Annotations: cw.Spec.Template.Annotations,
+				Spec:        convertWorkloadSpecToProto(&cw.Spec.Template.Spec),
+			},
+			ConcurrencyPolicy:          string(cw.Spec.ConcurrencyPolicy),
+			StartingDeadlineSeconds:    cw.Spec.StartingDeadlineSeconds,
+			SuccessfulRunsHistoryLimit: cw.Spec.SuccessfulRunsHistoryLimit,
+			FailedRunsHistoryLimit:     cw.Spec.FailedRunsHistoryLimit,
+			Suspend:                    cw.Spec.Suspend,
+		},
+		Status: &weaver.CronWorkloadStatus{
+			Active: cw.Status.Active,
+		},
+		CreatedAt: timestamppb.New(cw.CreatedAt),
+		UpdatedAt: timestamppb.New(cw.UpdatedAt),
+	}
+
+	if cw.Status.LastScheduleTime != nil {
+		result.Status.LastScheduleTime = timestamppb.New(*cw.Status.LastScheduleTime)
+	}
+	if cw.Status.LastSuccessfulTime != nil {
+		result.Status.LastSuccessfulTime = timestamppb.New(*cw.Status.LastSuccessfulTime)
+	}
+	if cw.Status.NextScheduleTime != nil {
+		result.Status.NextScheduleTime = timestamppb.New(*cw.Status.NextScheduleTime)
+	}
+
+	return result
+}
+
+// convertNodeResources converts protobuf NodeResources to node resources
+func convertNodeResources(resources *weaver.NodeResources) node.Resources {
+	if resources == nil {
+		return node.Resources{}
+	}
+
+	return node.Resources{
+		CPU:    resources.Cpu,
+		Memory: resources.Memory,
+		GPU:    resources.Gpu,
+		Disk:   resources.Disk,
+	}
+}
+
+// convertNodeTaints converts protobuf NodeTaints to node taints
+func convertNodeTaints(taints []*weaver.NodeTaint) []node.Taint {
+	result := make([]node.Taint, 0, len(taints))
+	for _, taint := range taints {
+		result = append(result, node.Taint{
+			Key:    taint.Key,
+			Value:  taint.Value,
+			Effect: taint.Effect,
+		})
+	}
+	return result
+}
+
 // convertProviderStats converts scheduler provider stats to protobuf format
 func convertProviderStats(providerStats map[string]*scheduler.ProviderStats) map[string]int32 {
 	result := make(map[string]int32)
@@ -211,3 +681,64 @@ func calculateTotalCost(providerStats map[string]*scheduler.ProviderStats) float
 	}
 	return totalCost
 }
+
+// convertCandidateEvaluation converts a scheduler candidate evaluation to protobuf
+func convertCandidateEvaluation(e *scheduler.CandidateEvaluation) *weaver.SchedulingCandidate {
+	candidate := &weaver.SchedulingCandidate{
+		Provider:    e.Provider,
+		Region:      e.Region,
+		MachineType: e.MachineType,
+		NodeId:      e.NodeID,
+		Feasible:    e.Feasible,
+		FilteredBy:  e.FilteredBy,
+		Reason:      e.Reason,
+		Score:       e.Score,
+	}
+
+	for _, component := range e.Scores {
+		candidate.Scores = append(candidate.Scores, &weaver.ScoreComponent{
+			Plugin: component.Plugin,
+			Score:  component.Score,
+			Weight: component.Weight,
+			Reason: component.Reason,
+		})
+	}
+
+	if e.EstimatedCost != nil {
+		candidate.CostPerHour = e.EstimatedCost.HourlyCost
+		candidate.CostConfidence = e.EstimatedCost.Confidence
+		candidate.CostAssumptions = e.EstimatedCost.Assumptions
+	}
+
+	return candidate
+}
+
+// convertTokenToProto converts an API token to protobuf without its hash
+func convertTokenToProto(t *auth.Token) *weaver.Token {
+	result := &weaver.Token{
+		Id:        t.ID,
+		Name:      t.Name,
+		Principal: t.Principal,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+	}
+
+	if t.ExpiresAt != nil {
+		result.ExpiresAt = timestamppb.New(*t.ExpiresAt)
+	}
+	if t.RevokedAt != nil {
+		result.RevokedAt = timestamppb.New(*t.RevokedAt)
+	}
+
+	return result
+}
+
+// convertRoleBindingToProto converts a role binding to protobuf
+func convertRoleBindingToProto(b *auth.RoleBinding) *weaver.RoleBinding {
+	return &weaver.RoleBinding{
+		Id:        b.ID,
+		Principal: b.Principal,
+		Namespace: b.Namespace,
+		Role:      string(b.Role),
+		CreatedAt: timestamppb.New(b.CreatedAt),
+	}
+}
@@ -2,56 +2,95 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/codecflow/fabric/pkg/proto/weaver"
 	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/finalizer"
+	"github.com/codecflow/fabric/weaver/internal/namespace"
+	"github.com/codecflow/fabric/weaver/internal/repository"
 	"github.com/codecflow/fabric/weaver/internal/state"
-	"github.com/codecflow/fabric/weaver/weaver/proto/weaver"
+	"github.com/codecflow/fabric/weaver/internal/watch"
+	"github.com/codecflow/fabric/weaver/services/provider"
+	"github.com/codecflow/fabric/weaver/services/scheduler"
 )
 
 type WorkloadHandler struct {
 	appState *state.State
 	logger   *logrus.Logger
+
+	finalizers *finalizer.Runner
 }
 
 func NewWorkloadHandler(appState *state.State, logger *logrus.Logger) *WorkloadHandler {
 	return &WorkloadHandler{
-		appState: appState,
-		logger:   logger,
+		appState:   appState,
+		logger:     logger,
+		finalizers: finalizer.New(appState, logger),
 	}
 }
 
 func (h *WorkloadHandler) Create(ctx context.Context, req *weaver.CreateWorkloadRequest) (*weaver.CreateWorkloadResponse, error) {
-	now := time.Now()
-
 	w := &workload.Workload{
-		ID:          generateID(),
 		Name:        req.Name,
 		Namespace:   req.Namespace,
 		Labels:      req.Labels,
 		Annotations: req.Annotations,
 		Spec:        convertWorkloadSpec(req.Spec),
-		Status: workload.Status{
-			Phase: workload.PhasePending,
-		},
-		CreatedAt: now,
-		UpdatedAt: now,
 	}
 
-	if err := h.appState.Repository.Workload.Create(ctx, w); err != nil {
-		return nil, fmt.Errorf("failed to store workload: %v", err)
+	if err := h.Submit(ctx, w); err != nil {
+		return nil, err
+	}
+
+	return &weaver.CreateWorkloadResponse{
+		Id:        w.ID,
+		Name:      w.Name,
+		Namespace: w.Namespace,
+		Status:    convertWorkloadStatus(&w.Status),
+		CreatedAt: timestamppb.New(w.CreatedAt),
+	}, nil
+}
+
+// Submit validates, admits and schedules a new workload. Workloads that
+// cannot be scheduled yet are left pending in the scheduling queue. Weaver
+// submits the workloads it creates itself, such as the runs of cron
+// workloads, the same way as CreateWorkload.
+func (h *WorkloadHandler) Submit(ctx context.Context, w *workload.Workload) error {
+	w.Spec.Default()
+	if err := w.Spec.Validate(); err != nil {
+		return fmt.Errorf("invalid workload spec: %v", err)
+	}
+
+	if w.Namespace == "" {
+		w.Namespace = DefaultNamespace
+	}
+
+	now := time.Now()
+	w.ID = generateID()
+	w.Status = workload.Status{
+		Phase: workload.PhasePending,
+	}
+	w.CreatedAt = now
+	w.UpdatedAt = now
+
+	if err := h.admit(ctx, w); err != nil {
+		return err
 	}
 
 	// Schedule workload
 	if h.appState.Scheduler != nil {
 		placement, err := h.appState.Scheduler.Schedule(ctx, w)
 		if err != nil {
-			return nil, fmt.Errorf("failed to schedule workload: %v", err)
+			h.queue(ctx, w, err)
+			return nil
 		}
 
 		w.Status.Provider = placement.Provider
@@ -74,19 +113,99 @@ func (h *WorkloadHandler) Create(ctx context.Context, req *weaver.CreateWorkload
 
 			if endpoint != "" {
 				if err := h.appState.Proxy.AddRoute(w, endpoint); err != nil {
-					return nil, fmt.Errorf("failed to add proxy route: %v", err)
+					return fmt.Errorf("failed to add proxy route: %v", err)
 				}
 			}
 		}
 	}
 
-	return &weaver.CreateWorkloadResponse{
-		Id:        w.ID,
-		Name:      w.Name,
-		Namespace: w.Namespace,
-		Status:    convertWorkloadStatus(&w.Status),
-		CreatedAt: timestamppb.New(w.CreatedAt),
-	}, nil
+	return nil
+}
+
+// admit applies the namespace's default placement to a workload, checks it
+// against the namespace's quotas and stores it. The namespace stays locked in
+// the database until the workload is stored, so concurrent admissions on any
+// replica cannot exceed the quotas together.
+func (h *WorkloadHandler) admit(ctx context.Context, w *workload.Workload) error {
+	if h.appState.Repository.Namespace == nil {
+		if err := h.appState.Repository.Workload.Create(ctx, w); err != nil {
+			return fmt.Errorf("failed to store workload: %v", err)
+		}
+		return nil
+	}
+
+	// Quota errors are returned to the caller as is
+	var rejected error
+	err := h.appState.Repository.Namespace.Admit(ctx, w, func(ns *namespace.Namespace, counted []*workload.Workload) error {
+		rejected = admitTo(ns, counted, w)
+		return rejected
+	})
+	if rejected != nil {
+		return rejected
+	}
+	if errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("namespace %s not found", w.Namespace)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to store workload: %v", err)
+	}
+
+	return nil
+}
+
+// admitTo checks a workload against its namespace's quotas and records the
+// namespace's usage including it
+func admitTo(ns *namespace.Namespace, counted []*workload.Workload, w *workload.Workload) error {
+	if ns.Status.Phase == namespace.PhaseTerminating {
+		return fmt.Errorf("namespace %s is terminating", ns.Name)
+	}
+
+	ns.Spec.ApplyDefaultPlacement(&w.Spec.Placement)
+
+	requests, err := w.Spec.Resources.Quantity()
+	if err != nil {
+		return fmt.Errorf("invalid workload spec: %v", err)
+	}
+
+	count, used := namespace.Usage(counted)
+	count, used = count+1, used.Add(requests)
+	if err := ns.Spec.Quotas.Admit(count, used); err != nil {
+		return fmt.Errorf("workload rejected by namespace %s: %v", ns.Name, err)
+	}
+
+	ns.Status.Usage = namespace.NewResourceUsage(count, used)
+	return nil
+}
+
+// refreshUsage recomputes the usage of a workload's namespace after it changed
+func (h *WorkloadHandler) refreshUsage(ctx context.Context, name string) {
+	if h.appState.Repository.Namespace == nil {
+		return
+	}
+
+	if err := namespace.RefreshUsage(ctx, h.appState.Repository.Namespace, h.appState.Repository.Workload, name); err != nil {
+		h.logger.Warnf("Failed to refresh usage of namespace %s: %v", name, err)
+	}
+}
+
+// queue leaves a workload that could not be scheduled pending in the scheduling
+// queue, where the workload controller retries it
+func (h *WorkloadHandler) queue(ctx context.Context, w *workload.Workload, err error) {
+	w.Status.Reason = "SchedulingFailed"
+	if errors.Is(err, scheduler.ErrUnschedulable) {
+		w.Status.Reason = workload.ReasonUnschedulable
+	}
+	w.Status.Message = err.Error()
+
+	if err := h.appState.Repository.Workload.Update(ctx, w); err != nil {
+		h.logger.Warnf("Failed to update workload with scheduling error: %v", err)
+	}
+
+	if h.appState.Queue != nil {
+		h.appState.Queue.Add(w)
+	}
+
+	h.logger.Infof("Queued workload %s/%s: %v", w.Namespace, w.Name, err)
 }
 
 func (h *WorkloadHandler) Get(ctx context.Context, req *weaver.GetWorkloadRequest) (*weaver.GetWorkloadResponse, error) {
@@ -99,19 +218,7 @@ func (h *WorkloadHandler) Get(ctx context.Context, req *weaver.GetWorkloadReques
 		return nil, fmt.Errorf("failed to get workload: %v", err)
 	}
 
-	return &weaver.GetWorkloadResponse{
-		Workload: &weaver.Workload{
-			Id:          w.ID,
-			Name:        w.Name,
-			Namespace:   w.Namespace,
-			Labels:      w.Labels,
-			Annotations: w.Annotations,
-			Spec:        convertWorkloadSpecToProto(&w.Spec),
-			Status:      convertWorkloadStatus(&w.Status),
-			CreatedAt:   timestamppb.New(w.CreatedAt),
-			UpdatedAt:   timestamppb.New(w.UpdatedAt),
-		},
-	}, nil
+	return &weaver.GetWorkloadResponse{Workload: convertWorkloadToProto(w)}, nil
 }
 
 func (h *WorkloadHandler) List(ctx context.Context, req *weaver.ListWorkloadsRequest) (*weaver.ListWorkloadsResponse, error) {
@@ -119,32 +226,174 @@ func (h *WorkloadHandler) List(ctx context.Context, req *weaver.ListWorkloadsReq
 		return nil, fmt.Errorf("workload repository not available")
 	}
 
-	workloads, err := h.appState.Repository.Workload.List(ctx, req.Namespace, req.LabelSelector)
+	if req.Limit < 0 {
+		return nil, fmt.Errorf("limit must not be negative")
+	}
+
+	namespaceName := req.Namespace
+	if namespaceName == "" {
+		namespaceName = DefaultNamespace
+	}
+
+	filter, err := workloadFilter(namespaceName, req.LabelSelector, req.Selector, req.FieldSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	workloads, continueToken, err := h.appState.Repository.Workload.ListPage(ctx, filter, int(req.Limit), req.ContinueToken)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list workloads: %v", err)
 	}
 
 	var protoWorkloads []*weaver.Workload
 	for _, w := range workloads {
-		protoWorkloads = append(protoWorkloads, &weaver.Workload{
-			Id:          w.ID,
-			Name:        w.Name,
-			Namespace:   w.Namespace,
-			Labels:      w.Labels,
-			Annotations: w.Annotations,
-			Spec:        convertWorkloadSpecToProto(&w.Spec),
-			Status:      convertWorkloadStatus(&w.Status),
-			CreatedAt:   timestamppb.New(w.CreatedAt),
-			UpdatedAt:   timestamppb.New(w.UpdatedAt),
-		})
+		protoWorkloads = append(protoWorkloads, convertWorkloadToProto(w))
 	}
 
 	return &weaver.ListWorkloadsResponse{
-		Workloads: protoWorkloads,
-		Total:     int32(len(protoWorkloads)), // nolint:gosec
+		Workloads:     protoWorkloads,
+		ContinueToken: continueToken,
+		Total:         int32(len(protoWorkloads)), // nolint:gosec
 	}, nil
 }
 
+// workloadFilter combines the label map, label selector and field selector of
+// a list or watch request into a filter
+func workloadFilter(namespaceName string, labels map[string]string, selector, fieldSelector string) (workload.Filter, error) {
+	filter := workload.Filter{
+		Namespace: namespaceName,
+		Labels:    workload.SelectorFromMap(labels),
+	}
+
+	parsed, err := workload.ParseSelector(selector)
+	if err != nil {
+		return filter, fmt.Errorf("invalid selector: %v", err)
+	}
+	filter.Labels = append(filter.Labels, parsed...)
+
+	filter.Fields, err = workload.ParseFieldSelector(fieldSelector)
+	if err != nil {
+		return filter, fmt.Errorf("invalid field selector: %v", err)
+	}
+
+	return filter, nil
+}
+
+// Watch streams workload changes. Without a resource version it starts with the
+// matching workloads as ADDED events; with one it replays the changes since.
+func (h *WorkloadHandler) Watch(req *weaver.WatchWorkloadsRequest, srv grpc.ServerStreamingServer[weaver.WorkloadEvent]) error {
+	if h.appState.Watch == nil || h.appState.Repository.Workload == nil {
+		return fmt.Errorf("workload watch not available")
+	}
+
+	ctx := srv.Context()
+	namespaceName := req.Namespace
+	if namespaceName == "" {
+		namespaceName = DefaultNamespace
+	}
+
+	filter, err := workloadFilter(namespaceName, req.LabelSelector, req.Selector, req.FieldSelector)
+	if err != nil {
+		return err
+	}
+
+	backlog, sub, err := h.appState.Watch.Subscribe(req.ResourceVersion)
+	if errors.Is(err, watch.ErrExpired) {
+		return fmt.Errorf("resource version %d is too old, watch again without one", req.ResourceVersion)
+	} else if err != nil {
+		return fmt.Errorf("failed to watch workloads: %v", err)
+	}
+	defer h.appState.Watch.Unsubscribe(sub)
+
+	send := func(event watch.Event) error {
+		if !filter.Matches(event.Workload) {
+			return nil
+		}
+		return srv.Send(&weaver.WorkloadEvent{
+			Type:            string(event.Type),
+			Workload:        convertWorkloadToProto(event.Workload),
+			ResourceVersion: event.Version,
+		})
+	}
+
+	if req.ResourceVersion == 0 {
+		// Changes made while listing are delivered after the snapshot
+		workloads, err := h.appState.Repository.Workload.List(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to list workloads: %v", err)
+		}
+		for _, w := range workloads {
+			backlog = append(backlog, watch.Event{Type: watch.Added, Version: sub.Version, Workload: w})
+		}
+	}
+
+	last := req.ResourceVersion
+	for _, event := range backlog {
+		if err := send(event); err != nil {
+			return fmt.Errorf("failed to send event: %v", err)
+		}
+		last = event.Version
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				return fmt.Errorf("watch fell behind, resume from resource version %d", last)
+			}
+			if err := send(event); err != nil {
+				return fmt.Errorf("failed to send event: %v", err)
+			}
+			last = event.Version
+		}
+	}
+}
+
+// Logs streams the logs of a workload container from its provider
+func (h *WorkloadHandler) Logs(req *weaver.StreamWorkloadLogsRequest, srv grpc.ServerStreamingServer[weaver.WorkloadLogEntry]) error {
+	ctx := srv.Context()
+	w, p, err := h.provisionedWorkload(ctx, req.Id)
+	if err != nil {
+		return err
+	}
+	logs, ok := p.(provider.LogProvider)
+	if !ok {
+		return fmt.Errorf("provider %s does not support logs", w.Status.Provider)
+	}
+
+	container, err := provider.ResolveContainer(w, req.Container)
+	if err != nil {
+		return err
+	}
+
+	opts := provider.LogOptions{
+		Container: container,
+		Follow:    req.Follow,
+		TailLines: req.TailLines,
+	}
+	if req.Since != nil {
+		opts.Since = req.Since.AsTime()
+	}
+
+	err = logs.StreamLogs(ctx, w, opts, func(line provider.LogLine) error {
+		entry := &weaver.WorkloadLogEntry{Line: line.Text, Stream: line.Stream}
+		if !line.Time.IsZero() {
+			entry.Timestamp = timestamppb.New(line.Time)
+		}
+		return srv.Send(entry)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stream logs: %v", err)
+	}
+
+	return nil
+}
+
+// Delete marks a workload as terminating and runs its finalizers. Finalizers
+// that fail are retried by the workload controller until the workload can be
+// removed; force removes it right away without tearing anything down.
 func (h *WorkloadHandler) Delete(ctx context.Context, req *weaver.DeleteWorkloadRequest) (*emptypb.Empty, error) {
 	if h.appState.Repository.Workload == nil {
 		return nil, fmt.Errorf("workload repository not available")
@@ -155,13 +404,21 @@ func (h *WorkloadHandler) Delete(ctx context.Context, req *weaver.DeleteWorkload
 		return nil, fmt.Errorf("failed to get workload: %v", err)
 	}
 
-	if h.appState.Proxy != nil {
-		h.appState.Proxy.RemoveRoute(w)
+	if req.Force {
+		h.logger.Warnf("Force deleting workload %s/%s, finalizers %v are skipped", w.Namespace, w.Name, w.Finalizers)
+		if err := h.finalizers.Remove(ctx, w); err != nil {
+			return nil, fmt.Errorf("failed to delete workload: %v", err)
+		}
+		return &emptypb.Empty{}, nil
 	}
 
-	if err := h.appState.Repository.Workload.Delete(ctx, req.Id); err != nil {
+	if err := h.finalizers.Start(ctx, w); err != nil {
 		return nil, fmt.Errorf("failed to delete workload: %v", err)
 	}
 
+	if err := h.finalizers.Run(ctx, w); err != nil {
+		h.logger.Warnf("Workload %s/%s is terminating, finalization will be retried: %v", w.Namespace, w.Name, err)
+	}
+
 	return &emptypb.Empty{}, nil
 }
@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+// http2Preface starts every HTTP/2 connection. gRPC clients speak HTTP/2
+// without TLS, so anything else is HTTP/1 for the gateway.
+var http2Preface = []byte("PRI * HTTP/2.0")
+
+// prefaceTimeout bounds the wait for a new connection's first bytes
+const prefaceTimeout = 10 * time.Second
+
+// splitListener serves gRPC and the HTTP gateway on a single port by routing
+// each accepted connection on its first bytes
+type splitListener struct {
+	base net.Listener
+	grpc *connListener
+	http *connListener
+}
+
+func newSplitListener(base net.Listener) *splitListener {
+	l := &splitListener{base: base}
+	l.grpc = newConnListener(base.Addr())
+	l.http = newConnListener(base.Addr())
+	return l
+}
+
+// serve accepts connections until the base listener is closed
+func (l *splitListener) serve() {
+	defer l.grpc.Close()
+	defer l.http.Close()
+
+	for {
+		conn, err := l.base.Accept()
+		if err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				continue
+			}
+			return
+		}
+		go l.route(conn)
+	}
+}
+
+func (l *splitListener) route(conn net.Conn) {
+	reader := bufio.NewReader(conn)
+
+	_ = conn.SetReadDeadline(time.Now().Add(prefaceTimeout))
+	peek, err := reader.Peek(len(http2Preface))
+	_ = conn.SetReadDeadline(time.Time{})
+	if err != nil && len(peek) == 0 {
+		conn.Close()
+		return
+	}
+
+	buffered := &bufferedConn{Conn: conn, reader: reader}
+	if bytes.Equal(peek, http2Preface) {
+		l.grpc.deliver(buffered)
+	} else {
+		l.http.deliver(buffered)
+	}
+}
+
+func (l *splitListener) Close() error {
+	return l.base.Close()
+}
+
+// connListener is a net.Listener fed with connections routed to it
+type connListener struct {
+	addr  net.Addr
+	conns chan net.Conn
+	done  chan struct{}
+	once  sync.Once
+}
+
+func newConnListener(addr net.Addr) *connListener {
+	return &connListener{
+		addr:  addr,
+		conns: make(chan net.Conn),
+		done:  make(chan struct{}),
+	}
+}
+
+func (l *connListener) deliver(conn net.Conn) {
+	select {
+	case l.conns <- conn:
+	case <-l.done:
+		conn.Close()
+	}
+}
+
+func (l *connListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.done:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *connListener) Close() error {
+	l.once.Do(func() { close(l.done) })
+	return nil
+}
+
+func (l *connListener) Addr() net.Addr {
+	return l.addr
+}
+
+// bufferedConn replays the bytes peeked while routing a connection
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(p []byte) (int, error) {
+	return c.reader.Read(p)
+}
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/codecflow/fabric/pkg/proto/weaver"
+	"github.com/codecflow/fabric/weaver/internal/grpc/handlers"
+)
+
+// NodeServer implements the gRPC node service used by Shuttle
+type NodeServer struct {
+	weaver.UnimplementedNodeServiceServer
+	node *handlers.NodeHandler
+}
+
+// Node management methods
+func (s *NodeServer) RegisterNode(ctx context.Context, req *weaver.RegisterNodeRequest) (*weaver.RegisterNodeResponse, error) {
+	return s.node.Register(ctx, req)
+}
+
+func (s *NodeServer) UnregisterNode(ctx context.Context, req *weaver.UnregisterNodeRequest) (*emptypb.Empty, error) {
+	return s.node.Unregister(ctx, req)
+}
+
+func (s *NodeServer) Heartbeat(ctx context.Context, req *weaver.HeartbeatRequest) (*weaver.HeartbeatResponse, error) {
+	return s.node.Heartbeat(ctx, req)
+}
+
+func (s *NodeServer) WatchAssignments(req *weaver.WatchAssignmentsRequest, srv grpc.ServerStreamingServer[weaver.WorkloadAssignments]) error {
+	return s.node.WatchAssignments(req, srv)
+}
+
+func (s *NodeServer) ReportWorkloadStatus(ctx context.Context, req *weaver.ReportWorkloadStatusRequest) (*emptypb.Empty, error) {
+	return s.node.ReportWorkloadStatus(ctx, req)
+}
@@ -2,8 +2,12 @@ package grpc
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -11,9 +15,11 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/codecflow/fabric/pkg/proto/weaver"
+	"github.com/codecflow/fabric/weaver/internal/gateway"
 	"github.com/codecflow/fabric/weaver/internal/grpc/handlers"
+	"github.com/codecflow/fabric/weaver/internal/metrics"
 	"github.com/codecflow/fabric/weaver/internal/state"
-	"github.com/codecflow/fabric/weaver/weaver/proto/weaver"
 )
 
 // Server implements the gRPC server for Weaver
@@ -22,11 +28,20 @@ type Server struct {
 	appState *state.State
 	logger   *logrus.Logger
 	server   *grpc.Server
+	http     *http.Server
+	listener *splitListener
 
 	// Handlers
 	workload  *handlers.WorkloadHandler
+	namespace *handlers.NamespaceHandler
+	secret    *handlers.SecretHandler
+	cron      *handlers.CronWorkloadHandler
 	provider  *handlers.ProviderHandler
 	scheduler *handlers.SchedulerHandler
+	auth      *handlers.AuthHandler
+
+	// Node service
+	nodes *NodeServer
 }
 
 // NewServer creates a new gRPC server instance
@@ -35,27 +50,115 @@ func NewServer(appState *state.State, logger *logrus.Logger) *Server {
 		appState:  appState,
 		logger:    logger,
 		workload:  handlers.NewWorkloadHandler(appState, logger),
+		namespace: handlers.NewNamespaceHandler(appState, logger),
+		secret:    handlers.NewSecretHandler(appState, logger),
+		cron:      handlers.NewCronWorkloadHandler(appState, logger),
 		provider:  handlers.NewProviderHandler(appState, logger),
 		scheduler: handlers.NewSchedulerHandler(appState, logger),
+		auth:      handlers.NewAuthHandler(appState, logger),
+		nodes:     &NodeServer{node: handlers.NewNodeHandler(appState, logger)},
 	}
 }
 
-// Start starts the gRPC server
-func (s *Server) Start(address string) error {
+// Workloads returns the handler that admits and schedules new workloads, for
+// Weaver components that create workloads themselves
+func (s *Server) Workloads() *handlers.WorkloadHandler {
+	return s.workload
+}
+
+// Start starts the gRPC server and, on the same address, the HTTP/JSON gateway.
+// With a TLS config both are served over TLS and client certificates verified
+// by it authenticate callers.
+func (s *Server) Start(address string, tlsConfig *tls.Config) error {
 	lis, err := net.Listen("tcp", address)
 	if err != nil {
 		return fmt.Errorf("failed to listen: %v", err)
 	}
 
-	s.server = grpc.NewServer()
+	gw, err := gateway.New(s, s.logger, s.authorizeUnary, s.authorizeStream)
+	if err != nil {
+		lis.Close()
+		return fmt.Errorf("failed to create HTTP gateway: %v", err)
+	}
+
+	if s.appState.Auth == nil {
+		s.logger.Warn("API authentication is disabled")
+	}
+
+	s.server = grpc.NewServer(
+		grpc.UnaryInterceptor(s.authorizeUnary),
+		grpc.StreamInterceptor(s.authorizeStream),
+	)
 	weaver.RegisterWeaverServiceServer(s.server, s)
+	weaver.RegisterNodeServiceServer(s.server, s.nodes)
+
+	// Metrics are served next to the gateway for scrapers
+	var handler http.Handler = gw
+	if s.appState.Metrics != nil {
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == metrics.Path && r.Method == http.MethodGet {
+				s.appState.Metrics.ServeHTTP(w, r)
+				return
+			}
+			gw.ServeHTTP(w, r)
+		})
+	}
 
-	s.logger.Infof("Starting gRPC server on %s", address)
-	return s.server.Serve(lis)
+	// No write timeout: streaming RPCs are served as long-lived event streams
+	s.http = &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	if tlsConfig != nil {
+		// gRPC and the gateway share the HTTPS server, told apart by content type
+		s.http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+				s.server.ServeHTTP(w, r)
+				return
+			}
+			handler.ServeHTTP(w, r)
+		})
+
+		tlsConfig = tlsConfig.Clone()
+		tlsConfig.NextProtos = []string{"h2", "http/1.1"}
+
+		s.logger.Infof("Starting gRPC server and HTTP gateway with TLS on %s", address)
+		if err := s.http.Serve(tls.NewListener(lis, tlsConfig)); !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	}
+
+	s.listener = newSplitListener(lis)
+	go s.listener.serve()
+
+	go func() {
+		if err := s.http.Serve(s.listener.http); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Errorf("HTTP gateway error: %v", err)
+		}
+	}()
+
+	s.logger.Infof("Starting gRPC server and HTTP gateway on %s", address)
+	return s.server.Serve(s.listener.grpc)
 }
 
-// Stop gracefully stops the gRPC server
+// Stop gracefully stops the gRPC server and the HTTP gateway
 func (s *Server) Stop() {
+	if s.listener != nil {
+		s.listener.Close()
+	}
+
+	if s.http != nil {
+		s.logger.Info("Stopping HTTP gateway...")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		if err := s.http.Shutdown(ctx); err != nil {
+			// Event streams stay open until their clients leave
+			s.http.Close()
+		}
+		cancel()
+	}
+
 	if s.server != nil {
 		s.logger.Info("Stopping gRPC server...")
 		s.server.GracefulStop()
@@ -76,10 +179,97 @@ func (s *Server) ListWorkloads(ctx context.Context, req *weaver.ListWorkloadsReq
 	return s.workload.List(ctx, req)
 }
 
+func (s *Server) UpdateWorkload(ctx context.Context, req *weaver.UpdateWorkloadRequest) (*weaver.UpdateWorkloadResponse, error) {
+	return s.workload.Update(ctx, req)
+}
+
 func (s *Server) DeleteWorkload(ctx context.Context, req *weaver.DeleteWorkloadRequest) (*emptypb.Empty, error) {
 	return s.workload.Delete(ctx, req)
 }
 
+func (s *Server) WatchWorkloads(req *weaver.WatchWorkloadsRequest, srv grpc.ServerStreamingServer[weaver.WorkloadEvent]) error {
+	return s.workload.Watch(req, srv)
+}
+
+func (s *Server) StreamWorkloadLogs(req *weaver.StreamWorkloadLogsRequest, srv grpc.ServerStreamingServer[weaver.WorkloadLogEntry]) error {
+	return s.workload.Logs(req, srv)
+}
+
+func (s *Server) ExecWorkload(srv grpc.BidiStreamingServer[weaver.ExecRequest, weaver.ExecResponse]) error {
+	return s.workload.Exec(srv)
+}
+
+func (s *Server) PortForward(srv grpc.BidiStreamingServer[weaver.PortForwardRequest, weaver.PortForwardResponse]) error {
+	return s.workload.PortForward(srv)
+}
+
+// Namespace management methods
+func (s *Server) CreateNamespace(ctx context.Context, req *weaver.CreateNamespaceRequest) (*weaver.CreateNamespaceResponse, error) {
+	return s.namespace.Create(ctx, req)
+}
+
+func (s *Server) GetNamespace(ctx context.Context, req *weaver.GetNamespaceRequest) (*weaver.GetNamespaceResponse, error) {
+	return s.namespace.Get(ctx, req)
+}
+
+func (s *Server) ListNamespaces(ctx context.Context, req *weaver.ListNamespacesRequest) (*weaver.ListNamespacesResponse, error) {
+	return s.namespace.List(ctx, req)
+}
+
+func (s *Server) UpdateNamespace(ctx context.Context, req *weaver.UpdateNamespaceRequest) (*weaver.UpdateNamespaceResponse, error) {
+	return s.namespace.Update(ctx, req)
+}
+
+func (s *Server) DeleteNamespace(ctx context.Context, req *weaver.DeleteNamespaceRequest) (*emptypb.Empty, error) {
+	return s.namespace.Delete(ctx, req)
+}
+
+// Secret management methods
+func (s *Server) CreateSecret(ctx context.Context, req *weaver.CreateSecretRequest) (*weaver.CreateSecretResponse, error) {
+	return s.secret.Create(ctx, req)
+}
+
+func (s *Server) GetSecret(ctx context.Context, req *weaver.GetSecretRequest) (*weaver.GetSecretResponse, error) {
+	return s.secret.Get(ctx, req)
+}
+
+func (s *Server) ListSecrets(ctx context.Context, req *weaver.ListSecretsRequest) (*weaver.ListSecretsResponse, error) {
+	return s.secret.List(ctx, req)
+}
+
+func (s *Server) UpdateSecret(ctx context.Context, req *weaver.UpdateSecretRequest) (*weaver.UpdateSecretResponse, error) {
+	return s.secret.Update(ctx, req)
+}
+
+func (s *Server) DeleteSecret(ctx context.Context, req *weaver.DeleteSecretRequest) (*emptypb.Empty, error) {
+	return s.secret.Delete(ctx, req)
+}
+
+func (s *Server) SyncSecret(ctx context.Context, req *weaver.SyncSecretRequest) (*weaver.SyncSecretResponse, error) {
+	return s.secret.Sync(ctx, req)
+}
+
+// Cron workload management methods
+func (s *Server) CreateCronWorkload(ctx context.Context, req *weaver.CreateCronWorkloadRequest) (*weaver.CreateCronWorkloadResponse, error) {
+	return s.cron.Create(ctx, req)
+}
+
+func (s *Server) GetCronWorkload(ctx context.Context, req *weaver.GetCronWorkloadRequest) (*weaver.GetCronWorkloadResponse, error) {
+	return s.cron.Get(ctx, req)
+}
+
+func (s *Server) ListCronWorkloads(ctx context.Context, req *weaver.ListCronWorkloadsRequest) (*weaver.ListCronWorkloadsResponse, error) {
+	return s.cron.List(ctx, req)
+}
+
+func (s *Server) UpdateCronWorkload(ctx context.Context, req *weaver.UpdateCronWorkloadRequest) (*weaver.UpdateCronWorkloadResponse, error) {
+	return s.cron.Update(ctx, req)
+}
+
+func (s *Server) DeleteCronWorkload(ctx context.Context, req *weaver.DeleteCronWorkloadRequest) (*emptypb.Empty, error) {
+	return s.cron.Delete(ctx, req)
+}
+
 // Provider management methods
 func (s *Server) ListProviders(ctx context.Context, req *emptypb.Empty) (*weaver.ListProvidersResponse, error) {
 	return s.provider.List(ctx, req)
@@ -106,10 +296,43 @@ func (s *Server) GetRecommendations(ctx context.Context, req *weaver.GetRecommen
 	return s.scheduler.GetRecommendations(ctx, req)
 }
 
+func (s *Server) ExplainScheduling(ctx context.Context, req *weaver.ExplainSchedulingRequest) (*weaver.ExplainSchedulingResponse, error) {
+	return s.scheduler.Explain(ctx, req)
+}
+
+func (s *Server) ListSchedulingQueue(ctx context.Context, req *weaver.ListSchedulingQueueRequest) (*weaver.ListSchedulingQueueResponse, error) {
+	return s.scheduler.ListQueue(ctx, req)
+}
+
 func (s *Server) GetSchedulerStats(ctx context.Context, req *emptypb.Empty) (*weaver.GetSchedulerStatsResponse, error) {
 	return s.scheduler.GetStats(ctx, req)
 }
 
+// Access control methods
+func (s *Server) CreateToken(ctx context.Context, req *weaver.CreateTokenRequest) (*weaver.CreateTokenResponse, error) {
+	return s.auth.CreateToken(ctx, req)
+}
+
+func (s *Server) ListTokens(ctx context.Context, req *weaver.ListTokensRequest) (*weaver.ListTokensResponse, error) {
+	return s.auth.ListTokens(ctx, req)
+}
+
+func (s *Server) RevokeToken(ctx context.Context, req *weaver.RevokeTokenRequest) (*emptypb.Empty, error) {
+	return s.auth.RevokeToken(ctx, req)
+}
+
+func (s *Server) CreateRoleBinding(ctx context.Context, req *weaver.CreateRoleBindingRequest) (*weaver.CreateRoleBindingResponse, error) {
+	return s.auth.CreateRoleBinding(ctx, req)
+}
+
+func (s *Server) ListRoleBindings(ctx context.Context, req *weaver.ListRoleBindingsRequest) (*weaver.ListRoleBindingsResponse, error) {
+	return s.auth.ListRoleBindings(ctx, req)
+}
+
+func (s *Server) DeleteRoleBinding(ctx context.Context, req *weaver.DeleteRoleBindingRequest) (*emptypb.Empty, error) {
+	return s.auth.DeleteRoleBinding(ctx, req)
+}
+
 // Health check
 func (s *Server) HealthCheck(ctx context.Context, req *emptypb.Empty) (*weaver.HealthCheckResponse, error) {
 	return &weaver.HealthCheckResponse{
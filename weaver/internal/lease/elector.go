@@ -0,0 +1,107 @@
+package lease
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Elector elects one of the Weaver replicas sharing a repository to run a
+// loop, by holding a lease that it renews on every pass. A leader that stops
+// renewing, e.g. because it crashed, is replaced once the lease expires.
+type Elector struct {
+	repo   Repository
+	name   string
+	holder string
+	ttl    time.Duration
+
+	leading bool
+	expires time.Time // when the lease expires at the latest while leading
+}
+
+// NewElector creates an elector for the named lease. Without a repository
+// there is nobody to share the loop with and the elector always leads.
+func NewElector(repo Repository, name string, ttl time.Duration) *Elector {
+	return &Elector{
+		repo:   repo,
+		name:   name,
+		holder: holderID(),
+		ttl:    ttl,
+	}
+}
+
+// Holder returns the identity the elector holds the lease as
+func (e *Elector) Holder() string {
+	return e.holder
+}
+
+// Lead acquires or renews the lease and reports whether this replica leads
+// until the next call. It also reports whether leadership changed since the
+// previous call. Errors end the leadership, since another replica may take
+// the lease once it expires.
+func (e *Elector) Lead(ctx context.Context) (leading, changed bool, err error) {
+	// Measured from before the request, the lease cannot expire any earlier
+	start := time.Now()
+
+	leading = true
+	if e.repo != nil {
+		leading, err = e.repo.Acquire(ctx, e.name, e.holder, e.ttl)
+		if err != nil {
+			leading = false
+			err = fmt.Errorf("failed to acquire lease %s: %w", e.name, err)
+		}
+	}
+
+	changed = leading != e.leading
+	e.leading = leading
+	e.expires = start.Add(e.ttl)
+	return leading, changed, err
+}
+
+// Keep reports whether this replica still leads for at least d, renewing the
+// lease first if it would expire sooner. Loops call it before every step that
+// may take up to d, so a long pass does not outlive the lease.
+func (e *Elector) Keep(ctx context.Context, d time.Duration) (bool, error) {
+	if e.repo == nil {
+		return true, nil
+	}
+	if !e.leading {
+		return false, nil
+	}
+	if time.Until(e.expires) > d {
+		return true, nil
+	}
+
+	leading, _, err := e.Lead(ctx)
+	return leading && time.Until(e.expires) > d, err
+}
+
+// Resign releases the lease so another replica can take over right away
+func (e *Elector) Resign(ctx context.Context) error {
+	if e.repo == nil || !e.leading {
+		return nil
+	}
+	e.leading = false
+	if err := e.repo.Release(ctx, e.name, e.holder); err != nil {
+		return fmt.Errorf("failed to release lease %s: %w", e.name, err)
+	}
+	return nil
+}
+
+// holderID identifies this replica by host name and a random suffix, which
+// tells apart replicas restarted on the same host
+func holderID() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "weaver"
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return host
+	}
+	return host + "-" + hex.EncodeToString(suffix)
+}
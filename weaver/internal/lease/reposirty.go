@@ -0,0 +1,16 @@
+package lease
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	// Acquire takes the named lease for holder, or renews it if holder already
+	// has it, and reports whether holder has it now. Leases that were not
+	// renewed within their TTL can be taken by anyone.
+	Acquire(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+
+	// Release gives up the named lease if holder has it
+	Release(ctx context.Context, name, holder string) error
+}
@@ -0,0 +1,95 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Path serves the metrics in the Prometheus text format
+const Path = "/metrics"
+
+// Registry holds the metrics Weaver exposes
+type Registry struct {
+	mu      sync.Mutex
+	metrics []*Vec
+}
+
+// Vec is a gauge or counter with one value per value of its label
+type Vec struct {
+	registry *Registry
+	name     string
+	help     string
+	kind     string
+	label    string
+	values   map[string]float64
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Gauge registers a gauge with a single label
+func (r *Registry) Gauge(name, help, label string) *Vec {
+	return r.register(name, help, "gauge", label)
+}
+
+// Counter registers a counter with a single label
+func (r *Registry) Counter(name, help, label string) *Vec {
+	return r.register(name, help, "counter", label)
+}
+
+func (r *Registry) register(name, help, kind, label string) *Vec {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, v := range r.metrics {
+		if v.name == name {
+			return v
+		}
+	}
+
+	v := &Vec{registry: r, name: name, help: help, kind: kind, label: label, values: make(map[string]float64)}
+	r.metrics = append(r.metrics, v)
+	return v
+}
+
+// Set sets the value for a label value
+func (v *Vec) Set(labelValue string, value float64) {
+	v.registry.mu.Lock()
+	v.values[labelValue] = value
+	v.registry.mu.Unlock()
+}
+
+// Add adds delta to the value for a label value
+func (v *Vec) Add(labelValue string, delta float64) {
+	v.registry.mu.Lock()
+	v.values[labelValue] += delta
+	v.registry.mu.Unlock()
+}
+
+// ServeHTTP writes every metric in the Prometheus text format
+func (r *Registry) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	r.mu.Lock()
+	var b strings.Builder
+	for _, v := range r.metrics {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", v.name, v.help, v.name, v.kind)
+
+		labelValues := make([]string, 0, len(v.values))
+		for labelValue := range v.values {
+			labelValues = append(labelValues, labelValue)
+		}
+		sort.Strings(labelValues)
+
+		for _, labelValue := range labelValues {
+			fmt.Fprintf(&b, "%s{%s=%q} %g\n", v.name, v.label, labelValue, v.values[labelValue])
+		}
+	}
+	r.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	_, _ = w.Write([]byte(b.String()))
+}
@@ -0,0 +1,157 @@
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/codecflow/fabric/pkg/quantity"
+	"github.com/codecflow/fabric/pkg/workload"
+)
+
+// Validate checks that the quota quantities can be parsed
+func (q ResourceQuotas) Validate() error {
+	if q.MaxWorkloads < 0 {
+		return fmt.Errorf("maxWorkloads must not be negative")
+	}
+	if _, err := q.limits(); err != nil {
+		return err
+	}
+	if _, err := quantity.ParseBytes(q.MaxStorage); err != nil {
+		return fmt.Errorf("invalid maxStorage: %w", err)
+	}
+	return nil
+}
+
+// Admit checks that a namespace using the given resources across count workloads
+// stays within its quotas. Unset quotas are unlimited.
+func (q ResourceQuotas) Admit(count int, used quantity.Resources) error {
+	if q.MaxWorkloads > 0 && count > q.MaxWorkloads {
+		return fmt.Errorf("workload quota exceeded: %d of %d workloads", count, q.MaxWorkloads)
+	}
+
+	limits, err := q.limits()
+	if err != nil {
+		return err
+	}
+
+	if q.MaxCPU != "" && used.MilliCPU > limits.MilliCPU {
+		return fmt.Errorf("cpu quota exceeded: %s of %s", quantity.FormatCPU(used.MilliCPU), quantity.FormatCPU(limits.MilliCPU))
+	}
+	if q.MaxMemory != "" && used.Memory > limits.Memory {
+		return fmt.Errorf("memory quota exceeded: %s of %s", quantity.FormatBytes(used.Memory), quantity.FormatBytes(limits.Memory))
+	}
+	if q.MaxGPU != "" && used.GPU > limits.GPU {
+		return fmt.Errorf("gpu quota exceeded: %d of %d", used.GPU, limits.GPU)
+	}
+
+	return nil
+}
+
+// limits parses the CPU, memory and GPU quotas
+func (q ResourceQuotas) limits() (quantity.Resources, error) {
+	limits, err := quantity.Parse(q.MaxCPU, q.MaxMemory, q.MaxGPU, "")
+	if err != nil {
+		return quantity.Resources{}, fmt.Errorf("invalid quota: %w", err)
+	}
+	return limits, nil
+}
+
+// Counts reports whether a workload counts against its namespace's quotas.
+// Finished workloads no longer hold resources. Terminating workloads count
+// until they are removed, unless they had finished before.
+func Counts(w *workload.Workload) bool {
+	switch w.Status.Phase {
+	case workload.PhaseSucceeded, workload.PhaseFailed:
+		return false
+	case workload.PhaseTerminating:
+		return w.Status.FinishTime == nil
+	}
+	return true
+}
+
+// Usage sums the resource requests of the workloads that count against the quotas
+func Usage(workloads []*workload.Workload) (int, quantity.Resources) {
+	var count int
+	var used quantity.Resources
+	for _, w := range workloads {
+		if !Counts(w) {
+			continue
+		}
+		count++
+
+		// Requests were validated when the workload was created
+		if requests, err := w.Spec.Resources.Quantity(); err == nil {
+			used = used.Add(requests)
+		}
+	}
+	return count, used
+}
+
+// NewResourceUsage formats resource usage for the namespace status
+func NewResourceUsage(count int, used quantity.Resources) ResourceUsage {
+	return ResourceUsage{
+		Workloads: count,
+		CPU:       quantity.FormatCPU(used.MilliCPU),
+		Memory:    quantity.FormatBytes(used.Memory),
+		GPU:       fmt.Sprintf("%d", used.GPU),
+	}
+}
+
+// RefreshUsage recomputes the usage of a namespace from its workloads and stores
+// it when it changed
+func RefreshUsage(ctx context.Context, namespaces Repository, workloads workload.Repository, name string) error {
+	ns, err := namespaces.Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to get namespace %s: %w", name, err)
+	}
+
+	list, err := workloads.List(ctx, workload.Filter{Namespace: name})
+	if err != nil {
+		return fmt.Errorf("failed to list workloads of namespace %s: %w", name, err)
+	}
+
+	usage := NewResourceUsage(Usage(list))
+	if ns.Status.Usage == usage {
+		return nil
+	}
+
+	ns.Status.Usage = usage
+	ns.UpdatedAt = time.Now()
+	if err := namespaces.Update(ctx, ns); err != nil {
+		return fmt.Errorf("failed to update namespace %s: %w", name, err)
+	}
+	return nil
+}
+
+// ApplyDefaultPlacement fills in the placement fields a workload leaves unset
+// from the namespace's default placement. A default region or zone is only used
+// when the workload does not pick a different provider or region.
+func (s *Spec) ApplyDefaultPlacement(placement *workload.PlacementSpec) {
+	defaults := s.DefaultPlacement
+
+	if placement.Provider == "" {
+		placement.Provider = defaults.Provider
+	}
+	if placement.Region == "" && (defaults.Provider == "" || placement.Provider == defaults.Provider) {
+		placement.Region = defaults.Region
+	}
+	if placement.Zone == "" && placement.Region == defaults.Region {
+		placement.Zone = defaults.Zone
+	}
+	if len(placement.Tolerations) == 0 {
+		placement.Tolerations = defaults.Tolerations
+	}
+
+	if len(defaults.NodeLabels) > 0 {
+		labels := make(map[string]string, len(defaults.NodeLabels)+len(placement.NodeLabels))
+		for key, value := range defaults.NodeLabels {
+			labels[key] = value
+		}
+		// Labels set on the workload win
+		for key, value := range placement.NodeLabels {
+			labels[key] = value
+		}
+		placement.NodeLabels = labels
+	}
+}
@@ -1,6 +1,10 @@
 package namespace
 
-import "context"
+import (
+	"context"
+
+	"github.com/codecflow/fabric/pkg/workload"
+)
 
 type Repository interface {
 	Create(ctx context.Context, ns *Namespace) error
@@ -8,4 +12,9 @@ type Repository interface {
 	Update(ctx context.Context, ns *Namespace) error
 	Delete(ctx context.Context, name string) error
 	List(ctx context.Context, filters map[string]string) ([]*Namespace, error)
+	// Admit creates a workload if admit accepts it, given the workload's
+	// namespace and the workloads that count against its quotas. The namespace
+	// is locked until the workload is stored, together with the namespace
+	// status admit sets. An error from admit is returned as is.
+	Admit(ctx context.Context, w *workload.Workload, admit func(ns *Namespace, counted []*workload.Workload) error) error
 }
@@ -0,0 +1,59 @@
+package node
+
+import (
+	"github.com/codecflow/fabric/pkg/workload"
+)
+
+// MatchesLabels reports whether the node carries every label in the selector
+func (n *Node) MatchesLabels(selector map[string]string) bool {
+	for key, value := range selector {
+		if n.Labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Tolerates reports whether the tolerations cover every taint that blocks scheduling
+func (n *Node) Tolerates(tolerations []workload.Toleration) bool {
+	for _, taint := range n.Taints {
+		// PreferNoSchedule is a soft preference and never blocks placement
+		if taint.Effect == "PreferNoSchedule" {
+			continue
+		}
+
+		tolerated := false
+		for _, toleration := range tolerations {
+			if tolerates(toleration, taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return false
+		}
+	}
+	return true
+}
+
+// Schedulable reports whether new workloads may be placed on the node
+func (n *Node) Schedulable() bool {
+	return n.Status.Phase == PhaseReady
+}
+
+// tolerates reports whether a single toleration matches a taint
+func tolerates(toleration workload.Toleration, taint Taint) bool {
+	if toleration.Effect != "" && toleration.Effect != taint.Effect {
+		return false
+	}
+
+	switch toleration.Operator {
+	case "Exists":
+		// An empty key with Exists tolerates everything
+		return toleration.Key == "" || toleration.Key == taint.Key
+	case "", "Equal":
+		return toleration.Key == taint.Key && toleration.Value == taint.Value
+	}
+
+	return false
+}
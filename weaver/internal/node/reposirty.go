@@ -0,0 +1,22 @@
+package node
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	Create(ctx context.Context, node *Node) error
+	Get(ctx context.Context, id string) (*Node, error)
+	// Update stores what a node reports about itself; its allocated resources are kept
+	Update(ctx context.Context, node *Node) error
+	// UpdateStatus stores the status of a node without touching its heartbeat
+	UpdateStatus(ctx context.Context, node *Node) error
+	// UpdateAllocated locks a node and stores the allocated resources set by
+	// allocate, so allocations on the same node never overlap. An error from
+	// allocate is returned as is and leaves the node unchanged.
+	UpdateAllocated(ctx context.Context, id string, allocate func(node *Node) error) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context) ([]*Node, error)
+	Heartbeat(ctx context.Context, id string, at time.Time) error
+}
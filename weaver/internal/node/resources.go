@@ -0,0 +1,31 @@
+package node
+
+import (
+	"strconv"
+
+	"github.com/codecflow/fabric/pkg/quantity"
+	"github.com/codecflow/fabric/pkg/workload"
+)
+
+// Quantity is a parsed set of resources used for capacity accounting
+type Quantity = quantity.Resources
+
+// ParseResources parses node resources into a Quantity
+func ParseResources(r Resources) (Quantity, error) {
+	return quantity.Parse(r.CPU, r.Memory, r.GPU, r.Disk)
+}
+
+// ParseRequests parses workload resource requests into a Quantity
+func ParseRequests(r workload.ResourceRequests) (Quantity, error) {
+	return r.Quantity()
+}
+
+// FromQuantity formats a Quantity as node resources
+func FromQuantity(q Quantity) Resources {
+	return Resources{
+		CPU:    quantity.FormatCPU(q.MilliCPU),
+		Memory: quantity.FormatBytes(q.Memory),
+		GPU:    strconv.FormatInt(q.GPU, 10),
+		Disk:   quantity.FormatBytes(q.Disk),
+	}
+}
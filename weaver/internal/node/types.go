@@ -0,0 +1,64 @@
+package node
+
+import (
+	"time"
+)
+
+// Resources describes compute resources on a node
+type Resources struct {
+	CPU    string `json:"cpu,omitempty"`    // e.g. "4" or "4000m"
+	Memory string `json:"memory,omitempty"` // e.g. "8Gi"
+	GPU    string `json:"gpu,omitempty"`    // e.g. "1"
+	Disk   string `json:"disk,omitempty"`   // e.g. "100Gi"
+}
+
+// Taint repels workloads that do not tolerate it
+type Taint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect,omitempty"` // NoSchedule, PreferNoSchedule, NoExecute
+}
+
+// Status represents the current state of a node
+type Status struct {
+	Phase   Phase  `json:"phase"`
+	Message string `json:"message,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// Phase represents the lifecycle phase
+type Phase string
+
+const (
+	PhaseReady    Phase = "Ready"
+	PhaseNotReady Phase = "NotReady"
+	PhaseUnknown  Phase = "Unknown"
+)
+
+// ReasonUnregistered marks a node that left on its own. It is removed once its
+// workloads were moved to other nodes.
+const ReasonUnregistered = "NodeUnregistered"
+
+// Node represents a Shuttle machine registered with Weaver
+type Node struct {
+	ID      string            `json:"id"`
+	Name    string            `json:"name"`
+	Region  string            `json:"region,omitempty"`
+	Zone    string            `json:"zone,omitempty"`
+	Labels  map[string]string `json:"labels,omitempty"`
+	Taints  []Taint           `json:"taints,omitempty"`
+	Address string            `json:"address,omitempty"` // Tailscale or public address
+	Version string            `json:"version,omitempty"`
+
+	// AgentPort is the port of the node's agent service on Address, 0 when not served
+	AgentPort int32 `json:"agentPort,omitempty"`
+
+	Capacity  Resources `json:"capacity"`
+	Allocated Resources `json:"allocated"`
+
+	Status        Status    `json:"status"`
+	LastHeartbeat time.Time `json:"lastHeartbeat"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
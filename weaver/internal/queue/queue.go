@@ -0,0 +1,247 @@
+package queue
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/codecflow/fabric/pkg/workload"
+)
+
+const (
+	defaultInitialBackoff = 5 * time.Second
+	defaultMaxBackoff     = 5 * time.Minute
+)
+
+// Item is a pending workload waiting to be scheduled
+type Item struct {
+	WorkloadID  string
+	Namespace   string
+	Name        string
+	Priority    int32
+	EnqueuedAt  time.Time
+	Attempts    int
+	NextAttempt time.Time
+	LastError   string
+
+	// Unschedulable is set when no provider could run the workload on the last
+	// attempt. Such items are retried early when capacity changes.
+	Unschedulable bool
+}
+
+// Queue orders pending workloads by priority and then by age, and backs off
+// workloads that failed to schedule
+type Queue struct {
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+
+	mu    sync.Mutex
+	items map[string]*Item
+	wake  chan struct{}
+}
+
+// New creates a scheduling queue. A zero maxBackoff uses the default.
+func New(maxBackoff time.Duration) *Queue {
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+
+	return &Queue{
+		initialBackoff: defaultInitialBackoff,
+		maxBackoff:     maxBackoff,
+		items:          make(map[string]*Item),
+		wake:           make(chan struct{}, 1),
+	}
+}
+
+// Add queues a workload for scheduling. Adding a queued workload keeps its place
+// and backoff but picks up a changed priority.
+func (q *Queue) Add(w *workload.Workload) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if item, ok := q.items[w.ID]; ok {
+		item.Priority = w.Spec.Priority
+		return
+	}
+
+	// A workload's age in the queue counts from its creation
+	enqueuedAt := w.CreatedAt
+	if enqueuedAt.IsZero() {
+		enqueuedAt = time.Now()
+	}
+
+	item := &Item{
+		WorkloadID: w.ID,
+		Namespace:  w.Namespace,
+		Name:       w.Name,
+		Priority:   w.Spec.Priority,
+		EnqueuedAt: enqueuedAt,
+	}
+
+	// A job whose run failed is not scheduled again before its backoff ends
+	if job := w.Status.Job; job != nil && job.RetryAt != nil {
+		item.NextAttempt = *job.RetryAt
+	}
+
+	q.items[w.ID] = item
+}
+
+// Due returns the IDs of the workloads ready for a scheduling attempt in the
+// order they should be tried
+func (q *Queue) Due() []string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	var ids []string
+	for _, item := range q.sorted() {
+		if !now.Before(item.NextAttempt) {
+			ids = append(ids, item.WorkloadID)
+		}
+	}
+	return ids
+}
+
+// Backoff records a failed scheduling attempt and delays the next one
+// exponentially. It returns the delay.
+func (q *Queue) Backoff(id string, err error, unschedulable bool) time.Duration {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	item, ok := q.items[id]
+	if !ok {
+		return 0
+	}
+
+	item.Attempts++
+	item.LastError = err.Error()
+	item.Unschedulable = unschedulable
+
+	delay := q.initialBackoff
+	for i := 1; i < item.Attempts && delay < q.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > q.maxBackoff {
+		delay = q.maxBackoff
+	}
+	item.NextAttempt = time.Now().Add(delay)
+
+	return delay
+}
+
+// Remove drops a workload from the queue, e.g. once it is scheduled or deleted
+func (q *Queue) Remove(id string) {
+	q.mu.Lock()
+	delete(q.items, id)
+	q.mu.Unlock()
+}
+
+// Retain drops every workload whose ID is not in keep
+func (q *Queue) Retain(keep map[string]bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for id := range q.items {
+		if !keep[id] {
+			delete(q.items, id)
+		}
+	}
+}
+
+// CapacityChanged makes unschedulable workloads due again, since a provider or
+// node may now be able to run them
+func (q *Queue) CapacityChanged() {
+	q.mu.Lock()
+	woken := false
+	for _, item := range q.items {
+		if item.Unschedulable {
+			item.NextAttempt = time.Time{}
+			woken = true
+		}
+	}
+	q.mu.Unlock()
+
+	if woken {
+		select {
+		case q.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Wake is signalled when queued workloads became due early
+func (q *Queue) Wake() <-chan struct{} {
+	return q.wake
+}
+
+// HasUnschedulable reports whether any queued workload could not be placed
+func (q *Queue) HasUnschedulable() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, item := range q.items {
+		if item.Unschedulable {
+			return true
+		}
+	}
+	return false
+}
+
+// List returns a copy of the queued workloads in scheduling order, optionally
+// limited to a namespace. Positions are 1-based indexes into the full queue.
+func (q *Queue) List(namespace string) ([]Item, []int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var items []Item
+	var positions []int
+	for i, item := range q.sorted() {
+		if namespace != "" && item.Namespace != namespace {
+			continue
+		}
+		items = append(items, *item)
+		positions = append(positions, i+1)
+	}
+	return items, positions
+}
+
+// Position returns the 1-based position of a workload in the queue
+func (q *Queue) Position(id string) (int, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i, item := range q.sorted() {
+		if item.WorkloadID == id {
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+// Len returns the number of queued workloads
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.items)
+}
+
+// sorted returns the items by descending priority, then oldest first; q.mu must be held
+func (q *Queue) sorted() []*Item {
+	items := make([]*Item, 0, len(q.items))
+	for _, item := range q.items {
+		items = append(items, item)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Priority != items[j].Priority {
+			return items[i].Priority > items[j].Priority
+		}
+		if !items[i].EnqueuedAt.Equal(items[j].EnqueuedAt) {
+			return items[i].EnqueuedAt.Before(items[j].EnqueuedAt)
+		}
+		return items[i].WorkloadID < items[j].WorkloadID
+	})
+
+	return items
+}
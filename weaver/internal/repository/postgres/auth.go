@@ -0,0 +1,213 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codecflow/fabric/weaver/internal/auth"
+	"github.com/codecflow/fabric/weaver/internal/repository"
+)
+
+// AuthRepository implements auth.Repository
+type AuthRepository struct {
+	db *sql.DB
+}
+
+// NewAuthRepository creates a new auth repository
+func NewAuthRepository(db *sql.DB) *AuthRepository {
+	return &AuthRepository{db: db}
+}
+
+// CreateToken stores a new API token
+func (r *AuthRepository) CreateToken(ctx context.Context, t *auth.Token) error {
+	query := `
+		INSERT INTO api_tokens (id, name, principal, hash, created_at, expires_at, revoked_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.ExecContext(ctx, query, t.ID, t.Name, t.Principal, t.Hash, t.CreatedAt, t.ExpiresAt, t.RevokedAt)
+	return err
+}
+
+// GetToken retrieves a token by ID
+func (r *AuthRepository) GetToken(ctx context.Context, id string) (*auth.Token, error) {
+	query := `
+		SELECT id, name, principal, hash, created_at, expires_at, revoked_at
+		FROM api_tokens WHERE id = $1
+	`
+
+	t, err := scanToken(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, repository.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// ListTokens lists the tokens of a principal, or all tokens when empty
+func (r *AuthRepository) ListTokens(ctx context.Context, principal string) ([]*auth.Token, error) {
+	query := `
+		SELECT id, name, principal, hash, created_at, expires_at, revoked_at
+		FROM api_tokens WHERE ($1 = '' OR principal = $1) ORDER BY created_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, principal)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var tokens []*auth.Token
+	for rows.Next() {
+		t, err := scanToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, t)
+	}
+
+	return tokens, rows.Err()
+}
+
+// RevokeToken marks a token as revoked; revoking it again keeps the first time
+func (r *AuthRepository) RevokeToken(ctx context.Context, id string, at time.Time) error {
+	query := `UPDATE api_tokens SET revoked_at = COALESCE(revoked_at, $2) WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id, at)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// CreateRoleBinding stores a new role binding
+func (r *AuthRepository) CreateRoleBinding(ctx context.Context, b *auth.RoleBinding) error {
+	query := `
+		INSERT INTO role_bindings (id, principal, namespace, role, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := r.db.ExecContext(ctx, query, b.ID, b.Principal, b.Namespace, string(b.Role), b.CreatedAt)
+	return err
+}
+
+// GetRoleBinding retrieves a role binding by ID
+func (r *AuthRepository) GetRoleBinding(ctx context.Context, id string) (*auth.RoleBinding, error) {
+	query := `SELECT id, principal, namespace, role, created_at FROM role_bindings WHERE id = $1`
+
+	b, err := scanRoleBinding(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, repository.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// ListRoleBindings lists the role bindings matching a filter
+func (r *AuthRepository) ListRoleBindings(ctx context.Context, filter auth.BindingFilter) ([]*auth.RoleBinding, error) {
+	var conditions []string
+	var args []interface{}
+	if filter.Principal != "" {
+		args = append(args, filter.Principal)
+		conditions = append(conditions, "principal = $"+strconv.Itoa(len(args)))
+	}
+	if filter.Namespace != "" {
+		args = append(args, filter.Namespace)
+		conditions = append(conditions, "namespace = $"+strconv.Itoa(len(args)))
+	}
+
+	query := `SELECT id, principal, namespace, role, created_at FROM role_bindings`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY namespace ASC, principal ASC"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var bindings []*auth.RoleBinding
+	for rows.Next() {
+		b, err := scanRoleBinding(rows)
+		if err != nil {
+			return nil, err
+		}
+		bindings = append(bindings, b)
+	}
+
+	return bindings, rows.Err()
+}
+
+// DeleteRoleBinding removes a role binding
+func (r *AuthRepository) DeleteRoleBinding(ctx context.Context, id string) error {
+	query := `DELETE FROM role_bindings WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// scanToken reads a single token row
+func scanToken(row rowScanner) (*auth.Token, error) {
+	var t auth.Token
+	var expiresAt, revokedAt sql.NullTime
+
+	if err := row.Scan(&t.ID, &t.Name, &t.Principal, &t.Hash, &t.CreatedAt, &expiresAt, &revokedAt); err != nil {
+		return nil, err
+	}
+
+	if expiresAt.Valid {
+		t.ExpiresAt = &expiresAt.Time
+	}
+	if revokedAt.Valid {
+		t.RevokedAt = &revokedAt.Time
+	}
+
+	return &t, nil
+}
+
+// scanRoleBinding reads a single role binding row
+func scanRoleBinding(row rowScanner) (*auth.RoleBinding, error) {
+	var b auth.RoleBinding
+	var role string
+
+	if err := row.Scan(&b.ID, &b.Principal, &b.Namespace, &role, &b.CreatedAt); err != nil {
+		return nil, err
+	}
+	b.Role = auth.Role(role)
+
+	return &b, nil
+}
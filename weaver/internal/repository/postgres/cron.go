@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/codecflow/fabric/weaver/internal/cron"
+	"github.com/codecflow/fabric/weaver/internal/repository"
+)
+
+// CronRepository implements cron.Repository
+type CronRepository struct {
+	db *sql.DB
+}
+
+// NewCronRepository creates a new cron workload repository
+func NewCronRepository(db *sql.DB) *CronRepository {
+	return &CronRepository{db: db}
+}
+
+// Create creates a new cron workload
+func (r *CronRepository) Create(ctx context.Context, cw *cron.CronWorkload) error {
+	query := `
+		INSERT INTO cron_workloads (id, namespace_id, name, spec, status, labels, annotations, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		cw.ID,
+		cw.Namespace,
+		cw.Name,
+		toJSON(cw.Spec),
+		toJSON(cw.Status),
+		toJSON(cw.Labels),
+		toJSON(cw.Annotations),
+		cw.CreatedAt,
+		cw.UpdatedAt,
+	)
+
+	return err
+}
+
+// Get retrieves a cron workload by namespace and name
+func (r *CronRepository) Get(ctx context.Context, namespace, name string) (*cron.CronWorkload, error) {
+	query := `
+		SELECT id, namespace_id, name, spec, status, labels, annotations, created_at, updated_at
+		FROM cron_workloads WHERE namespace_id = $1 AND name = $2
+	`
+
+	cw, err := scanCronWorkload(r.db.QueryRowContext(ctx, query, namespace, name))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, repository.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return cw, nil
+}
+
+// Update updates the spec, labels and annotations of a cron workload. The
+// status is left to UpdateStatus.
+func (r *CronRepository) Update(ctx context.Context, cw *cron.CronWorkload) error {
+	query := `
+		UPDATE cron_workloads
+		SET spec = $3, labels = $4, annotations = $5, updated_at = $6
+		WHERE namespace_id = $1 AND name = $2
+	`
+
+	return r.exec(ctx, query,
+		cw.Namespace,
+		cw.Name,
+		toJSON(cw.Spec),
+		toJSON(cw.Labels),
+		toJSON(cw.Annotations),
+		cw.UpdatedAt,
+	)
+}
+
+// UpdateStatus updates the status of a cron workload
+func (r *CronRepository) UpdateStatus(ctx context.Context, cw *cron.CronWorkload) error {
+	query := `UPDATE cron_workloads SET status = $3 WHERE namespace_id = $1 AND name = $2`
+
+	return r.exec(ctx, query, cw.Namespace, cw.Name, toJSON(cw.Status))
+}
+
+// Delete deletes a cron workload
+func (r *CronRepository) Delete(ctx context.Context, namespace, name string) error {
+	query := `DELETE FROM cron_workloads WHERE namespace_id = $1 AND name = $2`
+
+	return r.exec(ctx, query, namespace, name)
+}
+
+// List lists the cron workloads of a namespace, or of all namespaces when
+// empty, whose labels contain all of the given labels
+func (r *CronRepository) List(ctx context.Context, namespace string, labels map[string]string) ([]*cron.CronWorkload, error) {
+	query := `
+		SELECT id, namespace_id, name, spec, status, labels, annotations, created_at, updated_at
+		FROM cron_workloads
+		WHERE ($1 = '' OR namespace_id = $1) AND ($2::jsonb = '{}'::jsonb OR labels @> $2::jsonb)
+		ORDER BY namespace_id, name
+	`
+
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, namespace, toJSON(labels))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var cronWorkloads []*cron.CronWorkload
+	for rows.Next() {
+		cw, err := scanCronWorkload(rows)
+		if err != nil {
+			return nil, err
+		}
+		cronWorkloads = append(cronWorkloads, cw)
+	}
+
+	return cronWorkloads, rows.Err()
+}
+
+// exec runs a statement that must affect a cron workload
+func (r *CronRepository) exec(ctx context.Context, query string, args ...interface{}) error {
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// scanCronWorkload reads a single cron workload row
+func scanCronWorkload(row rowScanner) (*cron.CronWorkload, error) {
+	var cw cron.CronWorkload
+	var specJSON, statusJSON, labelsJSON, annotationsJSON []byte
+
+	err := row.Scan(
+		&cw.ID,
+		&cw.Namespace,
+		&cw.Name,
+		&specJSON,
+		&statusJSON,
+		&labelsJSON,
+		&annotationsJSON,
+		&cw.CreatedAt,
+		&cw.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse JSON fields
+	if err := fromJSON(specJSON, &cw.Spec); err != nil {
+		return nil, err
+	}
+	if err := fromJSON(statusJSON, &cw.Status); err != nil {
+		return nil, err
+	}
+	if err := fromJSON(labelsJSON, &cw.Labels); err != nil {
+		return nil, err
+	}
+	if err := fromJSON(annotationsJSON, &cw.Annotations); err != nil {
+		return nil, err
+	}
+
+	return &cw, nil
+}
@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/watch"
+)
+
+// eventsChannel is notified with the version of every appended change
+const eventsChannel = "workload_events"
+
+// EventRepository implements watch.Log. Changes are numbered by a sequence
+// and announced to every replica with NOTIFY.
+type EventRepository struct {
+	db         *sql.DB
+	connection string
+}
+
+// NewEventRepository creates a new event repository. Listening opens its own
+// connection from the connection string.
+func NewEventRepository(db *sql.DB, connection string) *EventRepository {
+	return &EventRepository{db: db, connection: connection}
+}
+
+// Append records a change. Appends lock the table until they commit, so
+// versions become visible in order and readers never skip one that commits late.
+func (r *EventRepository) Append(ctx context.Context, eventType watch.EventType, w *workload.Workload) (uint64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `LOCK TABLE workload_events IN EXCLUSIVE MODE`); err != nil {
+		return 0, err
+	}
+
+	var version uint64
+	query := `INSERT INTO workload_events (type, workload) VALUES ($1, $2) RETURNING version`
+	if err := tx.QueryRowContext(ctx, query, string(eventType), toJSON(w)).Scan(&version); err != nil {
+		return 0, err
+	}
+
+	// Delivered to listeners when the transaction commits
+	if _, err := tx.ExecContext(ctx, `SELECT pg_notify($1, $2::text)`, eventsChannel, version); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// Since lists up to limit changes after version, oldest first
+func (r *EventRepository) Since(ctx context.Context, version uint64, limit int) ([]watch.Event, error) {
+	query := `
+		SELECT version, type, workload
+		FROM workload_events WHERE version > $1 ORDER BY version ASC LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, version, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var events []watch.Event
+	for rows.Next() {
+		var event watch.Event
+		var eventType string
+		var workloadJSON []byte
+
+		if err := rows.Scan(&event.Version, &eventType, &workloadJSON); err != nil {
+			return nil, err
+		}
+
+		event.Type = watch.EventType(eventType)
+		event.Workload = &workload.Workload{}
+		if err := fromJSON(workloadJSON, event.Workload); err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// Latest returns the version of the last change, or zero if there is none
+func (r *EventRepository) Latest(ctx context.Context) (uint64, error) {
+	var version uint64
+	err := r.db.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM workload_events`).Scan(&version)
+	return version, err
+}
+
+// Prune deletes the changes up to and including version
+func (r *EventRepository) Prune(ctx context.Context, version uint64) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM workload_events WHERE version <= $1`, version)
+	return err
+}
+
+// Listen signals on the returned channel for every notification, and after
+// reconnecting, when notifications may have been missed
+func (r *EventRepository) Listen(ctx context.Context) (<-chan struct{}, error) {
+	listener := pq.NewListener(r.connection, time.Second, time.Minute, nil)
+	if err := listener.Listen(eventsChannel); err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	signals := make(chan struct{}, 1)
+	go func() {
+		defer func() { _ = listener.Close() }()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-listener.Notify:
+				// A nil notification follows a reconnect
+			}
+
+			select {
+			case signals <- struct{}{}:
+			default:
+			}
+		}
+	}()
+
+	return signals, nil
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/repository"
+)
+
+// workloadFields maps selectable fields to their JSONB expressions
+var workloadFields = map[string]string{
+	workload.FieldPhase:    "status->>'phase'",
+	workload.FieldProvider: "status->>'provider'",
+	workload.FieldNodeID:   "status->>'nodeId'",
+}
+
+// query collects the conditions and arguments of a WHERE clause
+type query struct {
+	conditions []string
+	args       []interface{}
+}
+
+// arg adds an argument and returns its placeholder
+func (q *query) arg(value interface{}) string {
+	q.args = append(q.args, value)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// where adds a condition
+func (q *query) where(format string, args ...interface{}) {
+	q.conditions = append(q.conditions, fmt.Sprintf(format, args...))
+}
+
+// clause returns the WHERE clause, or "" without conditions
+func (q *query) clause() string {
+	if len(q.conditions) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(q.conditions, " AND ")
+}
+
+// filterWorkloads adds the conditions of a workload filter
+func (q *query) filterWorkloads(filter workload.Filter) error {
+	if filter.Namespace != "" {
+		q.where("namespace_id = %s", q.arg(filter.Namespace))
+	}
+
+	for _, r := range filter.Labels {
+		switch r.Operator {
+		case workload.OperatorEquals:
+			// Containment is served by the GIN index on labels
+			q.where("labels @> %s::jsonb", q.arg(toJSON(map[string]string{r.Key: r.Values[0]})))
+		case workload.OperatorNotEquals:
+			q.where("NOT labels @> %s::jsonb", q.arg(toJSON(map[string]string{r.Key: r.Values[0]})))
+		case workload.OperatorIn:
+			q.where("labels->>%s = ANY(%s)", q.arg(r.Key), q.arg(pq.Array(r.Values)))
+		case workload.OperatorNotIn:
+			q.where("COALESCE(labels->>%s <> ALL(%s), true)", q.arg(r.Key), q.arg(pq.Array(r.Values)))
+		case workload.OperatorExists:
+			q.where("labels ? %s", q.arg(r.Key))
+		case workload.OperatorDoesNotExist:
+			q.where("NOT labels ? %s", q.arg(r.Key))
+		default:
+			return fmt.Errorf("unsupported label operator %q", r.Operator)
+		}
+	}
+
+	for _, r := range filter.Fields {
+		expr, ok := workloadFields[r.Key]
+		if !ok {
+			return fmt.Errorf("unsupported field %q", r.Key)
+		}
+
+		// Unset fields compare as empty strings. Without an empty value the bare
+		// expression is kept so its index can be used.
+		value := expr
+		for _, v := range r.Values {
+			if v == "" {
+				value = fmt.Sprintf("COALESCE(%s, '')", expr)
+			}
+		}
+
+		switch r.Operator {
+		case workload.OperatorEquals, workload.OperatorIn:
+			q.where("%s = ANY(%s)", value, q.arg(pq.Array(r.Values)))
+		case workload.OperatorNotEquals, workload.OperatorNotIn:
+			q.where("COALESCE(%s, '') <> ALL(%s)", expr, q.arg(pq.Array(r.Values)))
+		default:
+			return fmt.Errorf("unsupported field operator %q", r.Operator)
+		}
+	}
+
+	return nil
+}
+
+// continueToken is the position of the last workload of a page. Workloads are
+// listed by creation time and ID, both descending.
+type continueToken struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+// encode returns the opaque form of the token
+func (t continueToken) encode() string {
+	data, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeContinueToken parses a token returned by encode
+func decodeContinueToken(s string) (continueToken, error) {
+	var t continueToken
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return t, repository.ErrInvalidContinueToken
+	}
+	if err := json.Unmarshal(data, &t); err != nil || t.ID == "" {
+		return t, repository.ErrInvalidContinueToken
+	}
+	return t, nil
+}
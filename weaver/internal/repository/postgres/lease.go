@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// LeaseRepository implements lease.Repository. Expiry is checked against the
+// database clock, so replicas need not agree on the time.
+type LeaseRepository struct {
+	db *sql.DB
+}
+
+// NewLeaseRepository creates a new lease repository
+func NewLeaseRepository(db *sql.DB) *LeaseRepository {
+	return &LeaseRepository{db: db}
+}
+
+// Acquire takes or renews a lease in a single statement. The conflicting row
+// is only overwritten when holder has the lease or it expired.
+func (r *LeaseRepository) Acquire(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	query := `
+		INSERT INTO leases (name, holder, expires_at)
+		VALUES ($1, $2, NOW() + $3 * INTERVAL '1 millisecond')
+		ON CONFLICT (name) DO UPDATE SET holder = EXCLUDED.holder, expires_at = EXCLUDED.expires_at
+		WHERE leases.holder = EXCLUDED.holder OR leases.expires_at < NOW()
+		RETURNING holder
+	`
+
+	var current string
+	err := r.db.QueryRowContext(ctx, query, name, holder, ttl.Milliseconds()).Scan(&current)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return current == holder, nil
+}
+
+// Release deletes a lease if holder has it
+func (r *LeaseRepository) Release(ctx context.Context, name, holder string) error {
+	query := `DELETE FROM leases WHERE name = $1 AND holder = $2`
+
+	_, err := r.db.ExecContext(ctx, query, name, holder)
+	return err
+}
@@ -3,7 +3,11 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"time"
 
+	"github.com/lib/pq"
+
+	"github.com/codecflow/fabric/pkg/workload"
 	"github.com/codecflow/fabric/weaver/internal/namespace"
 	"github.com/codecflow/fabric/weaver/internal/repository"
 )
@@ -46,19 +50,7 @@ func (r *NamespaceRepository) Get(ctx context.Context, name string) (*namespace.
 		FROM namespaces WHERE name = $1
 	`
 
-	var ns namespace.Namespace
-	var labelsJSON, annotationsJSON, specJSON, statusJSON []byte
-
-	err := r.db.QueryRowContext(ctx, query, name).Scan(
-		&ns.ID,
-		&ns.Name,
-		&labelsJSON,
-		&annotationsJSON,
-		&specJSON,
-		&statusJSON,
-		&ns.CreatedAt,
-		&ns.UpdatedAt,
-	)
+	ns, err := scanNamespace(r.db.QueryRowContext(ctx, query, name))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, repository.ErrNotFound
@@ -66,21 +58,70 @@ func (r *NamespaceRepository) Get(ctx context.Context, name string) (*namespace.
 		return nil, err
 	}
 
-	// Parse JSON fields
-	if err := fromJSON(labelsJSON, &ns.Labels); err != nil {
-		return nil, err
+	return ns, nil
+}
+
+// Admit locks a namespace, passes admit the workloads counting against its
+// quotas and stores the workload together with the namespace status admit
+// leaves. Admissions to the same namespace run one at a time across replicas.
+func (r *NamespaceRepository) Admit(ctx context.Context, w *workload.Workload, admit func(ns *namespace.Namespace, counted []*workload.Workload) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
 	}
-	if err := fromJSON(annotationsJSON, &ns.Annotations); err != nil {
-		return nil, err
+	defer func() { _ = tx.Rollback() }()
+
+	query := `
+		SELECT id, name, labels, annotations, spec, status, created_at, updated_at
+		FROM namespaces WHERE name = $1 FOR UPDATE
+	`
+
+	ns, err := scanNamespace(tx.QueryRowContext(ctx, query, w.Namespace))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return repository.ErrNotFound
+		}
+		return err
 	}
-	if err := fromJSON(specJSON, &ns.Spec); err != nil {
-		return nil, err
+
+	// Mirrors namespace.Counts: finished workloads hold no resources, and
+	// terminating ones only until they are removed, unless they had finished
+	query = `
+		SELECT id, namespace_id, name, spec, status, labels, annotations, finalizers, created_at, updated_at, deleted_at, resource_version
+		FROM workloads
+		WHERE namespace_id = $1
+			AND COALESCE(status->>'phase', '') <> ALL($2)
+			AND NOT (status->>'phase' = $3 AND status->>'finishTime' IS NOT NULL)
+	`
+
+	rows, err := tx.QueryContext(ctx, query, ns.Name,
+		pq.Array([]string{string(workload.PhaseSucceeded), string(workload.PhaseFailed)}),
+		string(workload.PhaseTerminating),
+	)
+	if err != nil {
+		return err
 	}
-	if err := fromJSON(statusJSON, &ns.Status); err != nil {
-		return nil, err
+	counted, err := scanWorkloads(rows)
+	_ = rows.Close()
+	if err != nil {
+		return err
 	}
 
-	return &ns, nil
+	if err := admit(ns, counted); err != nil {
+		return err
+	}
+
+	if err := insertWorkload(ctx, tx, w); err != nil {
+		return err
+	}
+
+	ns.UpdatedAt = time.Now()
+	if _, err := tx.ExecContext(ctx, `UPDATE namespaces SET status = $2, updated_at = $3 WHERE name = $1`,
+		ns.Name, toJSON(ns.Status), ns.UpdatedAt); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 // Update updates an existing namespace
@@ -136,14 +177,20 @@ func (r *NamespaceRepository) Delete(ctx context.Context, name string) error {
 	return nil
 }
 
-// List lists namespaces with optional filtering
+// List lists namespaces whose labels contain all of the given filters
 func (r *NamespaceRepository) List(ctx context.Context, filters map[string]string) ([]*namespace.Namespace, error) {
 	query := `
 		SELECT id, name, labels, annotations, spec, status, created_at, updated_at
-		FROM namespaces ORDER BY created_at DESC
+		FROM namespaces WHERE $1::jsonb = '{}'::jsonb OR labels @> $1::jsonb
+		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query)
+	labels := filters
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, toJSON(labels))
 	if err != nil {
 		return nil, err
 	}
@@ -188,3 +235,39 @@ func (r *NamespaceRepository) List(ctx context.Context, filters map[string]strin
 
 	return namespaces, rows.Err()
 }
+
+// scanNamespace reads a single namespace row
+func scanNamespace(row rowScanner) (*namespace.Namespace, error) {
+	var ns namespace.Namespace
+	var labelsJSON, annotationsJSON, specJSON, statusJSON []byte
+
+	err := row.Scan(
+		&ns.ID,
+		&ns.Name,
+		&labelsJSON,
+		&annotationsJSON,
+		&specJSON,
+		&statusJSON,
+		&ns.CreatedAt,
+		&ns.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse JSON fields
+	if err := fromJSON(labelsJSON, &ns.Labels); err != nil {
+		return nil, err
+	}
+	if err := fromJSON(annotationsJSON, &ns.Annotations); err != nil {
+		return nil, err
+	}
+	if err := fromJSON(specJSON, &ns.Spec); err != nil {
+		return nil, err
+	}
+	if err := fromJSON(statusJSON, &ns.Status); err != nil {
+		return nil, err
+	}
+
+	return &ns, nil
+}
@@ -0,0 +1,280 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/codecflow/fabric/weaver/internal/node"
+	"github.com/codecflow/fabric/weaver/internal/repository"
+)
+
+// NodeRepository implements node.Repository
+type NodeRepository struct {
+	db *sql.DB
+}
+
+// NewNodeRepository creates a new node repository
+func NewNodeRepository(db *sql.DB) *NodeRepository {
+	return &NodeRepository{db: db}
+}
+
+// Create registers a new node
+func (r *NodeRepository) Create(ctx context.Context, n *node.Node) error {
+	query := `
+		INSERT INTO nodes (id, name, region, zone, address, agent_port, version, labels, taints, capacity, allocated, status, last_heartbeat, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		n.ID,
+		n.Name,
+		n.Region,
+		n.Zone,
+		n.Address,
+		n.AgentPort,
+		n.Version,
+		toJSON(n.Labels),
+		toJSON(n.Taints),
+		toJSON(n.Capacity),
+		toJSON(n.Allocated),
+		toJSON(n.Status),
+		n.LastHeartbeat,
+		n.CreatedAt,
+		n.UpdatedAt,
+	)
+
+	return err
+}
+
+// Get retrieves a node by ID
+func (r *NodeRepository) Get(ctx context.Context, id string) (*node.Node, error) {
+	query := `
+		SELECT id, name, region, zone, address, agent_port, version, labels, taints, capacity, allocated, status, last_heartbeat, created_at, updated_at
+		FROM nodes WHERE id = $1
+	`
+
+	n, err := scanNode(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, repository.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// Update updates what a node reports about itself, keeping its allocation
+func (r *NodeRepository) Update(ctx context.Context, n *node.Node) error {
+	query := `
+		UPDATE nodes
+		SET name = $2, region = $3, zone = $4, address = $5, agent_port = $6, version = $7, labels = $8, taints = $9,
+			capacity = $10, status = $11, last_heartbeat = $12, updated_at = $13
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		n.ID,
+		n.Name,
+		n.Region,
+		n.Zone,
+		n.Address,
+		n.AgentPort,
+		n.Version,
+		toJSON(n.Labels),
+		toJSON(n.Taints),
+		toJSON(n.Capacity),
+		toJSON(n.Status),
+		n.LastHeartbeat,
+		n.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// UpdateStatus updates the status of a node, leaving its heartbeat to the node
+func (r *NodeRepository) UpdateStatus(ctx context.Context, n *node.Node) error {
+	query := `UPDATE nodes SET status = $2, updated_at = $3 WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, n.ID, toJSON(n.Status), n.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// UpdateAllocated locks a node row for the duration of allocate and stores
+// the allocation it sets
+func (r *NodeRepository) UpdateAllocated(ctx context.Context, id string, allocate func(n *node.Node) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `
+		SELECT id, name, region, zone, address, agent_port, version, labels, taints, capacity, allocated, status, last_heartbeat, created_at, updated_at
+		FROM nodes WHERE id = $1 FOR UPDATE
+	`
+
+	n, err := scanNode(tx.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return repository.ErrNotFound
+		}
+		return err
+	}
+
+	if err := allocate(n); err != nil {
+		return err
+	}
+
+	n.UpdatedAt = time.Now()
+	if _, err := tx.ExecContext(ctx, `UPDATE nodes SET allocated = $2, updated_at = $3 WHERE id = $1`,
+		n.ID, toJSON(n.Allocated), n.UpdatedAt); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Delete removes a node from the registry
+func (r *NodeRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM nodes WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// List lists all registered nodes
+func (r *NodeRepository) List(ctx context.Context) ([]*node.Node, error) {
+	query := `
+		SELECT id, name, region, zone, address, agent_port, version, labels, taints, capacity, allocated, status, last_heartbeat, created_at, updated_at
+		FROM nodes ORDER BY name ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var nodes []*node.Node
+	for rows.Next() {
+		n, err := scanNode(rows)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+	}
+
+	return nodes, rows.Err()
+}
+
+// Heartbeat records the time a node last reported in
+func (r *NodeRepository) Heartbeat(ctx context.Context, id string, at time.Time) error {
+	query := `UPDATE nodes SET last_heartbeat = $2 WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id, at)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// rowScanner is satisfied by *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanNode reads a single node row
+func scanNode(row rowScanner) (*node.Node, error) {
+	var n node.Node
+	var labelsJSON, taintsJSON, capacityJSON, allocatedJSON, statusJSON []byte
+
+	err := row.Scan(
+		&n.ID,
+		&n.Name,
+		&n.Region,
+		&n.Zone,
+		&n.Address,
+		&n.AgentPort,
+		&n.Version,
+		&labelsJSON,
+		&taintsJSON,
+		&capacityJSON,
+		&allocatedJSON,
+		&statusJSON,
+		&n.LastHeartbeat,
+		&n.CreatedAt,
+		&n.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse JSON fields
+	if err := fromJSON(labelsJSON, &n.Labels); err != nil {
+		return nil, err
+	}
+	if err := fromJSON(taintsJSON, &n.Taints); err != nil {
+		return nil, err
+	}
+	if err := fromJSON(capacityJSON, &n.Capacity); err != nil {
+		return nil, err
+	}
+	if err := fromJSON(allocatedJSON, &n.Allocated); err != nil {
+		return nil, err
+	}
+	if err := fromJSON(statusJSON, &n.Status); err != nil {
+		return nil, err
+	}
+
+	return &n, nil
+}
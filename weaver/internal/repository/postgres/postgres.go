@@ -14,6 +14,11 @@ type Repository struct {
 	Workload  *WorkloadRepository
 	Namespace *NamespaceRepository
 	Secret    *SecretRepository
+	Node      *NodeRepository
+	Auth      *AuthRepository
+	Cron      *CronRepository
+	Lease     *LeaseRepository
+	Events    *EventRepository
 }
 
 // New creates a new PostgreSQL repository
@@ -32,6 +37,11 @@ func New(connectionString string) (*Repository, error) {
 		Workload:  NewWorkloadRepository(db),
 		Namespace: NewNamespaceRepository(db),
 		Secret:    NewSecretRepository(db),
+		Node:      NewNodeRepository(db),
+		Auth:      NewAuthRepository(db),
+		Cron:      NewCronRepository(db),
+		Lease:     NewLeaseRepository(db),
+		Events:    NewEventRepository(db, connectionString),
 	}
 
 	// Initialize schema
@@ -78,15 +88,101 @@ func (r *Repository) initSchema() error {
 		status JSONB,
 		labels JSONB,
 		annotations JSONB,
+		finalizers JSONB,
 		created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
 		updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+		deleted_at TIMESTAMP WITH TIME ZONE,
+		resource_version BIGINT NOT NULL DEFAULT 1,
 		FOREIGN KEY (namespace_id) REFERENCES namespaces(name),
 		UNIQUE(namespace_id, name)
 	);
 
+	CREATE TABLE IF NOT EXISTS nodes (
+		id VARCHAR(255) PRIMARY KEY,
+		name VARCHAR(255) NOT NULL,
+		region VARCHAR(255),
+		zone VARCHAR(255),
+		address VARCHAR(255),
+		agent_port INTEGER NOT NULL DEFAULT 0,
+		version VARCHAR(255),
+		labels JSONB,
+		taints JSONB,
+		capacity JSONB NOT NULL,
+		allocated JSONB,
+		status JSONB,
+		last_heartbeat TIMESTAMP WITH TIME ZONE,
+		created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+		updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+	);
+
+	CREATE TABLE IF NOT EXISTS api_tokens (
+		id VARCHAR(255) PRIMARY KEY,
+		name VARCHAR(255) NOT NULL,
+		principal VARCHAR(255) NOT NULL,
+		hash VARCHAR(64) NOT NULL,
+		created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+		expires_at TIMESTAMP WITH TIME ZONE,
+		revoked_at TIMESTAMP WITH TIME ZONE
+	);
+
+	CREATE TABLE IF NOT EXISTS role_bindings (
+		id VARCHAR(255) PRIMARY KEY,
+		principal VARCHAR(255) NOT NULL,
+		namespace VARCHAR(255) NOT NULL,
+		role VARCHAR(32) NOT NULL,
+		created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+		UNIQUE(principal, namespace, role)
+	);
+
+	CREATE TABLE IF NOT EXISTS cron_workloads (
+		id VARCHAR(255) PRIMARY KEY,
+		namespace_id VARCHAR(255) NOT NULL,
+		name VARCHAR(255) NOT NULL,
+		spec JSONB NOT NULL,
+		status JSONB,
+		labels JSONB,
+		annotations JSONB,
+		created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+		updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+		FOREIGN KEY (namespace_id) REFERENCES namespaces(name),
+		UNIQUE(namespace_id, name)
+	);
+
+	CREATE TABLE IF NOT EXISTS leases (
+		name VARCHAR(255) PRIMARY KEY,
+		holder VARCHAR(255) NOT NULL,
+		expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS workload_events (
+		version BIGSERIAL PRIMARY KEY,
+		type VARCHAR(32) NOT NULL,
+		workload JSONB NOT NULL,
+		created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+	);
+
+	ALTER TABLE nodes ADD COLUMN IF NOT EXISTS agent_port INTEGER NOT NULL DEFAULT 0;
+	ALTER TABLE workloads ADD COLUMN IF NOT EXISTS finalizers JSONB;
+	ALTER TABLE workloads ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+	ALTER TABLE workloads ADD COLUMN IF NOT EXISTS resource_version BIGINT NOT NULL DEFAULT 1;
+
 	CREATE INDEX IF NOT EXISTS idx_workloads_namespace ON workloads(namespace_id);
+	CREATE INDEX IF NOT EXISTS idx_workloads_phase ON workloads((status->>'phase'));
+	CREATE INDEX IF NOT EXISTS idx_workloads_node ON workloads((status->>'nodeId'));
+	CREATE INDEX IF NOT EXISTS idx_workloads_provider ON workloads((status->>'provider'));
+	CREATE INDEX IF NOT EXISTS idx_workloads_labels ON workloads USING GIN (labels);
+	CREATE INDEX IF NOT EXISTS idx_workloads_namespace_created ON workloads(namespace_id, created_at DESC, id DESC);
+	CREATE INDEX IF NOT EXISTS idx_workloads_created ON workloads(created_at DESC, id DESC);
 	CREATE INDEX IF NOT EXISTS idx_secrets_namespace ON secrets(namespace_id);
 	CREATE INDEX IF NOT EXISTS idx_namespaces_name ON namespaces(name);
+	CREATE INDEX IF NOT EXISTS idx_nodes_heartbeat ON nodes(last_heartbeat);
+	CREATE INDEX IF NOT EXISTS idx_api_tokens_principal ON api_tokens(principal);
+	CREATE INDEX IF NOT EXISTS idx_role_bindings_namespace ON role_bindings(namespace);
+	CREATE INDEX IF NOT EXISTS idx_cron_workloads_namespace ON cron_workloads(namespace_id);
+
+	INSERT INTO namespaces (id, name, labels, annotations, spec, status)
+	VALUES ('default', 'default', '{}', '{}', '{}', '{"phase": "Active", "usage": {"workloads": 0}}')
+	ON CONFLICT (name) DO NOTHING;
 	`
 
 	_, err := r.db.Exec(schema)
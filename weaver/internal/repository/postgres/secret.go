@@ -188,10 +188,11 @@ func (r *SecretRepository) Delete(ctx context.Context, namespace, name string) e
 func (r *SecretRepository) List(ctx context.Context, filter secret.Filter) (*secret.List, error) {
 	query := `
 		SELECT id, namespace_id, name, spec, status, labels, annotations, created_at, updated_at
-		FROM secrets WHERE namespace_id = $1 ORDER BY created_at DESC
+		FROM secrets WHERE namespace_id = $1 AND ($2 = '' OR spec->>'type' = $2)
+		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, filter.Namespace)
+	rows, err := r.db.QueryContext(ctx, query, filter.Namespace, string(filter.Type))
 	if err != nil {
 		return nil, err
 	}
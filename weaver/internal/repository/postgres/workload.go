@@ -3,6 +3,9 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
 
 	"github.com/codecflow/fabric/pkg/workload"
 	"github.com/codecflow/fabric/weaver/internal/repository"
@@ -18,14 +21,25 @@ func NewWorkloadRepository(db *sql.DB) *WorkloadRepository {
 	return &WorkloadRepository{db: db}
 }
 
-// Create creates a new workload
+// Create creates a new workload at its first resource version
 func (r *WorkloadRepository) Create(ctx context.Context, w *workload.Workload) error {
+	return insertWorkload(ctx, r.db, w)
+}
+
+// execer is satisfied by *sql.DB and *sql.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// insertWorkload stores a new workload at its first resource version
+func insertWorkload(ctx context.Context, db execer, w *workload.Workload) error {
 	query := `
-		INSERT INTO workloads (id, namespace_id, name, spec, status, labels, annotations, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO workloads (id, namespace_id, name, spec, status, labels, annotations, finalizers, created_at, updated_at, deleted_at, resource_version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 	`
 
-	_, err := r.db.ExecContext(ctx, query,
+	w.ResourceVersion = 1
+	_, err := db.ExecContext(ctx, query,
 		w.ID,
 		w.Namespace,
 		w.Name,
@@ -33,8 +47,11 @@ func (r *WorkloadRepository) Create(ctx context.Context, w *workload.Workload) e
 		toJSON(w.Status),
 		toJSON(w.Labels),
 		toJSON(w.Annotations),
+		toJSON(w.Finalizers),
 		w.CreatedAt,
 		w.UpdatedAt,
+		w.DeletedAt,
+		w.ResourceVersion,
 	)
 
 	return err
@@ -43,12 +60,12 @@ func (r *WorkloadRepository) Create(ctx context.Context, w *workload.Workload) e
 // Get retrieves a workload by ID
 func (r *WorkloadRepository) Get(ctx context.Context, id string) (*workload.Workload, error) {
 	query := `
-		SELECT id, namespace_id, name, spec, status, labels, annotations, created_at, updated_at
+		SELECT id, namespace_id, name, spec, status, labels, annotations, finalizers, created_at, updated_at, deleted_at, resource_version
 		FROM workloads WHERE id = $1
 	`
 
 	var w workload.Workload
-	var specJSON, statusJSON, labelsJSON, annotationsJSON []byte
+	var specJSON, statusJSON, labelsJSON, annotationsJSON, finalizersJSON []byte
 
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&w.ID,
@@ -58,8 +75,11 @@ func (r *WorkloadRepository) Get(ctx context.Context, id string) (*workload.Work
 		&statusJSON,
 		&labelsJSON,
 		&annotationsJSON,
+		&finalizersJSON,
 		&w.CreatedAt,
 		&w.UpdatedAt,
+		&w.DeletedAt,
+		&w.ResourceVersion,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -81,6 +101,9 @@ func (r *WorkloadRepository) Get(ctx context.Context, id string) (*workload.Work
 	if err := fromJSON(annotationsJSON, &w.Annotations); err != nil {
 		return nil, err
 	}
+	if err := fromJSON(finalizersJSON, &w.Finalizers); err != nil {
+		return nil, err
+	}
 
 	return &w, nil
 }
@@ -88,12 +111,12 @@ func (r *WorkloadRepository) Get(ctx context.Context, id string) (*workload.Work
 // GetByName retrieves a workload by namespace and name
 func (r *WorkloadRepository) GetByName(ctx context.Context, namespace, name string) (*workload.Workload, error) {
 	query := `
-		SELECT id, namespace_id, name, spec, status, labels, annotations, created_at, updated_at
+		SELECT id, namespace_id, name, spec, status, labels, annotations, finalizers, created_at, updated_at, deleted_at, resource_version
 		FROM workloads WHERE namespace_id = $1 AND name = $2
 	`
 
 	var w workload.Workload
-	var specJSON, statusJSON, labelsJSON, annotationsJSON []byte
+	var specJSON, statusJSON, labelsJSON, annotationsJSON, finalizersJSON []byte
 
 	err := r.db.QueryRowContext(ctx, query, namespace, name).Scan(
 		&w.ID,
@@ -103,8 +126,11 @@ func (r *WorkloadRepository) GetByName(ctx context.Context, namespace, name stri
 		&statusJSON,
 		&labelsJSON,
 		&annotationsJSON,
+		&finalizersJSON,
 		&w.CreatedAt,
 		&w.UpdatedAt,
+		&w.DeletedAt,
+		&w.ResourceVersion,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -126,41 +152,60 @@ func (r *WorkloadRepository) GetByName(ctx context.Context, namespace, name stri
 	if err := fromJSON(annotationsJSON, &w.Annotations); err != nil {
 		return nil, err
 	}
+	if err := fromJSON(finalizersJSON, &w.Finalizers); err != nil {
+		return nil, err
+	}
 
 	return &w, nil
 }
 
-// Update updates an existing workload
+// Update updates an existing workload if it is still at the resource version
+// it was read at, and advances the version. Writers holding a stale copy get
+// ErrConflict instead of overwriting newer changes, such as a deletion.
 func (r *WorkloadRepository) Update(ctx context.Context, w *workload.Workload) error {
 	query := `
-		UPDATE workloads 
-		SET spec = $3, status = $4, labels = $5, annotations = $6, updated_at = $7
-		WHERE namespace_id = $1 AND name = $2
+		UPDATE workloads
+		SET spec = $3, status = $4, labels = $5, annotations = $6, finalizers = $7, updated_at = $8, deleted_at = $9,
+			resource_version = resource_version + 1
+		WHERE namespace_id = $1 AND name = $2 AND resource_version = $10
+		RETURNING resource_version
 	`
 
-	result, err := r.db.ExecContext(ctx, query,
+	err := r.db.QueryRowContext(ctx, query,
 		w.Namespace,
 		w.Name,
 		toJSON(w.Spec),
 		toJSON(w.Status),
 		toJSON(w.Labels),
 		toJSON(w.Annotations),
+		toJSON(w.Finalizers),
 		w.UpdatedAt,
-	)
-	if err != nil {
-		return err
+		w.DeletedAt,
+		w.ResourceVersion,
+	).Scan(&w.ResourceVersion)
+	if err == sql.ErrNoRows {
+		return r.missing(ctx, w)
 	}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return err
-	}
+	return err
+}
 
-	if rowsAffected == 0 {
+// missing tells apart a workload that was changed since it was read from one
+// that no longer exists
+func (r *WorkloadRepository) missing(ctx context.Context, w *workload.Workload) error {
+	var version int64
+	err := r.db.QueryRowContext(ctx,
+		`SELECT resource_version FROM workloads WHERE namespace_id = $1 AND name = $2`,
+		w.Namespace, w.Name,
+	).Scan(&version)
+	if err == sql.ErrNoRows {
 		return repository.ErrNotFound
 	}
+	if err != nil {
+		return err
+	}
 
-	return nil
+	return fmt.Errorf("%w: workload %s/%s is at version %d, not %d", repository.ErrConflict, w.Namespace, w.Name, version, w.ResourceVersion)
 }
 
 // Delete deletes a workload
@@ -184,24 +229,103 @@ func (r *WorkloadRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// List lists workloads with optional filtering
-func (r *WorkloadRepository) List(ctx context.Context, namespace string, filters map[string]string) ([]*workload.Workload, error) {
+// List lists every workload matching the filter, newest first
+func (r *WorkloadRepository) List(ctx context.Context, filter workload.Filter) ([]*workload.Workload, error) {
+	workloads, _, err := r.ListPage(ctx, filter, 0, "")
+	return workloads, err
+}
+
+// ListPage lists up to limit workloads matching the filter, newest first,
+// continuing after the workload a continue token points at. A limit of zero
+// lists all of them.
+func (r *WorkloadRepository) ListPage(ctx context.Context, filter workload.Filter, limit int, token string) ([]*workload.Workload, string, error) {
+	var q query
+	if err := q.filterWorkloads(filter); err != nil {
+		return nil, "", err
+	}
+
+	if token != "" {
+		after, err := decodeContinueToken(token)
+		if err != nil {
+			return nil, "", err
+		}
+		q.where("(created_at, id) < (%s, %s)", q.arg(after.CreatedAt), q.arg(after.ID))
+	}
+
+	statement := `
+		SELECT id, namespace_id, name, spec, status, labels, annotations, finalizers, created_at, updated_at, deleted_at, resource_version
+		FROM workloads ` + q.clause() + `
+		ORDER BY created_at DESC, id DESC
+	`
+	// One extra row tells whether another page follows
+	if limit > 0 {
+		statement += " LIMIT " + q.arg(limit+1)
+	}
+
+	rows, err := r.db.QueryContext(ctx, statement, q.args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() { _ = rows.Close() }()
+
+	workloads, err := scanWorkloads(rows)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if limit <= 0 || len(workloads) <= limit {
+		return workloads, "", nil
+	}
+
+	workloads = workloads[:limit]
+	last := workloads[limit-1]
+	return workloads, continueToken{CreatedAt: last.CreatedAt, ID: last.ID}.encode(), nil
+}
+
+// ListByPhase lists workloads across all namespaces in any of the given phases
+func (r *WorkloadRepository) ListByPhase(ctx context.Context, phases ...workload.Phase) ([]*workload.Workload, error) {
+	query := `
+		SELECT id, namespace_id, name, spec, status, labels, annotations, finalizers, created_at, updated_at, deleted_at, resource_version
+		FROM workloads WHERE status->>'phase' = ANY($1) ORDER BY created_at ASC
+	`
+
+	values := make([]string, len(phases))
+	for i, phase := range phases {
+		values[i] = string(phase)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(values))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanWorkloads(rows)
+}
+
+// ListByNode lists workloads placed on the given node
+func (r *WorkloadRepository) ListByNode(ctx context.Context, nodeID string) ([]*workload.Workload, error) {
 	query := `
-		SELECT id, namespace_id, name, spec, status, labels, annotations, created_at, updated_at
-		FROM workloads WHERE namespace_id = $1 ORDER BY created_at DESC
+		SELECT id, namespace_id, name, spec, status, labels, annotations, finalizers, created_at, updated_at, deleted_at, resource_version
+		FROM workloads WHERE status->>'nodeId' = $1 ORDER BY created_at ASC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, namespace)
+	rows, err := r.db.QueryContext(ctx, query, nodeID)
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = rows.Close() }()
 
+	return scanWorkloads(rows)
+}
+
+// scanWorkloads reads all workload rows from a query result
+func scanWorkloads(rows *sql.Rows) ([]*workload.Workload, error) {
 	var workloads []*workload.Workload
 
 	for rows.Next() {
 		var w workload.Workload
-		var specJSON, statusJSON, labelsJSON, annotationsJSON []byte
+		var specJSON, statusJSON, labelsJSON, annotationsJSON, finalizersJSON []byte
 
 		err := rows.Scan(
 			&w.ID,
@@ -211,8 +335,11 @@ func (r *WorkloadRepository) List(ctx context.Context, namespace string, filters
 			&statusJSON,
 			&labelsJSON,
 			&annotationsJSON,
+			&finalizersJSON,
 			&w.CreatedAt,
 			&w.UpdatedAt,
+			&w.DeletedAt,
+			&w.ResourceVersion,
 		)
 		if err != nil {
 			return nil, err
@@ -231,6 +358,9 @@ func (r *WorkloadRepository) List(ctx context.Context, namespace string, filters
 		if err := fromJSON(annotationsJSON, &w.Annotations); err != nil {
 			return nil, err
 		}
+		if err := fromJSON(finalizersJSON, &w.Finalizers); err != nil {
+			return nil, err
+		}
 
 		workloads = append(workloads, &w)
 	}
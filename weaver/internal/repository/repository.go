@@ -6,15 +6,30 @@ import (
 
 	"github.com/codecflow/fabric/pkg/secret"
 	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/auth"
+	"github.com/codecflow/fabric/weaver/internal/cron"
+	"github.com/codecflow/fabric/weaver/internal/lease"
 	"github.com/codecflow/fabric/weaver/internal/namespace"
+	"github.com/codecflow/fabric/weaver/internal/node"
 )
 
 var ErrNotFound = errors.New("resource not found")
 
+// ErrConflict is returned when a resource changed since it was read, so an
+// update would overwrite newer changes
+var ErrConflict = errors.New("resource was modified concurrently")
+
+// ErrInvalidContinueToken is returned for a continue token the repository did not issue
+var ErrInvalidContinueToken = errors.New("invalid continue token")
+
 type Repository struct {
 	Workload  workload.Repository
 	Namespace namespace.Repository
 	Secret    secret.Repository
+	Node      node.Repository
+	Auth      auth.Repository
+	Cron      cron.Repository
+	Lease     lease.Repository
 }
 
 // HealthCheck checks the health of the repository
@@ -5,9 +5,14 @@ import (
 
 	"github.com/codecflow/fabric/pkg/metering"
 	"github.com/codecflow/fabric/pkg/network"
+	"github.com/codecflow/fabric/pkg/secret"
+	"github.com/codecflow/fabric/weaver/internal/auth"
+	"github.com/codecflow/fabric/weaver/internal/metrics"
 	"github.com/codecflow/fabric/weaver/internal/proxy"
+	"github.com/codecflow/fabric/weaver/internal/queue"
 	"github.com/codecflow/fabric/weaver/internal/repository"
 	"github.com/codecflow/fabric/weaver/internal/storage"
+	"github.com/codecflow/fabric/weaver/internal/watch"
 
 	// todo: service should separated.
 	"github.com/codecflow/fabric/weaver/services/provider"
@@ -24,12 +29,21 @@ type State struct {
 	Network    network.Network
 	Scheduler  scheduler.Scheduler
 	Proxy      *proxy.Server
+	Queue      *queue.Queue     // pending workloads waiting to be scheduled
+	Watch      *watch.Hub       // workload changes for WatchWorkloads
+	Secrets    *secret.Manager  // nil when no encryption key is configured
+	Auth       *auth.Authorizer // nil when API authentication is disabled
+	Metrics    *metrics.Registry
 	Providers  map[string]provider.Provider
+
+	// RevealSecrets allows secret values to be returned over the API
+	RevealSecrets bool
 }
 
 // New creates a new State instance
 func New() *State {
 	return &State{
+		Metrics:   metrics.NewRegistry(),
 		Providers: make(map[string]provider.Provider),
 	}
 }
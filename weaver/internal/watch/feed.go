@@ -0,0 +1,174 @@
+package watch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/codecflow/fabric/pkg/workload"
+)
+
+const (
+	// pollInterval bounds how late a change arrives when a notification is lost
+	pollInterval = 5 * time.Second
+	// pruneInterval is how often changes beyond the retention are removed from the log
+	pruneInterval = time.Minute
+	// retainedChanges is how many changes the log keeps for hubs that start or fall behind
+	retainedChanges = 10 * defaultHistory
+	// readBatch is how many changes are read from the log at once
+	readBatch = 500
+)
+
+// Log is the record of workload changes shared by all Weaver replicas.
+// Versions increase with every change and are committed in order.
+type Log interface {
+	// Append records a change and returns its version
+	Append(ctx context.Context, eventType EventType, w *workload.Workload) (uint64, error)
+	// Since returns up to limit changes after version, oldest first
+	Since(ctx context.Context, version uint64, limit int) ([]Event, error)
+	// Latest returns the version of the last change
+	Latest(ctx context.Context) (uint64, error)
+	// Prune removes the changes up to and including version
+	Prune(ctx context.Context, version uint64) error
+	// Listen signals whenever another change may have been appended, until ctx is done
+	Listen(ctx context.Context) (<-chan struct{}, error)
+}
+
+// Feed records workload changes in a shared log and delivers the log to a
+// hub, so watchers on every replica see the changes stored by any of them
+// under the same versions
+type Feed struct {
+	log    Log
+	hub    *Hub
+	logger *logrus.Logger
+
+	wake   chan struct{}
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewFeed creates a feed from log into hub
+func NewFeed(log Log, hub *Hub, logger *logrus.Logger) *Feed {
+	return &Feed{
+		log:    log,
+		hub:    hub,
+		logger: logger,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// Publish records a change in the log. The change reaches the hub once the
+// feed reads it back, like the changes of other replicas.
+func (f *Feed) Publish(ctx context.Context, eventType EventType, w *workload.Workload) {
+	// The change is stored already, so it is recorded even if the caller gave up
+	if _, err := f.log.Append(context.WithoutCancel(ctx), eventType, w); err != nil {
+		f.logger.Warnf("Failed to record change of workload %s/%s for watchers: %v", w.Namespace, w.Name, err)
+		return
+	}
+
+	select {
+	case f.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Start loads the retained changes into the hub and delivers new ones until
+// Stop is called or ctx is cancelled
+func (f *Feed) Start(ctx context.Context) {
+	ctx, f.cancel = context.WithCancel(ctx)
+
+	last := f.load(ctx)
+
+	notify, err := f.log.Listen(ctx)
+	if err != nil {
+		f.logger.Warnf("Failed to listen for workload changes, polling every %s: %v", pollInterval, err)
+	}
+
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+
+		poll := time.NewTicker(pollInterval)
+		defer poll.Stop()
+		prune := time.NewTicker(pruneInterval)
+		defer prune.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-notify:
+			case <-f.wake:
+			case <-poll.C:
+			case <-prune.C:
+				f.prune(ctx, last)
+				continue
+			}
+			last = f.read(ctx, last)
+		}
+	}()
+}
+
+// Stop stops delivering changes and waits for the feed to exit
+func (f *Feed) Stop() {
+	if f.cancel != nil {
+		f.cancel()
+	}
+	f.wg.Wait()
+}
+
+// load replays the last changes of the log into the hub, so watches can resume
+// across restarts, and returns the version the feed continues from
+func (f *Feed) load(ctx context.Context) uint64 {
+	latest, err := f.log.Latest(ctx)
+	if err != nil {
+		f.logger.Warnf("Failed to read the latest workload change: %v", err)
+		return 0
+	}
+
+	from := uint64(0)
+	if latest > uint64(f.hub.size) {
+		from = latest - uint64(f.hub.size)
+	}
+
+	last := f.read(ctx, from)
+	f.hub.seek(latest)
+	if last < latest {
+		last = latest
+	}
+	return last
+}
+
+// read delivers the changes after last to the hub and returns the last version read
+func (f *Feed) read(ctx context.Context, last uint64) uint64 {
+	for {
+		events, err := f.log.Since(ctx, last, readBatch)
+		if err != nil {
+			if ctx.Err() == nil {
+				f.logger.Warnf("Failed to read workload changes: %v", err)
+			}
+			return last
+		}
+
+		for _, event := range events {
+			f.hub.publishAt(event)
+			last = event.Version
+		}
+
+		if len(events) < readBatch {
+			return last
+		}
+	}
+}
+
+// prune removes the changes no hub needs any more from the log
+func (f *Feed) prune(ctx context.Context, last uint64) {
+	if last <= retainedChanges {
+		return
+	}
+	if err := f.log.Prune(ctx, last-retainedChanges); err != nil {
+		f.logger.Warnf("Failed to prune workload changes: %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package watch
+
+import (
+	"context"
+
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/namespace"
+)
+
+// Repository publishes every stored workload change to a feed, so watchers see
+// exactly the changes written to the underlying repository
+type Repository struct {
+	workload.Repository
+	feed *Feed
+}
+
+// NewRepository wraps a workload repository
+func NewRepository(repo workload.Repository, feed *Feed) *Repository {
+	return &Repository{
+		Repository: repo,
+		feed:       feed,
+	}
+}
+
+// Create stores a workload and publishes an ADDED event
+func (r *Repository) Create(ctx context.Context, w *workload.Workload) error {
+	if err := r.Repository.Create(ctx, w); err != nil {
+		return err
+	}
+	r.feed.Publish(ctx, Added, w)
+	return nil
+}
+
+// Update stores a workload and publishes a MODIFIED event
+func (r *Repository) Update(ctx context.Context, w *workload.Workload) error {
+	if err := r.Repository.Update(ctx, w); err != nil {
+		return err
+	}
+	r.feed.Publish(ctx, Modified, w)
+	return nil
+}
+
+// Delete removes a workload and publishes a DELETED event with its last state
+func (r *Repository) Delete(ctx context.Context, id string) error {
+	w, err := r.Repository.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := r.Repository.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.feed.Publish(ctx, Deleted, w)
+	return nil
+}
+
+// NamespaceRepository publishes the workloads admitted to namespaces, which
+// are stored by the namespace repository
+type NamespaceRepository struct {
+	namespace.Repository
+	feed *Feed
+}
+
+// NewNamespaceRepository wraps a namespace repository
+func NewNamespaceRepository(repo namespace.Repository, feed *Feed) *NamespaceRepository {
+	return &NamespaceRepository{
+		Repository: repo,
+		feed:       feed,
+	}
+}
+
+// Admit stores an admitted workload and publishes an ADDED event
+func (r *NamespaceRepository) Admit(ctx context.Context, w *workload.Workload, admit func(ns *namespace.Namespace, counted []*workload.Workload) error) error {
+	if err := r.Repository.Admit(ctx, w, admit); err != nil {
+		return err
+	}
+	r.feed.Publish(ctx, Added, w)
+	return nil
+}
@@ -0,0 +1,181 @@
+package watch
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/codecflow/fabric/pkg/workload"
+)
+
+const (
+	defaultHistory   = 1000
+	subscriberBuffer = 256
+)
+
+// ErrExpired is returned when a watch resumes from a version that is no longer
+// in the history, for example after Weaver restarted. Clients start over with
+// a fresh snapshot.
+var ErrExpired = errors.New("resource version is too old")
+
+// EventType describes a change to a workload
+type EventType string
+
+const (
+	Added    EventType = "ADDED"
+	Modified EventType = "MODIFIED"
+	Deleted  EventType = "DELETED"
+)
+
+// Event is a workload change. Versions increase by one with every change.
+type Event struct {
+	Type     EventType
+	Version  uint64
+	Workload *workload.Workload
+}
+
+// Hub fans workload changes out to watchers and keeps a bounded history of
+// recent changes so watchers can resume after reconnecting
+type Hub struct {
+	mu          sync.Mutex
+	version     uint64
+	history     []Event
+	size        int
+	subscribers map[*Subscription]struct{}
+}
+
+// NewHub creates a hub keeping the given number of changes. A zero history
+// uses the default.
+func NewHub(history int) *Hub {
+	if history <= 0 {
+		history = defaultHistory
+	}
+
+	return &Hub{
+		size:        history,
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscription receives the changes published after it was created
+type Subscription struct {
+	// Version is the hub version when the subscription was created
+	Version uint64
+
+	events chan Event
+}
+
+// Events returns the change channel. It is closed when the subscriber falls too
+// far behind and must resume from its last seen version.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Publish records a change and delivers it to all subscribers
+func (h *Hub) Publish(eventType EventType, w *workload.Workload) {
+	// Callers keep mutating their workloads after storing them
+	w = clone(w)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.deliver(Event{Type: eventType, Version: h.version + 1, Workload: w})
+}
+
+// publishAt delivers a change numbered by a shared log. Changes the hub has
+// already seen are ignored.
+func (h *Hub) publishAt(event Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if event.Version > h.version {
+		h.deliver(event)
+	}
+}
+
+// seek moves the hub to the version of a shared log without recording changes
+func (h *Hub) seek(version uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if version > h.version {
+		h.version = version
+	}
+}
+
+// deliver records a change and hands it to all subscribers; h.mu must be held
+func (h *Hub) deliver(event Event) {
+	h.version = event.Version
+
+	h.history = append(h.history, event)
+	if len(h.history) > h.size {
+		h.history = h.history[len(h.history)-h.size:]
+	}
+
+	for s := range h.subscribers {
+		select {
+		case s.events <- event:
+		default:
+			// Dropping the subscriber makes it resume instead of silently missing changes
+			close(s.events)
+			delete(h.subscribers, s)
+		}
+	}
+}
+
+// Subscribe starts a subscription. A zero version subscribes from now; otherwise
+// the changes after version are returned for replay.
+func (h *Hub) Subscribe(version uint64) ([]Event, *Subscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var backlog []Event
+	if version > 0 {
+		if version > h.version {
+			return nil, nil, ErrExpired
+		}
+		if version < h.version && (len(h.history) == 0 || h.history[0].Version > version+1) {
+			return nil, nil, ErrExpired
+		}
+
+		for _, event := range h.history {
+			if event.Version > version {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+
+	s := &Subscription{
+		Version: h.version,
+		events:  make(chan Event, subscriberBuffer),
+	}
+	h.subscribers[s] = struct{}{}
+
+	return backlog, s, nil
+}
+
+// Unsubscribe stops a subscription
+func (h *Hub) Unsubscribe(s *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subscribers[s]; ok {
+		close(s.events)
+		delete(h.subscribers, s)
+	}
+}
+
+// clone deep-copies a workload so published events are immutable
+func clone(w *workload.Workload) *workload.Workload {
+	data, err := json.Marshal(w)
+	if err != nil {
+		copied := *w
+		return &copied
+	}
+
+	var copied workload.Workload
+	if err := json.Unmarshal(data, &copied); err != nil {
+		copied = *w
+	}
+	return &copied
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"os"
 	"os/signal"
@@ -11,14 +12,24 @@ import (
 	"github.com/sirupsen/logrus" // todo: for consistency use zerolog instead.
 
 	"github.com/codecflow/fabric/pkg/config"
+	"github.com/codecflow/fabric/pkg/secret"
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/auth"
+	"github.com/codecflow/fabric/weaver/internal/controller"
 	"github.com/codecflow/fabric/weaver/internal/grpc"
 	"github.com/codecflow/fabric/weaver/internal/proxy"
+	"github.com/codecflow/fabric/weaver/internal/queue"
 	"github.com/codecflow/fabric/weaver/internal/repository"
 	"github.com/codecflow/fabric/weaver/internal/repository/postgres"
 	"github.com/codecflow/fabric/weaver/internal/state"
+	"github.com/codecflow/fabric/weaver/internal/watch"
+	"github.com/codecflow/fabric/weaver/services/provider"
+	"github.com/codecflow/fabric/weaver/services/provider/fabric"
 	"github.com/codecflow/fabric/weaver/services/provider/fly"
 	"github.com/codecflow/fabric/weaver/services/provider/kubernetes"
 	"github.com/codecflow/fabric/weaver/services/provider/nosana"
+	"github.com/codecflow/fabric/weaver/services/scheduler"
+	"github.com/codecflow/fabric/weaver/services/scheduler/framework"
 	"github.com/codecflow/fabric/weaver/services/scheduler/simple"
 	"github.com/codecflow/fabric/weaver/services/stream/nats"
 )
@@ -38,6 +49,7 @@ func main() { // nolint:gocyclo
 	appState := state.New()
 
 	// Initialize repository
+	var watchFeed *watch.Feed
 	if cfg.Database.Host != "" {
 		connStr := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
 			cfg.Database.Host, cfg.Database.Port, cfg.Database.Username,
@@ -47,15 +59,50 @@ func main() { // nolint:gocyclo
 		if err != nil {
 			logger.Warnf("Failed to initialize PostgreSQL repository: %v", err)
 		} else {
+			// Workload changes are recorded in the database as they are stored
+			// and fed to the watchers of every replica
+			appState.Watch = watch.NewHub(0)
+			watchFeed = watch.NewFeed(pgRepo.Events, appState.Watch, logger)
+			watchFeed.Start(context.Background())
 			appState.Repository = &repository.Repository{
-				Workload:  pgRepo.Workload,
-				Namespace: pgRepo.Namespace,
+				Workload:  watch.NewRepository(pgRepo.Workload, watchFeed),
+				Namespace: watch.NewNamespaceRepository(pgRepo.Namespace, watchFeed),
 				Secret:    pgRepo.Secret,
+				Node:      pgRepo.Node,
+				Auth:      pgRepo.Auth,
+				Cron:      pgRepo.Cron,
+				Lease:     pgRepo.Lease,
 			}
 			logger.Info("PostgreSQL repository initialized")
 		}
 	}
 
+	// Initialize secret management
+	if appState.Repository != nil {
+		if cfg.Secrets.EncryptionKey == "" {
+			logger.Warn("SECRETS_ENCRYPTION_KEY not set, secret management disabled")
+		} else if encryptor, err := secret.NewEncryptorFromBase64(cfg.Secrets.EncryptionKey); err != nil {
+			logger.Fatalf("Invalid secret encryption key: %v", err)
+		} else {
+			appState.Secrets = secret.NewManager(appState.Repository.Secret, encryptor)
+			appState.RevealSecrets = cfg.Secrets.AllowReveal
+			logger.Info("Secret manager initialized")
+		}
+	}
+
+	// Initialize API authentication
+	if cfg.Auth.Enabled {
+		var tokens auth.Repository
+		if appState.Repository != nil {
+			tokens = appState.Repository.Auth
+		}
+		appState.Auth = auth.New(tokens, cfg.Auth.BootstrapToken, cfg.Auth.Admins)
+		if cfg.Auth.BootstrapToken == "" && len(cfg.Auth.Admins) == 0 {
+			logger.Warn("AUTH_BOOTSTRAP_TOKEN and AUTH_ADMINS not set, only existing role bindings grant access")
+		}
+		logger.Info("API authentication enabled")
+	}
+
 	// Initialize NATS stream
 	if cfg.NATS.URL != "" {
 		stream, err := nats.New(cfg.NATS.URL)
@@ -109,9 +156,42 @@ func main() { // nolint:gocyclo
 		}
 	}
 
+	// Self-hosted Shuttle nodes are tracked in the node registry
+	if cfg.Providers.Fabric.Enabled && appState.Repository != nil {
+		// Without a certificate, workloads on nodes cannot be inspected through their agents
+		var agentTLS *tls.Config
+		if fabricCfg := cfg.Providers.Fabric; fabricCfg.AgentCertFile != "" {
+			agentTLS, err = fabric.LoadAgentTLS(fabricCfg.AgentCertFile, fabricCfg.AgentKeyFile, fabricCfg.AgentCAFile)
+			if err != nil {
+				logger.Fatalf("Failed to load Fabric agent certificate: %v", err)
+			}
+		}
+
+		fabricProvider, err := fabric.New("fabric", appState.Repository.Node, appState.Repository.Workload, agentTLS)
+		if err != nil {
+			logger.Warnf("Failed to initialize Fabric provider: %v", err)
+		} else {
+			appState.Providers["fabric"] = fabricProvider
+			logger.Info("Fabric provider initialized")
+		}
+	}
+
+	// Providers resolve secret references at provision time
+	if appState.Secrets != nil {
+		for _, p := range appState.Providers {
+			if aware, ok := p.(provider.SecretAware); ok {
+				aware.SetSecretSource(appState.Secrets)
+			}
+		}
+	}
+
 	// Initialize scheduler with providers
-	scheduler := simple.New(appState.Providers, nil)
-	appState.Scheduler = scheduler
+	var workloads workload.Repository
+	if appState.Repository != nil {
+		workloads = appState.Repository.Workload
+	}
+	appState.Scheduler = newScheduler(&cfg.Scheduler, appState.Providers, workloads)
+	logger.Infof("Scheduler %s initialized", cfg.Scheduler.Type)
 
 	// Initialize proxy server
 	if cfg.Proxy.Enabled {
@@ -128,13 +208,55 @@ func main() { // nolint:gocyclo
 		logger.Infof("Proxy server started on port %d", cfg.Proxy.Port)
 	}
 
+	// Start workload controller
+	var workloadController *controller.WorkloadController
+	if cfg.Controller.Enabled && appState.Repository != nil {
+		// Pending workloads are only retried while the controller runs
+		appState.Queue = queue.New(time.Duration(cfg.Controller.MaxBackoff) * time.Second)
+		workloadController = controller.New(appState, logger, &cfg.Controller)
+		workloadController.Start(context.Background())
+		logger.Info("Workload controller started")
+	}
+
+	// Start node controller
+	var nodeController *controller.NodeController
+	if cfg.Controller.Enabled && appState.Repository != nil {
+		nodeController = controller.NewNodeController(appState, logger, &cfg.Controller)
+		nodeController.Start(context.Background())
+		logger.Info("Node controller started")
+	}
+
+	// Start orphan collector
+	var orphanCollector *controller.OrphanCollector
+	if cfg.Controller.Enabled && cfg.Controller.OrphanInterval > 0 && appState.Repository != nil {
+		orphanCollector = controller.NewOrphanCollector(appState, logger, &cfg.Controller)
+		orphanCollector.Start(context.Background())
+		logger.Info("Orphan collector started")
+	}
+
 	// Create gRPC server
 	grpcServer := grpc.NewServer(appState, logger)
 
+	// Start cron controller; runs are admitted like workloads created over the API
+	var cronController *controller.CronController
+	if cfg.Controller.Enabled && appState.Repository != nil {
+		cronController = controller.NewCronController(appState, logger, &cfg.Controller, grpcServer.Workloads())
+		cronController.Start(context.Background())
+		logger.Info("Cron controller started")
+	}
+
+	var tlsConfig *tls.Config
+	if cfg.Server.TLSCertFile != "" {
+		tlsConfig, err = grpc.LoadTLSConfig(cfg.Server.TLSCertFile, cfg.Server.TLSKeyFile, cfg.Server.ClientCAFile)
+		if err != nil {
+			logger.Fatalf("Failed to load TLS config: %v", err)
+		}
+	}
+
 	// Start gRPC server in a goroutine
 	go func() {
 		logger.Infof("Starting Weaver gRPC server on %s", cfg.Server.Address)
-		if err := grpcServer.Start(cfg.Server.Address); err != nil {
+		if err := grpcServer.Start(cfg.Server.Address, tlsConfig); err != nil {
 			logger.Fatalf("gRPC server failed to start: %v", err)
 		}
 	}()
@@ -148,6 +270,31 @@ func main() { // nolint:gocyclo
 	// Gracefully stop gRPC server
 	grpcServer.Stop()
 
+	// Stop workload controller
+	if workloadController != nil {
+		workloadController.Stop()
+	}
+
+	// Stop node controller
+	if nodeController != nil {
+		nodeController.Stop()
+	}
+
+	// Stop orphan collector
+	if orphanCollector != nil {
+		orphanCollector.Stop()
+	}
+
+	// Stop cron controller
+	if cronController != nil {
+		cronController.Stop()
+	}
+
+	// Stop feeding workload changes to watchers
+	if watchFeed != nil {
+		watchFeed.Stop()
+	}
+
 	// Stop proxy server if running
 	if appState.Proxy != nil {
 		if err := appState.Proxy.Stop(); err != nil {
@@ -162,3 +309,37 @@ func main() { // nolint:gocyclo
 
 	logger.Info("Server exited gracefully")
 }
+
+// newScheduler creates the scheduler selected in the config
+func newScheduler(cfg *config.SchedulerConfig, providers map[string]provider.Provider, workloads workload.Repository) scheduler.Scheduler {
+	if cfg.Type != "framework" {
+		return simple.New(providers, workloads, nil)
+	}
+
+	policy := scheduler.SchedulingPolicy{
+		Strategy:           scheduler.SchedulingStrategy(cfg.Strategy),
+		CostWeight:         cfg.CostWeight,
+		PerformanceWeight:  cfg.PerformanceWeight,
+		ReliabilityWeight:  cfg.ReliabilityWeight,
+		LatencyWeight:      cfg.LatencyWeight,
+		PreferredProviders: cfg.PreferredProviders,
+		ExcludedProviders:  cfg.ExcludedProviders,
+		RequireGPU:         cfg.RequireGPU,
+	}
+	if cfg.MaxCostPerHour > 0 {
+		policy.MaxCostPerHour = &cfg.MaxCostPerHour
+	}
+	if cfg.MinCPUCores > 0 {
+		policy.MinCPUCores = &cfg.MinCPUCores
+	}
+	if cfg.MinMemoryGB > 0 {
+		policy.MinMemoryGB = &cfg.MinMemoryGB
+	}
+
+	return framework.New(providers, workloads, &scheduler.SchedulerConfig{
+		DefaultPolicy:      policy,
+		MaxAlternatives:    3,
+		ScheduleTimeout:    30 * time.Second,
+		CostUpdateInterval: 5 * time.Minute,
+	})
+}
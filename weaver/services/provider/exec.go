@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"io"
+
+	"github.com/codecflow/fabric/pkg/workload"
+)
+
+// ExecOptions describes a command to run in a workload container
+type ExecOptions struct {
+	Container string // resolved container name, the workload name for the main container
+	Command   []string
+	TTY       bool
+	Stdin     bool
+}
+
+// TerminalSize is the size of an exec terminal in characters
+type TerminalSize struct {
+	Width  uint16
+	Height uint16
+}
+
+// ExecStreams connects a command to its caller. Stdin is nil unless requested
+// and Resize only delivers sizes for TTY sessions.
+type ExecStreams struct {
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	Resize <-chan TerminalSize
+}
+
+// ExecProvider is implemented by providers that can run commands in workloads
+type ExecProvider interface {
+	// Exec runs a command until it exits and returns its exit code
+	Exec(ctx context.Context, w *workload.Workload, opts ExecOptions, streams ExecStreams) (int, error)
+}
+
+// PortForwardProvider is implemented by providers that can connect to ports of
+// workloads
+type PortForwardProvider interface {
+	// PortForward connects conn to a port of the workload and copies data both
+	// ways until the workload side closes. A read error or EOF on conn closes
+	// the connection's write side.
+	PortForward(ctx context.Context, w *workload.Workload, port uint16, conn io.ReadWriter) error
+}
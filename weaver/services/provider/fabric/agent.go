@@ -0,0 +1,299 @@
+package fabric
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/codecflow/fabric/pkg/proto/weaver"
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/node"
+	"github.com/codecflow/fabric/weaver/services/provider"
+)
+
+// relayBufferSize is the largest chunk of input relayed in one message
+const relayBufferSize = 32 * 1024
+
+// StreamLogs streams workload logs from the agent of the node running it
+func (p *Provider) StreamLogs(ctx context.Context, w *workload.Workload, opts provider.LogOptions, emit func(provider.LogLine) error) error {
+	n, conn, err := p.dialWorkloadAgent(ctx, w)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	req := &weaver.AgentLogsRequest{
+		Namespace: w.Namespace,
+		Name:      w.Name,
+		Container: opts.Container,
+		Follow:    opts.Follow,
+		TailLines: opts.TailLines,
+	}
+	if !opts.Since.IsZero() {
+		req.Since = timestamppb.New(opts.Since)
+	}
+
+	stream, err := weaver.NewAgentServiceClient(conn).StreamLogs(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to stream logs from node %s: %w", n.ID, err)
+	}
+
+	for {
+		entry, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to receive logs from node %s: %w", n.ID, err)
+		}
+
+		line := provider.LogLine{Stream: entry.Stream, Text: entry.Line}
+		if entry.Timestamp != nil {
+			line.Time = entry.Timestamp.AsTime()
+		}
+		if err := emit(line); err != nil {
+			return err
+		}
+	}
+}
+
+// Exec runs a command in a workload through the agent of the node running it
+func (p *Provider) Exec(ctx context.Context, w *workload.Workload, opts provider.ExecOptions, streams provider.ExecStreams) (int, error) { // nolint:gocyclo
+	n, conn, err := p.dialWorkloadAgent(ctx, w)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := weaver.NewAgentServiceClient(conn).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to exec on node %s: %w", n.ID, err)
+	}
+
+	var mu sync.Mutex
+	send := func(req *weaver.ExecRequest) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return stream.Send(&weaver.AgentExecRequest{Request: req})
+	}
+
+	err = stream.Send(&weaver.AgentExecRequest{
+		Namespace: w.Namespace,
+		Name:      w.Name,
+		Request: &weaver.ExecRequest{Payload: &weaver.ExecRequest_Start{Start: &weaver.ExecStart{
+			Container: opts.Container,
+			Command:   opts.Command,
+			Tty:       opts.TTY,
+			Stdin:     streams.Stdin != nil,
+		}}},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to start exec on node %s: %w", n.ID, err)
+	}
+
+	if streams.Stdin != nil {
+		go func() {
+			buf := make([]byte, relayBufferSize)
+			for {
+				count, err := streams.Stdin.Read(buf)
+				if count > 0 {
+					data := append([]byte(nil), buf[:count]...)
+					if send(&weaver.ExecRequest{Payload: &weaver.ExecRequest_Stdin{Stdin: data}}) != nil {
+						return
+					}
+				}
+				if err != nil {
+					_ = send(&weaver.ExecRequest{Payload: &weaver.ExecRequest_CloseStdin{CloseStdin: true}})
+					return
+				}
+			}
+		}()
+	}
+
+	if streams.Resize != nil {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case size, ok := <-streams.Resize:
+					if !ok {
+						return
+					}
+					resize := &weaver.TerminalSize{Width: uint32(size.Width), Height: uint32(size.Height)}
+					if send(&weaver.ExecRequest{Payload: &weaver.ExecRequest_Resize{Resize: resize}}) != nil {
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return 0, fmt.Errorf("node %s ended exec without an exit code", n.ID)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to receive exec output from node %s: %w", n.ID, err)
+		}
+
+		switch payload := resp.Payload.(type) {
+		case *weaver.ExecResponse_Stdout:
+			if _, err := streams.Stdout.Write(payload.Stdout); err != nil {
+				return 0, err
+			}
+		case *weaver.ExecResponse_Stderr:
+			if _, err := streams.Stderr.Write(payload.Stderr); err != nil {
+				return 0, err
+			}
+		case *weaver.ExecResponse_Exit:
+			return int(payload.Exit.ExitCode), nil
+		}
+	}
+}
+
+// PortForward connects conn to a workload port through the agent of the node
+// running it
+func (p *Provider) PortForward(ctx context.Context, w *workload.Workload, port uint16, conn io.ReadWriter) error {
+	n, agentConn, err := p.dialWorkloadAgent(ctx, w)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = agentConn.Close() }()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := weaver.NewAgentServiceClient(agentConn).PortForward(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to forward port on node %s: %w", n.ID, err)
+	}
+
+	err = stream.Send(&weaver.AgentPortForwardRequest{
+		Namespace: w.Namespace,
+		Name:      w.Name,
+		Request: &weaver.PortForwardRequest{Payload: &weaver.PortForwardRequest_Start{Start: &weaver.PortForwardStart{
+			Port: uint32(port),
+		}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start port forward on node %s: %w", n.ID, err)
+	}
+
+	go func() {
+		buf := make([]byte, relayBufferSize)
+		for {
+			count, err := conn.Read(buf)
+			if count > 0 {
+				data := append([]byte(nil), buf[:count]...)
+				req := &weaver.AgentPortForwardRequest{Request: &weaver.PortForwardRequest{Payload: &weaver.PortForwardRequest_Data{Data: data}}}
+				if stream.Send(req) != nil {
+					return
+				}
+			}
+			if err != nil {
+				_ = stream.CloseSend()
+				return
+			}
+		}
+	}()
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to receive forwarded data from node %s: %w", n.ID, err)
+		}
+		if _, err := conn.Write(resp.Data); err != nil {
+			return err
+		}
+	}
+}
+
+// dialWorkloadAgent connects to the agent of the node running a workload
+func (p *Provider) dialWorkloadAgent(ctx context.Context, w *workload.Workload) (*node.Node, *grpc.ClientConn, error) {
+	if w.Status.NodeID == "" {
+		return nil, nil, provider.ErrNoProviderReference
+	}
+
+	n, err := p.nodes.Get(ctx, w.Status.NodeID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get node %s: %w", w.Status.NodeID, err)
+	}
+
+	conn, err := p.dialAgent(n)
+	if err != nil {
+		return nil, nil, err
+	}
+	return n, conn, nil
+}
+
+// dialAgent connects to the agent service of a node over mutual TLS. The
+// agent must present a certificate for the node ID, so a node cannot stand in
+// for another one.
+func (p *Provider) dialAgent(n *node.Node) (*grpc.ClientConn, error) {
+	if n.Address == "" || n.AgentPort == 0 {
+		return nil, fmt.Errorf("node %s does not serve an agent", n.ID)
+	}
+	if p.agentTLS == nil {
+		return nil, fmt.Errorf("agent access is not configured, set the Fabric agent certificate")
+	}
+
+	tlsConfig := p.agentTLS.Clone()
+	tlsConfig.ServerName = n.ID
+
+	address := net.JoinHostPort(n.Address, strconv.Itoa(int(n.AgentPort)))
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to agent of node %s: %w", n.ID, err)
+	}
+	return conn, nil
+}
+
+// LoadAgentTLS loads the client certificate Weaver presents to node agents and
+// the CA their certificates are verified against
+func LoadAgentTLS(certFile, keyFile, caFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load agent client certificate: %w", err)
+	}
+
+	pem, err := os.ReadFile(caFile) // nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("failed to read agent CA: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in agent CA %s", caFile)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
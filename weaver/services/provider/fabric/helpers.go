@@ -0,0 +1,115 @@
+package fabric
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/node"
+	"github.com/codecflow/fabric/weaver/services/provider"
+)
+
+// eligible reports whether a node satisfies the workload's placement constraints
+func eligible(n *node.Node, placement *workload.PlacementSpec) bool {
+	if !n.Schedulable() {
+		return false
+	}
+	if placement.Region != "" && placement.Region != n.Region {
+		return false
+	}
+	if placement.Zone != "" && placement.Zone != n.Zone {
+		return false
+	}
+	return n.MatchesLabels(placement.NodeLabels) && n.Tolerates(placement.Tolerations)
+}
+
+// allocated sums the requests of the active workloads on a node, excluding one workload ID
+func (p *Provider) allocated(ctx context.Context, nodeID, exclude string) (node.Quantity, error) {
+	workloads, err := p.workloads.ListByNode(ctx, nodeID)
+	if err != nil {
+		return node.Quantity{}, fmt.Errorf("failed to list workloads on node %s: %w", nodeID, err)
+	}
+
+	var total node.Quantity
+	for _, w := range workloads {
+		if w.ID == exclude {
+			continue
+		}
+
+		switch w.Status.Phase {
+		case workload.PhasePending, workload.PhaseScheduled, workload.PhaseRunning, workload.PhaseUnknown:
+		default:
+			continue
+		}
+
+		// Requests were validated when the workload was placed
+		requests, err := node.ParseRequests(w.Spec.Resources)
+		if err != nil {
+			continue
+		}
+		total = total.Add(requests)
+	}
+
+	return total, nil
+}
+
+// refreshAllocation recomputes and stores the resources allocated on a node
+func (p *Provider) refreshAllocation(ctx context.Context, nodeID, exclude string) error {
+	err := p.nodes.UpdateAllocated(ctx, nodeID, func(n *node.Node) error {
+		allocated, err := p.allocated(ctx, n.ID, exclude)
+		if err != nil {
+			return err
+		}
+		n.Allocated = node.FromQuantity(allocated)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update allocation of node %s: %w", nodeID, err)
+	}
+	return nil
+}
+
+// remaining scores how much of a node would stay free after placement; lower packs tighter
+func remaining(capacity, free node.Quantity) float64 {
+	score := 0.0
+	if capacity.MilliCPU > 0 {
+		score += float64(free.MilliCPU) / float64(capacity.MilliCPU)
+	}
+	if capacity.Memory > 0 {
+		score += float64(free.Memory) / float64(capacity.Memory)
+	}
+	if capacity.GPU > 0 {
+		score += float64(free.GPU) / float64(capacity.GPU)
+	}
+	return score
+}
+
+// nodeReference builds the provider reference of a workload placed on a node
+func nodeReference(n *node.Node, w *workload.Workload) *workload.ProviderReference {
+	return &workload.ProviderReference{
+		ExternalID: w.ID,
+		Name:       n.Name,
+		Metadata: map[string]string{
+			"nodeId":  n.ID,
+			"address": n.Address,
+		},
+	}
+}
+
+// pool builds a resource pool from formatted quantities
+func pool(total, available, used string) provider.ResourcePool {
+	return provider.ResourcePool{
+		Total:     total,
+		Available: available,
+		Used:      used,
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,399 @@
+package fabric
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/codecflow/fabric/pkg/quantity"
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/internal/node"
+	"github.com/codecflow/fabric/weaver/internal/repository"
+	"github.com/codecflow/fabric/weaver/services/provider"
+)
+
+const Type provider.ProviderType = "fabric"
+
+// errNodeFull is returned when a node no longer fits a workload once it is locked
+var errNodeFull = errors.New("node has no capacity left for the workload")
+
+// Provider implements the Provider interface for self-hosted Shuttle nodes.
+// Workloads are bin-packed onto registered nodes, which pick up their
+// assignments from Weaver and report status back over the node service.
+type Provider struct {
+	name      string
+	nodes     node.Repository
+	workloads workload.Repository
+
+	// agentTLS authenticates Weaver to node agents; nil without agent access
+	agentTLS *tls.Config
+}
+
+// New creates a new Fabric provider backed by the node registry. Logs, exec
+// and port forwarding need agentTLS, see LoadAgentTLS.
+func New(name string, nodes node.Repository, workloads workload.Repository, agentTLS *tls.Config) (*Provider, error) {
+	if nodes == nil {
+		return nil, fmt.Errorf("node repository is required")
+	}
+	if workloads == nil {
+		return nil, fmt.Errorf("workload repository is required")
+	}
+
+	return &Provider{
+		name:      name,
+		nodes:     nodes,
+		workloads: workloads,
+		agentTLS:  agentTLS,
+	}, nil
+}
+
+func (p *Provider) Name() string {
+	return p.name
+}
+
+func (p *Provider) Type() provider.ProviderType {
+	return Type
+}
+
+// RestartsWorkloads reports that Shuttle restarts exited containers on their
+// node according to the workload's restart policy
+func (p *Provider) RestartsWorkloads() bool {
+	return true
+}
+
+// SelectNode bin-packs a workload onto the registered node it fits most tightly
+func (p *Provider) SelectNode(ctx context.Context, w *workload.Workload) (string, error) {
+	return p.selectNode(ctx, w, nil)
+}
+
+// selectNode bin-packs a workload onto the tightest fitting node that is not skipped
+func (p *Provider) selectNode(ctx context.Context, w *workload.Workload, skip map[string]bool) (string, error) {
+	requests, err := node.ParseRequests(w.Spec.Resources)
+	if err != nil {
+		return "", fmt.Errorf("invalid resource requests: %w", err)
+	}
+
+	nodes, err := p.nodes.List(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	var best *node.Node
+	bestScore := 0.0
+	for _, n := range nodes {
+		if skip[n.ID] || !eligible(n, &w.Spec.Placement) {
+			continue
+		}
+
+		capacity, err := node.ParseResources(n.Capacity)
+		if err != nil {
+			continue
+		}
+
+		allocated, err := p.allocated(ctx, n.ID, w.ID)
+		if err != nil {
+			return "", err
+		}
+
+		free := capacity.Sub(allocated)
+		if !requests.Fits(free) {
+			continue
+		}
+
+		score := remaining(capacity, free.Sub(requests))
+		if best == nil || score < bestScore {
+			best = n
+			bestScore = score
+		}
+	}
+
+	if best == nil {
+		return "", fmt.Errorf("no registered node can fit workload %s/%s", w.Namespace, w.Name)
+	}
+
+	return best.ID, nil
+}
+
+// CreateWorkload assigns a workload to a node; the node starts it once it sees
+// the assignment. Nodes that filled up since the workload was scheduled are
+// skipped in favour of the next best node.
+func (p *Provider) CreateWorkload(ctx context.Context, w *workload.Workload) error {
+	requests, err := node.ParseRequests(w.Spec.Resources)
+	if err != nil {
+		return fmt.Errorf("invalid resource requests: %w", err)
+	}
+
+	full := make(map[string]bool)
+	for {
+		nodeID := w.Status.NodeID
+		if nodeID == "" {
+			if nodeID, err = p.selectNode(ctx, w, full); err != nil {
+				return err
+			}
+		}
+
+		if err := p.assign(ctx, w, nodeID, requests); !errors.Is(err, errNodeFull) {
+			return err
+		}
+
+		full[nodeID] = true
+		w.Status.NodeID = ""
+	}
+}
+
+// assign places a workload on a node if it still fits there. The node stays
+// locked until the assignment is stored, so concurrent placements on the same
+// node account for each other.
+func (p *Provider) assign(ctx context.Context, w *workload.Workload, nodeID string, requests node.Quantity) error {
+	err := p.nodes.UpdateAllocated(ctx, nodeID, func(n *node.Node) error {
+		capacity, err := node.ParseResources(n.Capacity)
+		if err != nil {
+			return fmt.Errorf("invalid capacity of node %s: %w", n.ID, err)
+		}
+
+		allocated, err := p.allocated(ctx, n.ID, w.ID)
+		if err != nil {
+			return err
+		}
+		if !requests.Fits(capacity.Sub(allocated)) {
+			return errNodeFull
+		}
+
+		w.Status.NodeID = n.ID
+		w.Status.Provider = p.name
+		w.Status.TailscaleIP = n.Address
+		w.Status.ProviderRef = nodeReference(n, w)
+
+		if err := p.workloads.Update(ctx, w); err != nil {
+			return fmt.Errorf("failed to store assignment: %w", err)
+		}
+
+		n.Allocated = node.FromQuantity(allocated.Add(requests))
+		return nil
+	})
+	if err != nil && !errors.Is(err, errNodeFull) {
+		return fmt.Errorf("failed to place workload on node %s: %w", nodeID, err)
+	}
+	return err
+}
+
+// GetWorkload returns the workload as last reported by its node
+func (p *Provider) GetWorkload(ctx context.Context, w *workload.Workload) (*workload.Workload, error) {
+	if w.Status.ProviderRef == nil {
+		return nil, provider.ErrNoProviderReference
+	}
+
+	observed, err := p.workloads.Get(ctx, w.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workload: %w", err)
+	}
+
+	return observed, nil
+}
+
+// UpdateWorkload is picked up by the node from its next assignment snapshot
+func (p *Provider) UpdateWorkload(ctx context.Context, w *workload.Workload) error {
+	if w.Status.ProviderRef == nil {
+		return provider.ErrNoProviderReference
+	}
+	return nil
+}
+
+// DeleteWorkload releases the resources a workload held on its node; the node
+// stops the container when the workload disappears from its assignments
+func (p *Provider) DeleteWorkload(ctx context.Context, w *workload.Workload) error {
+	if w.Status.ProviderRef == nil {
+		return provider.ErrNoProviderReference
+	}
+
+	err := p.refreshAllocation(ctx, w.Status.NodeID, w.ID)
+	if errors.Is(err, repository.ErrNotFound) {
+		// The node already left the fabric
+		return nil
+	}
+	return err
+}
+
+// ListWorkloads lists workloads assigned to Fabric nodes
+func (p *Provider) ListWorkloads(ctx context.Context, namespace string) ([]*workload.Workload, error) {
+	nodes, err := p.nodes.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	var workloads []*workload.Workload
+	for _, n := range nodes {
+		assigned, err := p.workloads.ListByNode(ctx, n.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list workloads on node %s: %w", n.ID, err)
+		}
+
+		for _, w := range assigned {
+			if w.Status.Provider != p.name {
+				continue
+			}
+			if namespace != "" && w.Namespace != namespace {
+				continue
+			}
+			workloads = append(workloads, w)
+		}
+	}
+
+	return workloads, nil
+}
+
+// GetAvailableResources returns the capacity of all schedulable nodes
+func (p *Provider) GetAvailableResources(ctx context.Context) (*provider.ResourceAvailability, error) {
+	nodes, err := p.nodes.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	var capacity, allocated node.Quantity
+	regions := make(map[string]*provider.RegionInfo)
+	var regionOrder []string
+
+	for _, n := range nodes {
+		if !n.Schedulable() {
+			continue
+		}
+
+		nodeCapacity, err := node.ParseResources(n.Capacity)
+		if err != nil {
+			continue
+		}
+		nodeAllocated, _ := node.ParseResources(n.Allocated)
+
+		capacity = capacity.Add(nodeCapacity)
+		allocated = allocated.Add(nodeAllocated)
+
+		region := n.Region
+		if region == "" {
+			region = "default"
+		}
+		info, ok := regions[region]
+		if !ok {
+			info = &provider.RegionInfo{
+				Name:        region,
+				DisplayName: region,
+				Available:   true,
+			}
+			regions[region] = info
+			regionOrder = append(regionOrder, region)
+		}
+		if n.Zone != "" && !contains(info.Zones, n.Zone) {
+			info.Zones = append(info.Zones, n.Zone)
+		}
+	}
+
+	free := capacity.Sub(allocated)
+	result := &provider.ResourceAvailability{
+		CPU:    pool(quantity.FormatCPU(capacity.MilliCPU), quantity.FormatCPU(free.MilliCPU), quantity.FormatCPU(allocated.MilliCPU)),
+		Memory: pool(quantity.FormatBytes(capacity.Memory), quantity.FormatBytes(free.Memory), quantity.FormatBytes(allocated.Memory)),
+		GPU: provider.GPUPool{
+			Types: make(map[string]provider.GPUTypeInfo),
+		},
+	}
+
+	if capacity.GPU > 0 {
+		result.GPU.Types["shuttle"] = provider.GPUTypeInfo{
+			Name:      "Self-hosted GPU",
+			Total:     int(capacity.GPU),
+			Available: int(capacity.GPU - allocated.GPU),
+		}
+	}
+
+	for _, name := range regionOrder {
+		result.Regions = append(result.Regions, *regions[name])
+	}
+
+	return result, nil
+}
+
+// GetPricing returns pricing for Fabric nodes; the hardware is already paid
+// for, so placing workloads on it has no marginal cost
+func (p *Provider) GetPricing(ctx context.Context) (*provider.PricingInfo, error) {
+	return &provider.PricingInfo{
+		Currency: "USD",
+		CPU:      provider.PricePerUnit{Amount: 0, Unit: "hour"},
+		Memory:   provider.PricePerUnit{Amount: 0, Unit: "hour"},
+		GPU:      map[string]provider.PricePerUnit{},
+		Storage:  provider.PricePerUnit{Amount: 0, Unit: "month"},
+		Network: provider.NetworkPricing{
+			Ingress:  provider.PricePerUnit{Amount: 0, Unit: "gb"},
+			Egress:   provider.PricePerUnit{Amount: 0, Unit: "gb"},
+			Internal: provider.PricePerUnit{Amount: 0, Unit: "gb"},
+		},
+	}, nil
+}
+
+// HealthCheck checks that at least one node can accept workloads
+func (p *Provider) HealthCheck(ctx context.Context) error {
+	nodes, err := p.nodes.List(ctx)
+	if err != nil {
+		return fmt.Errorf("Fabric health check failed: %w", err)
+	}
+
+	for _, n := range nodes {
+		if n.Schedulable() {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no ready Fabric nodes registered")
+}
+
+// GetStatus returns the current status of the Fabric provider
+func (p *Provider) GetStatus(ctx context.Context) (*provider.ProviderStatus, error) {
+	available := true
+	message := ""
+
+	if err := p.HealthCheck(ctx); err != nil {
+		available = false
+		message = err.Error()
+	}
+
+	nodes, err := p.nodes.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	var regions []provider.RegionStatus
+	for _, n := range nodes {
+		capacity, err := node.ParseResources(n.Capacity)
+		if err != nil {
+			continue
+		}
+		allocated, _ := node.ParseResources(n.Allocated)
+
+		load := 0.0
+		if capacity.MilliCPU > 0 {
+			load = float64(allocated.MilliCPU) / float64(capacity.MilliCPU)
+		}
+
+		regions = append(regions, provider.RegionStatus{
+			Name:      n.Name,
+			Available: n.Schedulable(),
+			Load:      load,
+		})
+	}
+
+	workloads, _ := p.ListWorkloads(ctx, "")
+	activeWorkloads := 0
+	for _, w := range workloads {
+		if w.Status.Phase == workload.PhaseRunning {
+			activeWorkloads++
+		}
+	}
+
+	return &provider.ProviderStatus{
+		Available: available,
+		Message:   message,
+		Regions:   regions,
+		Metrics: provider.ProviderMetrics{
+			ActiveWorkloads: activeWorkloads,
+			TotalWorkloads:  len(workloads),
+		},
+	}, nil
+}
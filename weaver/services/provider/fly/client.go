@@ -86,6 +86,17 @@ func (c *Client) DeleteApp(ctx context.Context, appName string) error {
 	return err
 }
 
+// SetSecrets creates or replaces app secrets
+func (c *Client) SetSecrets(ctx context.Context, appName string, req *SetSecretsRequest) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	_, err = c.makeRequest(ctx, "POST", "/apps/"+appName+"/secrets", body)
+	return err
+}
+
 // CreateMachine creates a new machine in an app
 func (c *Client) CreateMachine(ctx context.Context, appName string, req *CreateMachineRequest) (*Machine, error) {
 	body, err := json.Marshal(req)
@@ -184,6 +195,26 @@ func (c *Client) GetMachineLogs(ctx context.Context, appName, machineID string)
 	return string(resp), nil
 }
 
+// ExecMachine runs a command on a machine and returns its output once it exits
+func (c *Client) ExecMachine(ctx context.Context, appName, machineID string, req *MachineExecRequest) (*MachineExecResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.makeRequest(ctx, "POST", "/apps/"+appName+"/machines/"+machineID+"/exec", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result MachineExecResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
 // ListRegions lists available Fly.io regions
 func (c *Client) ListRegions(ctx context.Context) ([]*Region, error) {
 	resp, err := c.makeRequest(ctx, "GET", "/regions", nil)
@@ -0,0 +1,59 @@
+package fly
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/services/provider"
+)
+
+// maxExecStdin bounds the input buffered for the exec API, which takes stdin
+// as part of the request
+const maxExecStdin = 1 << 20
+
+// Exec runs a command on the workload's machine. The exec API is not
+// interactive, so stdin is read until closed and output arrives at exit.
+func (p *Provider) Exec(ctx context.Context, w *workload.Workload, opts provider.ExecOptions, streams provider.ExecStreams) (int, error) {
+	ref := w.Status.ProviderRef
+	if ref == nil {
+		return 0, provider.ErrNoProviderReference
+	}
+	if opts.Container != w.Name {
+		return 0, fmt.Errorf("workload %s has no container %s on fly", w.Name, opts.Container)
+	}
+	if opts.TTY {
+		return 0, fmt.Errorf("fly exec does not support terminals")
+	}
+
+	req := &MachineExecRequest{Command: opts.Command}
+	if streams.Stdin != nil {
+		data, err := io.ReadAll(io.LimitReader(streams.Stdin, maxExecStdin+1))
+		if err != nil {
+			return 0, fmt.Errorf("failed to read stdin: %w", err)
+		}
+		if len(data) > maxExecStdin {
+			return 0, fmt.Errorf("stdin exceeds %d bytes", maxExecStdin)
+		}
+		req.Stdin = string(data)
+	}
+
+	resp, err := p.client.ExecMachine(ctx, ref.Name, ref.ExternalID, req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to exec on machine: %w", err)
+	}
+
+	if resp.StdOut != "" {
+		if _, err := io.WriteString(streams.Stdout, resp.StdOut); err != nil {
+			return 0, err
+		}
+	}
+	if resp.StdErr != "" {
+		if _, err := io.WriteString(streams.Stderr, resp.StdErr); err != nil {
+			return 0, err
+		}
+	}
+
+	return int(resp.ExitCode), nil
+}
@@ -1,51 +1,54 @@
 package fly
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/codecflow/fabric/pkg/quantity"
 	"github.com/codecflow/fabric/pkg/workload"
 )
 
 // parseGuest converts Fabric resource specifications to Fly.io Guest format
-func parseGuest(w *workload.Workload) Guest {
+func parseGuest(w *workload.Workload) (Guest, error) {
+	cpus, err := parseCPUs(w.Spec.Resources.CPU)
+	if err != nil {
+		return Guest{}, fmt.Errorf("invalid cpu: %w", err)
+	}
+	memoryMB, err := parseMemoryMB(w.Spec.Resources.Memory)
+	if err != nil {
+		return Guest{}, fmt.Errorf("invalid memory: %w", err)
+	}
+	gpu, err := quantity.ParseGPU(w.Spec.Resources.GPU)
+	if err != nil {
+		return Guest{}, fmt.Errorf("invalid gpu: %w", err)
+	}
+
 	return Guest{
-		CPUs:     parseCPUs(w.Spec.Resources.CPU),
+		CPUs:     cpus,
 		CPUKind:  parseCPUKind(w.Spec.Resources.CPU),
-		MemoryMB: parseMemoryMB(w.Spec.Resources.Memory),
-		GPUs:     parseGPUs(w.Spec.Resources.GPU),
-		GPUKind:  parseGPUKind(w.Spec.Resources.GPU),
-	}
+		MemoryMB: memoryMB,
+		GPUs:     int(gpu.Count),
+		GPUKind:  parseGPUKind(gpu),
+	}, nil
 }
 
-// parseCPUs converts CPU specification to number of CPUs
-func parseCPUs(cpuSpec string) int {
+// parseCPUs converts CPU specification to number of CPUs, rounding fractional cores up
+func parseCPUs(cpuSpec string) (int, error) {
 	if cpuSpec == "" {
-		return 1 // Default 1 CPU
+		return 1, nil // Default 1 CPU
 	}
 
-	// Handle Kubernetes-style CPU specifications
-	if strings.HasSuffix(cpuSpec, "m") {
-		// Millicores (e.g., "2000m" = 2 cores)
-		milliStr := strings.TrimSuffix(cpuSpec, "m")
-		if milli, err := strconv.Atoi(milliStr); err == nil {
-			cores := milli / 1000
-			if cores < 1 {
-				cores = 1
-			}
-			return cores
-		}
+	milli, err := quantity.ParseCPU(cpuSpec)
+	if err != nil {
+		return 0, err
 	}
 
-	// Try to parse as direct number
-	if cores, err := strconv.Atoi(cpuSpec); err == nil {
-		if cores < 1 {
-			cores = 1
-		}
-		return cores
+	cpus := int(quantity.RoundUp(milli, 1000))
+	if cpus < 1 {
+		cpus = 1
 	}
-
-	return 1 // Default
+	return cpus, nil
 }
 
 // parseCPUKind determines CPU kind from specification
@@ -58,85 +61,26 @@ func parseCPUKind(cpuSpec string) string {
 	return CPUKindShared // Default to shared
 }
 
-// parseMemoryMB converts memory specification to MB
-func parseMemoryMB(memorySpec string) int {
+// parseMemoryMB converts memory specification to MB, which Fly.io counts in MiB
+func parseMemoryMB(memorySpec string) (int, error) {
 	if memorySpec == "" {
-		return 1024 // Default 1GB
-	}
-
-	spec := strings.ToLower(memorySpec)
-
-	if strings.HasSuffix(spec, "gi") {
-		// Gibibytes to MB
-		giStr := strings.TrimSuffix(spec, "gi")
-		if gi, err := strconv.Atoi(giStr); err == nil {
-			return gi * 1024 // 1 GiB = 1024 MiB
-		}
-	}
-
-	if strings.HasSuffix(spec, "gb") {
-		// Gigabytes to MB
-		gbStr := strings.TrimSuffix(spec, "gb")
-		if gb, err := strconv.Atoi(gbStr); err == nil {
-			return gb * 1000 // 1 GB = 1000 MB
-		}
-	}
-
-	if strings.HasSuffix(spec, "mi") {
-		// Mebibytes
-		miStr := strings.TrimSuffix(spec, "mi")
-		if mi, err := strconv.Atoi(miStr); err == nil {
-			return mi
-		}
-	}
-
-	if strings.HasSuffix(spec, "mb") {
-		// Megabytes
-		mbStr := strings.TrimSuffix(spec, "mb")
-		if mb, err := strconv.Atoi(mbStr); err == nil {
-			return mb
-		}
-	}
-
-	// Try to parse as direct number (assume GB)
-	if gb, err := strconv.Atoi(spec); err == nil {
-		return gb * 1000
+		return 1024, nil // Default 1GB
 	}
 
-	return 1024 // Default 1GB
-}
-
-// parseGPUs converts GPU specification to number of GPUs
-func parseGPUs(gpuSpec string) int {
-	if gpuSpec == "" {
-		return 0 // No GPU required
-	}
-
-	// Extract GPU count
-	if strings.Contains(gpuSpec, "=") {
-		parts := strings.Split(gpuSpec, "=")
-		if len(parts) > 1 {
-			if count, err := strconv.Atoi(parts[1]); err == nil {
-				return count
-			}
-		}
+	b, err := quantity.ParseBytes(memorySpec)
+	if err != nil {
+		return 0, err
 	}
-
-	// If it's just a number, assume that many GPUs
-	if count, err := strconv.Atoi(gpuSpec); err == nil {
-		return count
-	}
-
-	return 1 // Default if GPU specified but count unclear
+	return int(quantity.RoundUp(b, quantity.Mi)), nil
 }
 
-// parseGPUKind determines GPU kind from specification
-func parseGPUKind(gpuSpec string) string {
-	if gpuSpec == "" {
+// parseGPUKind determines GPU kind from the requested GPU type
+func parseGPUKind(gpu quantity.GPU) string {
+	if gpu.IsZero() {
 		return ""
 	}
 
-	spec := strings.ToLower(gpuSpec)
+	spec := strings.ToLower(gpu.Type)
 
 	// Check for specific GPU types
 	if strings.Contains(spec, "a100") {
@@ -195,6 +139,11 @@ func parseMounts(w *workload.Workload) []Mount {
 	var mounts []Mount
 
 	for _, volume := range w.Spec.Volumes {
+		// Secret volumes are written as files instead
+		if volume.Secret != nil {
+			continue
+		}
+
 		mount := Mount{
 			Source:      volume.Name,
 			Destination: volume.MountPath,
@@ -251,6 +200,34 @@ func selectRegion(regions []*Region, placement *workload.PlacementSpec) string {
 	return "iad" // Ultimate fallback
 }
 
+// Machine metadata keys used to map machines back to Fabric workloads
+const (
+	metadataWorkloadID        = "fabric.workload.id"
+	metadataWorkloadName      = "fabric.workload.name"
+	metadataWorkloadNamespace = "fabric.workload.namespace"
+)
+
+// machineMetadata returns the metadata attached to machines created for a workload
+func machineMetadata(w *workload.Workload) map[string]string {
+	return map[string]string{
+		metadataWorkloadID:        w.ID,
+		metadataWorkloadName:      w.Name,
+		metadataWorkloadNamespace: w.Namespace,
+	}
+}
+
+// machineReference builds the provider reference for a Fly.io machine
+func machineReference(machine *Machine, appName string) *workload.ProviderReference {
+	return &workload.ProviderReference{
+		ExternalID: machine.ID,
+		Name:       appName,
+		Metadata: map[string]string{
+			"region":     machine.Region,
+			"instanceId": machine.InstanceID,
+		},
+	}
+}
+
 // machineToWorkload converts a Fly.io machine to a Fabric workload
 func machineToWorkload(machine *Machine, appName string) *workload.Workload {
 	w := &workload.Workload{
@@ -263,16 +240,28 @@ func machineToWorkload(machine *Machine, appName string) *workload.Workload {
 			Env:     machine.Config.Env,
 		},
 		Status: workload.Status{
-			Phase:    machineStateToPhase(machine.State),
-			NodeID:   machine.Region,
-			Provider: "fly",
+			Phase:       machineStateToPhase(machine.State),
+			NodeID:      machine.Region,
+			Provider:    "fly",
+			ProviderRef: machineReference(machine, appName),
 		},
 	}
 
+	// Prefer the Fabric identity recorded on the machine
+	if id := machine.Config.Metadata[metadataWorkloadID]; id != "" {
+		w.ID = id
+	}
+	if name := machine.Config.Metadata[metadataWorkloadName]; name != "" {
+		w.Name = name
+	}
+	if namespace := machine.Config.Metadata[metadataWorkloadNamespace]; namespace != "" {
+		w.Namespace = namespace
+	}
+
 	// Convert guest config back to resource requests
 	w.Spec.Resources = workload.ResourceRequests{
 		CPU:    strconv.Itoa(machine.Config.Guest.CPUs),
-		Memory: strconv.Itoa(machine.Config.Guest.MemoryMB/1024) + "Gi",
+		Memory: quantity.FormatBytes(int64(machine.Config.Guest.MemoryMB) * quantity.Mi),
 	}
 
 	if machine.Config.Guest.GPUs > 0 {
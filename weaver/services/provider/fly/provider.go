@@ -23,19 +23,16 @@ type Config struct {
 
 // Provider implements the Fabric provider interface for Fly.io
 type Provider struct {
-	name   string
-	config Config
-	client *Client
+	name    string
+	config  Config
+	client  *Client
+	secrets provider.SecretSource
 
 	// Cache for regions and sizes
 	regions     []*Region
 	sizes       []*MachineSize
 	cacheExpiry time.Time
 	cacheMutex  sync.RWMutex
-
-	// App management
-	apps map[string]string // workload ID -> app name
-	mu   sync.RWMutex
 }
 
 // New creates a new Fly.io provider
@@ -50,7 +47,6 @@ func New(name string, config Config) (*Provider, error) {
 		name:   name,
 		config: config,
 		client: client,
-		apps:   make(map[string]string),
 	}
 
 	return p, nil
@@ -68,6 +64,11 @@ func (p *Provider) Type() provider.ProviderType {
 
 // CreateWorkload creates a new workload on Fly.io
 func (p *Provider) CreateWorkload(ctx context.Context, w *workload.Workload) error {
+	guest, err := parseGuest(w)
+	if err != nil {
+		return fmt.Errorf("invalid resource requests: %w", err)
+	}
+
 	// Generate unique app name
 	appName := generateAppName(w.Name, w.Namespace)
 
@@ -81,16 +82,11 @@ func (p *Provider) CreateWorkload(ctx context.Context, w *workload.Workload) err
 		createAppReq.PrimaryRegion = p.config.Region
 	}
 
-	_, err := p.client.CreateApp(ctx, createAppReq)
+	_, err = p.client.CreateApp(ctx, createAppReq)
 	if err != nil {
 		return fmt.Errorf("failed to create app: %w", err)
 	}
 
-	// Store app mapping
-	p.mu.Lock()
-	p.apps[w.ID] = appName
-	p.mu.Unlock()
-
 	// Get available regions for machine placement
 	regions, err := p.getRegions(ctx)
 	if err != nil {
@@ -100,15 +96,25 @@ func (p *Provider) CreateWorkload(ctx context.Context, w *workload.Workload) err
 	// Select region
 	region := selectRegion(regions, &w.Spec.Placement)
 
+	files, err := p.applySecrets(ctx, appName, w)
+	if err != nil {
+		if err := p.client.DeleteApp(ctx, appName); err != nil {
+			log.Printf("failed to delete app after secret setup failure: %v", err)
+		}
+		return err
+	}
+
 	// Create machine configuration
 	machineConfig := MachineConfig{
 		Image:    w.Spec.Image,
 		Env:      w.Spec.Env,
 		Cmd:      w.Spec.Command,
-		Guest:    parseGuest(w),
+		Guest:    guest,
 		Services: parseServices(w),
 		Mounts:   parseMounts(w),
+		Files:    files,
 		Restart:  parseRestartPolicy(w),
+		Metadata: machineMetadata(w),
 	}
 
 	// Create machine
@@ -124,19 +130,19 @@ func (p *Provider) CreateWorkload(ctx context.Context, w *workload.Workload) err
 		if err := p.client.DeleteApp(ctx, appName); err != nil {
 			log.Printf("failed to delete app after machine creation failure: %v", err)
 		}
-		p.mu.Lock()
-		delete(p.apps, w.ID)
-		p.mu.Unlock()
 		return fmt.Errorf("failed to create machine: %w", err)
 	}
 
+	// Record the app and machine so later lookups survive restarts
+	w.Status.ProviderRef = machineReference(machine, appName)
+
 	// Start the machine
 	if err := p.client.StartMachine(ctx, appName, machine.ID); err != nil {
 		return fmt.Errorf("failed to start machine: %w", err)
 	}
 
 	// Update workload with Fly.io specific information
-	w.Status.Provider = "fly"
+	w.Status.Provider = p.name
 	w.Status.NodeID = machine.Region
 	w.Status.Phase = workload.PhasePending
 
@@ -144,69 +150,71 @@ func (p *Provider) CreateWorkload(ctx context.Context, w *workload.Workload) err
 }
 
 // GetWorkload retrieves a workload from Fly.io
-func (p *Provider) GetWorkload(ctx context.Context, id string) (*workload.Workload, error) {
-	p.mu.RLock()
-	appName, exists := p.apps[id]
-	p.mu.RUnlock()
-
-	if !exists {
-		return nil, fmt.Errorf("workload not found")
+func (p *Provider) GetWorkload(ctx context.Context, w *workload.Workload) (*workload.Workload, error) {
+	ref := w.Status.ProviderRef
+	if ref == nil {
+		return nil, provider.ErrNoProviderReference
 	}
 
-	machines, err := p.client.ListMachines(ctx, appName)
+	machine, err := p.client.GetMachine(ctx, ref.Name, ref.ExternalID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list machines: %w", err)
+		return nil, fmt.Errorf("failed to get machine: %w", err)
 	}
 
-	if len(machines) == 0 {
-		return nil, fmt.Errorf("no machines found for workload")
-	}
+	observed := machineToWorkload(machine, ref.Name)
+	observed.ID = w.ID
 
-	// Use the first machine (assuming one machine per workload)
-	machine := machines[0]
-	w := machineToWorkload(machine, appName)
-	w.ID = id
+	return observed, nil
+}
+
+// StreamLogs streams the machine logs of a workload
+func (p *Provider) StreamLogs(ctx context.Context, w *workload.Workload, opts provider.LogOptions, emit func(provider.LogLine) error) error {
+	ref := w.Status.ProviderRef
+	if ref == nil {
+		return provider.ErrNoProviderReference
+	}
 
-	return w, nil
+	fetch := func(ctx context.Context) (string, error) {
+		return p.client.GetMachineLogs(ctx, ref.Name, ref.ExternalID)
+	}
+	return provider.StreamTextLogs(ctx, w, opts, fetch, emit)
 }
 
 // UpdateWorkload updates a workload on Fly.io
 func (p *Provider) UpdateWorkload(ctx context.Context, w *workload.Workload) error {
-	p.mu.RLock()
-	appName, exists := p.apps[w.ID]
-	p.mu.RUnlock()
-
-	if !exists {
-		return fmt.Errorf("workload not found")
+	ref := w.Status.ProviderRef
+	if ref == nil {
+		return provider.ErrNoProviderReference
 	}
 
-	machines, err := p.client.ListMachines(ctx, appName)
+	guest, err := parseGuest(w)
 	if err != nil {
-		return fmt.Errorf("failed to list machines: %w", err)
+		return fmt.Errorf("invalid resource requests: %w", err)
 	}
 
-	if len(machines) == 0 {
-		return fmt.Errorf("no machines found for workload")
+	files, err := p.applySecrets(ctx, ref.Name, w)
+	if err != nil {
+		return err
 	}
 
-	machine := machines[0]
-
 	// Update machine configuration
 	machineConfig := MachineConfig{
 		Image:    w.Spec.Image,
 		Env:      w.Spec.Env,
 		Cmd:      w.Spec.Command,
-		Guest:    parseGuest(w),
+		Guest:    guest,
 		Services: parseServices(w),
 		Mounts:   parseMounts(w),
+		Files:    files,
 		Restart:  parseRestartPolicy(w),
+		Metadata: machineMetadata(w),
 	}
 
 	updateReq := &UpdateMachineRequest{
 		Config: machineConfig,
 	}
 
-	_, err = p.client.UpdateMachine(ctx, appName, machine.ID, updateReq)
+	_, err = p.client.UpdateMachine(ctx, ref.Name, ref.ExternalID, updateReq)
 	if err != nil {
 		return fmt.Errorf("failed to update machine: %w", err)
 	}
@@ -214,50 +222,61 @@ func (p *Provider) UpdateWorkload(ctx context.Context, w *workload.Workload) err
 	return nil
 }
 
-// DeleteWorkload deletes a workload from Fly.io
-func (p *Provider) DeleteWorkload(ctx context.Context, id string) error {
-	p.mu.RLock()
-	appName, exists := p.apps[id]
-	p.mu.RUnlock()
+// UpdatesInPlace reports the changes UpdateWorkload applies by updating the
+// machine configuration. Fly restarts the machine with the new configuration
+// but keeps its ID, volumes and address.
+func (p *Provider) UpdatesInPlace(_ *workload.Workload, change workload.Change) bool {
+	switch change {
+	case workload.ChangeImage, workload.ChangeCommand, workload.ChangeEnv, workload.ChangeSecretEnv,
+		workload.ChangeResources, workload.ChangePorts, workload.ChangeRestart:
+		return true
+	}
+	return false
+}
+
+// RestartsWorkloads reports that machines are restarted by Fly according to
+// their restart policy
+func (p *Provider) RestartsWorkloads() bool {
+	return true
+}
 
-	if !exists {
-		return fmt.Errorf("workload not found")
+// DeleteWorkload deletes a workload from Fly.io
+func (p *Provider) DeleteWorkload(ctx context.Context, w *workload.Workload) error {
+	ref := w.Status.ProviderRef
+	if ref == nil {
+		return provider.ErrNoProviderReference
 	}
 
 	// Delete the entire app (which deletes all machines)
-	err := p.client.DeleteApp(ctx, appName)
+	err := p.client.DeleteApp(ctx, ref.Name)
 	if err != nil {
 		return fmt.Errorf("failed to delete app: %w", err)
 	}
 
-	// Remove from mapping
-	p.mu.Lock()
-	delete(p.apps, id)
-	p.mu.Unlock()
-
 	return nil
 }
 
 // ListWorkloads lists all workloads in a namespace
 func (p *Provider) ListWorkloads(ctx context.Context, namespace string) ([]*workload.Workload, error) {
-	var workloads []*workload.Workload
-
-	p.mu.RLock()
-	appMappings := make(map[string]string)
-	for workloadID, appName := range p.apps {
-		appMappings[workloadID] = appName
+	apps, err := p.client.ListApps(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list apps: %w", err)
 	}
-	p.mu.RUnlock()
 
-	for workloadID, appName := range appMappings {
-		machines, err := p.client.ListMachines(ctx, appName)
+	var workloads []*workload.Workload
+	for _, app := range apps {
+		machines, err := p.client.ListMachines(ctx, app.Name)
 		if err != nil {
 			continue // Skip failed apps
 		}
 
 		for _, machine := range machines {
-			w := machineToWorkload(machine, appName)
-			w.ID = workloadID
+			// Only machines created by Fabric carry a workload ID
+			if machine.Config.Metadata[metadataWorkloadID] == "" {
+				continue
+			}
+
+			w := machineToWorkload(machine, app.Name)
 			if namespace == "" || w.Namespace == namespace {
 				workloads = append(workloads, w)
 			}
@@ -414,9 +433,10 @@ func (p *Provider) GetStatus(ctx context.Context) (*provider.ProviderStatus, err
 	}
 
 	// Count active workloads
-	p.mu.RLock()
-	activeWorkloads := len(p.apps)
-	p.mu.RUnlock()
+	activeWorkloads := 0
+	if workloads, err := p.ListWorkloads(ctx, ""); err == nil {
+		activeWorkloads = len(workloads)
+	}
 
 	return &provider.ProviderStatus{
 		Available: healthy,
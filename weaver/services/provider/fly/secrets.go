@@ -0,0 +1,46 @@
+package fly
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/services/provider"
+)
+
+// SetSecretSource sets where the secrets referenced by workloads are read from
+func (p *Provider) SetSecretSource(source provider.SecretSource) {
+	p.secrets = source
+}
+
+// applySecrets stores a workload's resolved secrets as app secrets. Environment
+// variables become secrets of the same name; files are stored base64-encoded and
+// returned as machine files.
+func (p *Provider) applySecrets(ctx context.Context, appName string, w *workload.Workload) ([]File, error) {
+	resolved, err := provider.ResolveSecrets(ctx, p.secrets, w)
+	if err != nil {
+		return nil, err
+	}
+	if resolved.Empty() {
+		return nil, nil
+	}
+
+	values := make(map[string]string, len(resolved.EnvVars)+len(resolved.Files))
+	for _, v := range resolved.EnvVars {
+		values[v.Name] = string(v.Value)
+	}
+
+	var files []File
+	for i, f := range resolved.Files {
+		name := fmt.Sprintf("FABRIC_FILE_%d", i)
+		values[name] = base64.StdEncoding.EncodeToString(f.Value)
+		files = append(files, File{GuestPath: f.Path, SecretName: name})
+	}
+
+	if err := p.client.SetSecrets(ctx, appName, &SetSecretsRequest{Values: values}); err != nil {
+		return nil, fmt.Errorf("failed to set app secrets: %w", err)
+	}
+
+	return files, nil
+}
@@ -49,8 +49,16 @@ type MachineConfig struct {
 	Guest    Guest             `json:"guest"`
 	Services []Service         `json:"services,omitempty"`
 	Mounts   []Mount           `json:"mounts,omitempty"`
+	Files    []File            `json:"files,omitempty"`
 	Restart  RestartPolicy     `json:"restart,omitempty"`
 	DNS      DNSConfig         `json:"dns,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// File writes the base64-encoded value of an app secret to a path in the machine
+type File struct {
+	GuestPath  string `json:"guest_path"`
+	SecretName string `json:"secret_name"`
 }
 
 // Guest represents machine guest configuration (CPU/memory)
@@ -143,6 +151,25 @@ type Secret struct {
 	CreatedAt string `json:"createdAt"`
 }
 
+// SetSecretsRequest sets app secrets, which machines receive as environment variables
+type SetSecretsRequest struct {
+	Values map[string]string `json:"values"`
+}
+
+// MachineExecRequest runs a command on a machine
+type MachineExecRequest struct {
+	Command []string `json:"command"`
+	Stdin   string   `json:"stdin,omitempty"`
+	Timeout int      `json:"timeout,omitempty"` // seconds
+}
+
+// MachineExecResponse is the result of a command run on a machine
+type MachineExecResponse struct {
+	ExitCode int32  `json:"exit_code"`
+	StdOut   string `json:"stdout"`
+	StdErr   string `json:"stderr"`
+}
+
 // IPAddress represents an IP address allocation
 type IPAddress struct {
 	ID        string `json:"id"`
@@ -0,0 +1,168 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/transport/spdy"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/services/provider"
+)
+
+// Exec runs a command in one of the workload's pod containers
+func (p *Provider) Exec(ctx context.Context, w *workload.Workload, opts provider.ExecOptions, streams provider.ExecStreams) (int, error) {
+	pod, err := p.findPod(ctx, w)
+	if err != nil {
+		return 0, err
+	}
+
+	req := p.client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: opts.Container,
+			Command:   opts.Command,
+			Stdin:     streams.Stdin != nil,
+			Stdout:    true,
+			// A terminal merges stderr into stdout
+			Stderr: !opts.TTY,
+			TTY:    opts.TTY,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(p.client.Config(), http.MethodPost, req.URL())
+	if err != nil {
+		return 0, fmt.Errorf("failed to create executor: %w", err)
+	}
+
+	streamOptions := remotecommand.StreamOptions{
+		Stdin:  streams.Stdin,
+		Stdout: streams.Stdout,
+		Tty:    opts.TTY,
+	}
+	if !opts.TTY {
+		streamOptions.Stderr = streams.Stderr
+	}
+	if opts.TTY && streams.Resize != nil {
+		streamOptions.TerminalSizeQueue = &sizeQueue{ctx: ctx, sizes: streams.Resize}
+	}
+
+	err = executor.StreamWithContext(ctx, streamOptions)
+
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitStatus(), nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to exec in pod %s: %w", pod.Name, err)
+	}
+
+	return 0, nil
+}
+
+// PortForward connects conn to a port of the workload's pod
+func (p *Provider) PortForward(ctx context.Context, w *workload.Workload, port uint16, conn io.ReadWriter) error {
+	pod, err := p.findPod(ctx, w)
+	if err != nil {
+		return err
+	}
+	if pod.Status.Phase != corev1.PodRunning {
+		return fmt.Errorf("pod %s is not running", pod.Name)
+	}
+
+	req := p.client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("portforward")
+
+	transport, upgrader, err := spdy.RoundTripperFor(p.client.Config())
+	if err != nil {
+		return fmt.Errorf("failed to create round tripper: %w", err)
+	}
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL())
+
+	streamConn, _, err := dialer.Dial(portforward.PortForwardProtocolV1Name)
+	if err != nil {
+		return fmt.Errorf("failed to dial pod %s: %w", pod.Name, err)
+	}
+	defer func() { _ = streamConn.Close() }()
+
+	// Each forwarded connection is an error and a data stream sharing a request ID
+	headers := http.Header{}
+	headers.Set(corev1.StreamType, corev1.StreamTypeError)
+	headers.Set(corev1.PortHeader, strconv.Itoa(int(port)))
+	headers.Set(corev1.PortForwardRequestIDHeader, "0")
+
+	errorStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		return fmt.Errorf("failed to create error stream: %w", err)
+	}
+	// The error stream is only read
+	_ = errorStream.Close()
+
+	errCh := make(chan error, 1)
+	go func() {
+		message, err := io.ReadAll(errorStream)
+		switch {
+		case err != nil:
+			errCh <- fmt.Errorf("failed to read error stream: %w", err)
+		case len(message) > 0:
+			errCh <- fmt.Errorf("port %d of pod %s: %s", port, pod.Name, message)
+		}
+		close(errCh)
+	}()
+
+	headers.Set(corev1.StreamType, corev1.StreamTypeData)
+	dataStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		return fmt.Errorf("failed to create data stream: %w", err)
+	}
+
+	go func() {
+		_, _ = io.Copy(dataStream, conn)
+		// Closing the write side tells the workload the caller is done sending
+		_ = dataStream.Close()
+	}()
+
+	if _, err := io.Copy(conn, dataStream); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("failed to read from pod %s: %w", pod.Name, err)
+	}
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+// sizeQueue passes terminal resizes to the executor
+type sizeQueue struct {
+	ctx   context.Context
+	sizes <-chan provider.TerminalSize
+}
+
+// Next returns the next terminal size, or nil once the session ends
+func (q *sizeQueue) Next() *remotecommand.TerminalSize {
+	select {
+	case size, ok := <-q.sizes:
+		if !ok {
+			return nil
+		}
+		return &remotecommand.TerminalSize{Width: size.Width, Height: size.Height}
+	case <-q.ctx.Done():
+		return nil
+	}
+}
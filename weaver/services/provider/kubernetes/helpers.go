@@ -1,25 +1,25 @@
 package kubernetes
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/services/provider"
 )
 
-// workloadToPod converts a Fabric workload to a Kubernetes Pod
-func toPod(w *workload.Workload, namespace string) *corev1.Pod {
+// toPod converts a Fabric workload to a Kubernetes Pod
+func toPod(w *workload.Workload, namespace string) (*corev1.Pod, error) {
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      w.Name,
 			Namespace: namespace,
-			Labels: map[string]string{
-				"fabric.workload.id":   w.ID,
-				"fabric.workload.name": w.Name,
-			},
+			Labels:    podLabels(w),
 		},
 		Spec: corev1.PodSpec{
 			Containers: []corev1.Container{
@@ -31,6 +31,15 @@ func toPod(w *workload.Workload, namespace string) *corev1.Pod {
 		},
 	}
 
+	switch w.Spec.Restart {
+	case workload.RestartPolicyOnFailure:
+		pod.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
+	case workload.RestartPolicyNever:
+		pod.Spec.RestartPolicy = corev1.RestartPolicyNever
+	default:
+		pod.Spec.RestartPolicy = corev1.RestartPolicyAlways
+	}
+
 	// Set command and args
 	if len(w.Spec.Command) > 0 {
 		pod.Spec.Containers[0].Command = w.Spec.Command
@@ -53,32 +62,51 @@ func toPod(w *workload.Workload, namespace string) *corev1.Pod {
 
 	// Set resource requirements
 	if w.Spec.Resources.CPU != "" || w.Spec.Resources.Memory != "" || w.Spec.Resources.GPU != "" {
+		requested, err := w.Spec.Resources.Quantity()
+		if err != nil {
+			return nil, fmt.Errorf("invalid resource requests: %w", err)
+		}
+
 		resources := corev1.ResourceRequirements{
 			Requests: corev1.ResourceList{},
 			Limits:   corev1.ResourceList{},
 		}
 
 		if w.Spec.Resources.CPU != "" {
-			cpuQuantity := resource.MustParse(w.Spec.Resources.CPU)
-			resources.Requests[corev1.ResourceCPU] = cpuQuantity
-			resources.Limits[corev1.ResourceCPU] = cpuQuantity
+			cpuQuantity := resource.NewMilliQuantity(requested.MilliCPU, resource.DecimalSI)
+			resources.Requests[corev1.ResourceCPU] = *cpuQuantity
+			resources.Limits[corev1.ResourceCPU] = *cpuQuantity
 		}
 
 		if w.Spec.Resources.Memory != "" {
-			memQuantity := resource.MustParse(w.Spec.Resources.Memory)
-			resources.Requests[corev1.ResourceMemory] = memQuantity
-			resources.Limits[corev1.ResourceMemory] = memQuantity
+			memQuantity := resource.NewQuantity(requested.Memory, resource.BinarySI)
+			resources.Requests[corev1.ResourceMemory] = *memQuantity
+			resources.Limits[corev1.ResourceMemory] = *memQuantity
 		}
 
-		if w.Spec.Resources.GPU != "" {
-			gpuQuantity := resource.MustParse(w.Spec.Resources.GPU)
-			resources.Requests[corev1.ResourceName("nvidia.com/gpu")] = gpuQuantity
-			resources.Limits[corev1.ResourceName("nvidia.com/gpu")] = gpuQuantity
+		if requested.GPU > 0 {
+			gpuQuantity := resource.NewQuantity(requested.GPU, resource.DecimalSI)
+			resources.Requests[corev1.ResourceName("nvidia.com/gpu")] = *gpuQuantity
+			resources.Limits[corev1.ResourceName("nvidia.com/gpu")] = *gpuQuantity
 		}
 
 		pod.Spec.Containers[0].Resources = resources
 	}
 
+	// Sidecars run next to the main container and share its network
+	for _, sidecar := range w.Spec.Sidecars {
+		container := corev1.Container{
+			Name:    sidecar.Name,
+			Image:   sidecar.Image,
+			Command: sidecar.Command,
+			Args:    sidecar.Args,
+		}
+		for key, value := range sidecar.Env {
+			container.Env = append(container.Env, corev1.EnvVar{Name: key, Value: value})
+		}
+		pod.Spec.Containers = append(pod.Spec.Containers, container)
+	}
+
 	// Set ports
 	if len(w.Spec.Ports) > 0 {
 		var ports []corev1.ContainerPort
@@ -96,7 +124,18 @@ func toPod(w *workload.Workload, namespace string) *corev1.Pod {
 		pod.Spec.Containers[0].Ports = ports
 	}
 
-	return pod
+	return pod, nil
+}
+
+// podLabels returns the workload's labels with the labels Fabric finds its pods by
+func podLabels(w *workload.Workload) map[string]string {
+	labels := make(map[string]string, len(w.Labels)+2)
+	for key, value := range w.Labels {
+		labels[key] = value
+	}
+	labels["fabric.workload.id"] = w.ID
+	labels["fabric.workload.name"] = w.Name
+	return labels
 }
 
 // podToWorkload converts a Kubernetes Pod to a Fabric Workload
@@ -109,14 +148,17 @@ func toWorkload(pod *corev1.Pod, providerName string) *workload.Workload {
 			Image: pod.Spec.Containers[0].Image,
 		},
 		Status: workload.Status{
-			Phase:    toWorkloadPhase(pod.Status.Phase),
-			NodeID:   pod.Spec.NodeName,
-			Provider: providerName,
+			Phase:       toWorkloadPhase(pod.Status.Phase),
+			NodeID:      pod.Spec.NodeName,
+			Provider:    providerName,
+			ProviderRef: podReference(pod),
 		},
 		CreatedAt: pod.CreationTimestamp.Time,
 		UpdatedAt: pod.CreationTimestamp.Time,
 	}
 
+	setContainerStatus(&w.Status, pod)
+
 	// Convert command and args
 	if len(pod.Spec.Containers[0].Command) > 0 {
 		w.Spec.Command = pod.Spec.Containers[0].Command
@@ -129,6 +171,10 @@ func toWorkload(pod *corev1.Pod, providerName string) *workload.Workload {
 	if len(pod.Spec.Containers[0].Env) > 0 {
 		env := make(map[string]string)
 		for _, envVar := range pod.Spec.Containers[0].Env {
+			// Secret-backed variables are not part of the plain environment
+			if envVar.ValueFrom != nil {
+				continue
+			}
 			env[envVar.Name] = envVar.Value
 		}
 		w.Spec.Env = env
@@ -162,6 +208,49 @@ func toWorkload(pod *corev1.Pod, providerName string) *workload.Workload {
 	return w
 }
 
+// setContainerStatus copies the restarts and last exit of the workload's
+// container from the pod status
+func setContainerStatus(status *workload.Status, pod *corev1.Pod) {
+	for _, container := range pod.Status.ContainerStatuses {
+		if container.Name != pod.Spec.Containers[0].Name {
+			continue
+		}
+
+		status.RestartCount = container.RestartCount
+		if terminated := container.State.Terminated; terminated != nil {
+			status.ExitCode = terminated.ExitCode
+		} else if terminated := container.LastTerminationState.Terminated; terminated != nil {
+			status.ExitCode = terminated.ExitCode
+		}
+		if waiting := container.State.Waiting; waiting != nil && waiting.Reason == workload.ReasonCrashLoopBackOff {
+			status.Reason = waiting.Reason
+			status.Message = waiting.Message
+		}
+		return
+	}
+}
+
+// parseLogLine splits the RFC 3339 timestamp Kubernetes prefixes log lines with
+func parseLogLine(line string) provider.LogLine {
+	if i := strings.IndexByte(line, ' '); i > 0 {
+		if t, err := time.Parse(time.RFC3339Nano, line[:i]); err == nil {
+			return provider.LogLine{Time: t, Text: line[i+1:]}
+		}
+	}
+	return provider.LogLine{Text: line}
+}
+
+// podReference builds the provider reference for a pod
+func podReference(pod *corev1.Pod) *workload.ProviderReference {
+	return &workload.ProviderReference{
+		ExternalID: string(pod.UID),
+		Name:       pod.Name,
+		Metadata: map[string]string{
+			"namespace": pod.Namespace,
+		},
+	}
+}
+
 // podPhaseToWorkloadPhase converts Pod phase to Workload phase
 func toWorkloadPhase(phase corev1.PodPhase) workload.Phase {
 	switch phase {
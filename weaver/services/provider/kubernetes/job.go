@@ -0,0 +1,257 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/codecflow/fabric/pkg/workload"
+)
+
+// jobKind marks provider references to Kubernetes Jobs
+const jobKind = "Job"
+
+// RunsJobs reports that Job workloads run as Kubernetes Jobs, which enforce
+// their completions, parallelism, backoff limit and deadline
+func (p *Provider) RunsJobs() bool {
+	return true
+}
+
+// createJob runs a job workload's pod as a Kubernetes Job
+func (p *Provider) createJob(ctx context.Context, w *workload.Workload, pod *corev1.Pod, hasSecret bool) error {
+	created, err := p.client.BatchV1().Jobs(pod.Namespace).Create(ctx, toJob(w, pod), metav1.CreateOptions{})
+	if err != nil {
+		if hasSecret {
+			_ = p.deleteSecret(ctx, pod.Namespace, pod.Name)
+		}
+		return fmt.Errorf("failed to create job: %w", err)
+	}
+
+	w.Status.ProviderRef = jobReference(created)
+
+	if hasSecret {
+		// Best effort: DeleteWorkload removes the secret as well
+		_ = p.ownSecret(ctx, created.Namespace, metav1.OwnerReference{
+			APIVersion: "batch/v1",
+			Kind:       jobKind,
+			Name:       created.Name,
+			UID:        created.UID,
+		})
+	}
+
+	return nil
+}
+
+// getJob returns the status of a job workload, with the exit code of its most
+// recent pod
+func (p *Provider) getJob(ctx context.Context, w *workload.Workload) (*workload.Workload, error) {
+	job, err := p.findJob(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+
+	observed := toJobWorkload(job, p.name)
+	if pod, err := p.findPod(ctx, w); err == nil {
+		setContainerStatus(&observed.Status, pod)
+	}
+
+	return observed, nil
+}
+
+// updateJob applies label changes to a job. The pod template of a Job cannot
+// change, so other changes are rejected.
+func (p *Provider) updateJob(ctx context.Context, w *workload.Workload) error {
+	job, err := p.findJob(ctx, w)
+	if err != nil {
+		return err
+	}
+
+	for _, container := range job.Spec.Template.Spec.Containers {
+		if container.Name == w.Name && container.Image != w.Spec.Image {
+			return fmt.Errorf("the image of job %s cannot change while it runs", job.Name)
+		}
+	}
+
+	job.Labels = podLabels(w)
+	_, err = p.client.BatchV1().Jobs(job.Namespace).Update(ctx, job, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update job %s: %w", job.Name, err)
+	}
+	return nil
+}
+
+// deleteJob deletes a job along with its pods and secret. A job that is
+// already gone counts as deleted.
+func (p *Provider) deleteJob(ctx context.Context, w *workload.Workload) error {
+	job, err := p.findJob(ctx, w)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	propagation := metav1.DeletePropagationBackground
+	err = p.client.BatchV1().Jobs(job.Namespace).Delete(ctx, job.Name, metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete job %s: %w", job.Name, err)
+	}
+
+	return p.deleteSecret(ctx, job.Namespace, job.Name)
+}
+
+// findJob resolves the Job backing a workload through its provider reference,
+// falling back to the workload ID label
+func (p *Provider) findJob(ctx context.Context, w *workload.Workload) (*batchv1.Job, error) {
+	if ref := w.Status.ProviderRef; ref != nil && ref.Name != "" {
+		namespace := ref.Metadata["namespace"]
+		if namespace == "" {
+			namespace = p.namespace
+		}
+
+		job, err := p.client.BatchV1().Jobs(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get job %s: %w", ref.Name, err)
+		}
+		return job, nil
+	}
+
+	jobs, err := p.client.BatchV1().Jobs(p.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("fabric.workload.id=%s", w.ID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	if len(jobs.Items) == 0 {
+		return nil, fmt.Errorf("workload not found")
+	}
+
+	return &jobs.Items[0], nil
+}
+
+// toJob wraps a job workload's pod in a Kubernetes Job. The TTL is left to
+// Weaver, which deletes the Job along with the workload.
+func toJob(w *workload.Workload, pod *corev1.Pod) *batchv1.Job {
+	spec := w.Spec
+	spec.Default()
+	completions, parallelism, backoffLimit := spec.Job.Completions, spec.Job.Parallelism, *spec.Job.BackoffLimit
+
+	template := pod.Spec
+	template.RestartPolicy = corev1.RestartPolicyNever
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+			Labels:    pod.Labels,
+		},
+		Spec: batchv1.JobSpec{
+			Completions:  &completions,
+			Parallelism:  &parallelism,
+			BackoffLimit: &backoffLimit,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: pod.Labels},
+				Spec:       template,
+			},
+		},
+	}
+
+	if deadline := spec.Job.ActiveDeadlineSeconds; deadline > 0 {
+		job.Spec.ActiveDeadlineSeconds = &deadline
+	}
+
+	return job
+}
+
+// toJobWorkload converts a Kubernetes Job to a Fabric workload. The job
+// succeeds or fails as a whole once Kubernetes adds the matching condition.
+func toJobWorkload(job *batchv1.Job, providerName string) *workload.Workload {
+	w := &workload.Workload{
+		ID:        job.Labels["fabric.workload.id"],
+		Name:      job.Name,
+		Namespace: job.Namespace,
+		Spec: workload.Spec{
+			Kind: workload.KindJob,
+		},
+		Status: workload.Status{
+			Phase:       workload.PhasePending,
+			Provider:    providerName,
+			ProviderRef: jobReference(job),
+			Job: &workload.JobStatus{
+				Active:    job.Status.Active,
+				Succeeded: job.Status.Succeeded,
+				Failed:    job.Status.Failed,
+			},
+		},
+		CreatedAt: job.CreationTimestamp.Time,
+		UpdatedAt: job.CreationTimestamp.Time,
+	}
+
+	if containers := job.Spec.Template.Spec.Containers; len(containers) > 0 {
+		w.Spec.Image = containers[0].Image
+	}
+	if job.Status.Active > 0 {
+		w.Status.Phase = workload.PhaseRunning
+	}
+	if job.Status.StartTime != nil {
+		started := job.Status.StartTime.Time
+		w.Status.StartTime = &started
+		w.Status.Job.StartTime = &started
+	}
+	if job.Status.CompletionTime != nil {
+		completed := job.Status.CompletionTime.Time
+		w.Status.Job.CompletionTime = &completed
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		finished := condition.LastTransitionTime.Time
+		switch condition.Type {
+		case batchv1.JobComplete:
+			w.Status.Phase = workload.PhaseSucceeded
+			w.Status.Reason = workload.ReasonCompleted
+		case batchv1.JobFailed:
+			// Kubernetes uses the same reasons, e.g. BackoffLimitExceeded and DeadlineExceeded
+			w.Status.Phase = workload.PhaseFailed
+			w.Status.Reason = condition.Reason
+			w.Status.Message = condition.Message
+		default:
+			continue
+		}
+		w.Status.FinishTime = &finished
+	}
+
+	return w
+}
+
+// jobReference builds the provider reference for a Job
+func jobReference(job *batchv1.Job) *workload.ProviderReference {
+	return &workload.ProviderReference{
+		ExternalID: string(job.UID),
+		Name:       job.Name,
+		Metadata: map[string]string{
+			"namespace": job.Namespace,
+			"kind":      jobKind,
+		},
+	}
+}
+
+// ownedByJob reports whether a pod was created by a Job
+func ownedByJob(pod *corev1.Pod) bool {
+	for _, owner := range pod.OwnerReferences {
+		if owner.Kind == jobKind {
+			return true
+		}
+	}
+	return false
+}
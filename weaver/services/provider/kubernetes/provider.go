@@ -1,15 +1,18 @@
 package kubernetes
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/codecflow/fabric/pkg/quantity"
 	"github.com/codecflow/fabric/pkg/workload"
 	"github.com/codecflow/fabric/weaver/services/provider"
 )
@@ -19,6 +22,7 @@ type Provider struct {
 	client    *Client
 	namespace string
 	name      string
+	secrets   provider.SecretSource
 }
 
 // New creates a new Kubernetes provider
@@ -65,75 +69,205 @@ func (p *Provider) Type() provider.ProviderType {
 
 // CreateWorkload creates a new workload in Kubernetes
 func (p *Provider) CreateWorkload(ctx context.Context, w *workload.Workload) error {
-	pod := toPod(w, p.namespace)
+	pod, err := toPod(w, p.namespace)
+	if err != nil {
+		return err
+	}
 
-	_, err := p.client.CoreV1().Pods(p.namespace).Create(ctx, pod, metav1.CreateOptions{})
+	// Secret values go into a Kubernetes Secret the pod references, never into the pod spec
+	resolved, err := provider.ResolveSecrets(ctx, p.secrets, w)
 	if err != nil {
+		return err
+	}
+	if !resolved.Empty() {
+		if err := p.applySecret(ctx, toSecret(w, pod, resolved)); err != nil {
+			return err
+		}
+		attachSecret(pod, w, resolved)
+	}
+
+	if w.Spec.IsJob() {
+		return p.createJob(ctx, w, pod, !resolved.Empty())
+	}
+
+	created, err := p.client.CoreV1().Pods(p.namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		if !resolved.Empty() {
+			_ = p.deleteSecret(ctx, pod.Namespace, pod.Name)
+		}
 		return fmt.Errorf("failed to create pod: %w", err)
 	}
 
+	w.Status.ProviderRef = podReference(created)
+
+	if !resolved.Empty() {
+		// Best effort: DeleteWorkload removes the secret as well
+		_ = p.ownSecret(ctx, created.Namespace, metav1.OwnerReference{
+			APIVersion: "v1",
+			Kind:       "Pod",
+			Name:       created.Name,
+			UID:        created.UID,
+		})
+	}
+
 	return nil
 }
 
 // GetWorkload retrieves a workload from Kubernetes
-func (p *Provider) GetWorkload(ctx context.Context, id string) (*workload.Workload, error) {
-	pods, err := p.client.CoreV1().Pods(p.namespace).List(ctx, metav1.ListOptions{
-		LabelSelector: fmt.Sprintf("fabric.workload.id=%s", id),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list pods: %w", err)
+func (p *Provider) GetWorkload(ctx context.Context, w *workload.Workload) (*workload.Workload, error) {
+	if w.Spec.IsJob() {
+		return p.getJob(ctx, w)
 	}
 
-	if len(pods.Items) == 0 {
-		return nil, fmt.Errorf("workload not found")
+	pod, err := p.findPod(ctx, w)
+	if err != nil {
+		return nil, err
 	}
 
-	pod := pods.Items[0]
-	return toWorkload(&pod, p.name), nil
+	return toWorkload(pod, p.name), nil
 }
 
-// UpdateWorkload updates a workload in Kubernetes
+// UpdateWorkload applies label and image changes to the workload's pod.
+// Kubernetes restarts the container when its image changes.
 func (p *Provider) UpdateWorkload(ctx context.Context, w *workload.Workload) error {
-	pods, err := p.client.CoreV1().Pods(p.namespace).List(ctx, metav1.ListOptions{
-		LabelSelector: fmt.Sprintf("fabric.workload.id=%s", w.ID),
-	})
+	if w.Spec.IsJob() {
+		return p.updateJob(ctx, w)
+	}
+
+	pod, err := p.findPod(ctx, w)
 	if err != nil {
-		return fmt.Errorf("failed to list pods: %w", err)
+		return err
 	}
 
-	if len(pods.Items) == 0 {
-		return fmt.Errorf("workload not found")
+	pod.Labels = podLabels(w)
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == w.Name {
+			pod.Spec.Containers[i].Image = w.Spec.Image
+		}
 	}
 
-	pod := &pods.Items[0]
-	if pod.Labels == nil {
-		pod.Labels = make(map[string]string)
+	_, err = p.client.CoreV1().Pods(pod.Namespace).Update(ctx, pod, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update pod %s: %w", pod.Name, err)
 	}
-	pod.Labels["fabric.workload.name"] = w.Name
+	return nil
+}
 
-	_, err = p.client.CoreV1().Pods(p.namespace).Update(ctx, pod, metav1.UpdateOptions{})
-	return err
+// UpdatesInPlace reports the changes UpdateWorkload applies; the rest of a
+// pod's spec is immutable, and so is the whole pod template of a job
+func (p *Provider) UpdatesInPlace(w *workload.Workload, change workload.Change) bool {
+	if w.Spec.IsJob() {
+		return change == workload.ChangeLabels
+	}
+	return change == workload.ChangeLabels || change == workload.ChangeImage
 }
 
-// DeleteWorkload deletes a workload from Kubernetes
-func (p *Provider) DeleteWorkload(ctx context.Context, id string) error {
-	pods, err := p.client.CoreV1().Pods(p.namespace).List(ctx, metav1.ListOptions{
-		LabelSelector: fmt.Sprintf("fabric.workload.id=%s", id),
-	})
+// RestartsWorkloads reports that the kubelet restarts containers according to
+// the pod's restart policy
+func (p *Provider) RestartsWorkloads() bool {
+	return true
+}
+
+// DeleteWorkload deletes a workload from Kubernetes. A pod that is already gone
+// counts as deleted.
+func (p *Provider) DeleteWorkload(ctx context.Context, w *workload.Workload) error {
+	if w.Spec.IsJob() {
+		return p.deleteJob(ctx, w)
+	}
+
+	pod, err := p.findPod(ctx, w)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
 	if err != nil {
-		return fmt.Errorf("failed to list pods: %w", err)
+		return err
 	}
 
-	for _, pod := range pods.Items {
-		err := p.client.CoreV1().Pods(p.namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
-		if err != nil {
-			return fmt.Errorf("failed to delete pod %s: %w", pod.Name, err)
+	err = p.client.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete pod %s: %w", pod.Name, err)
+	}
+
+	return p.deleteSecret(ctx, pod.Namespace, pod.Name)
+}
+
+// StreamLogs streams the logs of one of the workload's pod containers
+func (p *Provider) StreamLogs(ctx context.Context, w *workload.Workload, opts provider.LogOptions, emit func(provider.LogLine) error) error {
+	pod, err := p.findPod(ctx, w)
+	if err != nil {
+		return err
+	}
+
+	logOptions := &corev1.PodLogOptions{
+		Container:  opts.Container,
+		Follow:     opts.Follow,
+		Timestamps: true,
+	}
+	if opts.TailLines > 0 {
+		logOptions.TailLines = &opts.TailLines
+	}
+	if !opts.Since.IsZero() {
+		since := metav1.NewTime(opts.Since)
+		logOptions.SinceTime = &since
+	}
+
+	logs, err := p.client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions).Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to stream logs of pod %s: %w", pod.Name, err)
+	}
+	defer func() { _ = logs.Close() }()
+
+	scanner := bufio.NewScanner(logs)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if err := emit(parseLogLine(scanner.Text())); err != nil {
+			return err
 		}
 	}
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("failed to read logs of pod %s: %w", pod.Name, err)
+	}
 
 	return nil
 }
 
+// findPod resolves the pod backing a workload through its provider reference,
+// falling back to the workload ID label for pods created without one and for
+// jobs, whose most recent pod is used
+func (p *Provider) findPod(ctx context.Context, w *workload.Workload) (*corev1.Pod, error) {
+	if ref := w.Status.ProviderRef; ref != nil && ref.Name != "" && ref.Metadata["kind"] != jobKind {
+		namespace := ref.Metadata["namespace"]
+		if namespace == "" {
+			namespace = p.namespace
+		}
+
+		pod, err := p.client.CoreV1().Pods(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pod %s: %w", ref.Name, err)
+		}
+		return pod, nil
+	}
+
+	pods, err := p.client.CoreV1().Pods(p.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("fabric.workload.id=%s", w.ID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	if len(pods.Items) == 0 {
+		return nil, fmt.Errorf("workload not found")
+	}
+
+	latest := &pods.Items[0]
+	for i := range pods.Items {
+		if latest.CreationTimestamp.Before(&pods.Items[i].CreationTimestamp) {
+			latest = &pods.Items[i]
+		}
+	}
+	return latest, nil
+}
+
 // ListWorkloads lists workloads in a namespace
 func (p *Provider) ListWorkloads(ctx context.Context, namespace string) ([]*workload.Workload, error) {
 	if namespace == "" {
@@ -149,10 +283,24 @@ func (p *Provider) ListWorkloads(ctx context.Context, namespace string) ([]*work
 
 	var workloads []*workload.Workload
 	for _, pod := range pods.Items {
+		// The pods of a job are listed as the job
+		if ownedByJob(&pod) {
+			continue
+		}
 		w := toWorkload(&pod, p.name)
 		workloads = append(workloads, w)
 	}
 
+	jobs, err := p.client.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "fabric.workload.id",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+	for i := range jobs.Items {
+		workloads = append(workloads, toJobWorkload(&jobs.Items[i], p.name))
+	}
+
 	return workloads, nil
 }
 
@@ -177,21 +325,21 @@ func (p *Provider) GetAvailableResources(ctx context.Context) (*provider.Resourc
 		}
 
 		// Check for GPUs
-		for resourceName, quantity := range node.Status.Capacity {
+		for resourceName, capacity := range node.Status.Capacity {
 			if strings.Contains(string(resourceName), "gpu") {
 				gpuType := string(resourceName)
 				if _, exists := gpuTypes[gpuType]; !exists {
 					gpuTypes[gpuType] = provider.GPUTypeInfo{
 						Name:         gpuType,
 						Memory:       "16Gi",
-						Total:        int(quantity.Value()),
-						Available:    int(quantity.Value()),
+						Total:        int(capacity.Value()),
+						Available:    int(capacity.Value()),
 						PricePerHour: 0.0,
 					}
 				} else {
 					info := gpuTypes[gpuType]
-					info.Total += int(quantity.Value())
-					info.Available += int(quantity.Value())
+					info.Total += int(capacity.Value())
+					info.Available += int(capacity.Value())
 					gpuTypes[gpuType] = info
 				}
 			}
@@ -301,11 +449,10 @@ func (p *Provider) GetStatus(ctx context.Context) (*provider.ProviderStatus, err
 		resources, resourceErr := p.GetAvailableResources(ctx)
 		if resourceErr == nil {
 			if resources.CPU.Total != "" && resources.CPU.Used != "" {
-				totalCPU := resource.MustParse(resources.CPU.Total)
-				usedCPU := resource.MustParse(resources.CPU.Used)
-				if !totalCPU.IsZero() {
-					cpuLoad := float64(usedCPU.MilliValue()) / float64(totalCPU.MilliValue())
-					load = cpuLoad
+				totalCPU, totalErr := quantity.ParseCPU(resources.CPU.Total)
+				usedCPU, usedErr := quantity.ParseCPU(resources.CPU.Used)
+				if totalErr == nil && usedErr == nil && totalCPU > 0 {
+					load = float64(usedCPU) / float64(totalCPU)
 				}
 			}
 		}
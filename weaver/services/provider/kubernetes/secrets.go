@@ -0,0 +1,151 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/services/provider"
+)
+
+// SetSecretSource sets where the secrets referenced by workloads are read from
+func (p *Provider) SetSecretSource(source provider.SecretSource) {
+	p.secrets = source
+}
+
+// secretName returns the name of the Kubernetes Secret holding a pod's resolved secrets
+func secretName(podName string) string {
+	return podName + "-secrets"
+}
+
+// toSecret builds the Kubernetes Secret for a workload's resolved secrets.
+// Environment variables are keyed by name and files by their index.
+func toSecret(w *workload.Workload, pod *corev1.Pod, resolved *provider.ResolvedSecrets) *corev1.Secret {
+	data := make(map[string][]byte, len(resolved.EnvVars)+len(resolved.Files))
+	for _, v := range resolved.EnvVars {
+		data[v.Name] = v.Value
+	}
+	for i, f := range resolved.Files {
+		data[fileKey(i)] = f.Value
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName(pod.Name),
+			Namespace: pod.Namespace,
+			Labels: map[string]string{
+				"fabric.workload.id":   w.ID,
+				"fabric.workload.name": w.Name,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	}
+}
+
+// fileKey returns the Secret key of the i-th resolved file
+func fileKey(i int) string {
+	return fmt.Sprintf("file-%d", i)
+}
+
+// attachSecret references the workload's Secret from the pod's environment and volumes
+func attachSecret(pod *corev1.Pod, w *workload.Workload, resolved *provider.ResolvedSecrets) {
+	name := secretName(pod.Name)
+	container := &pod.Spec.Containers[0]
+
+	for _, v := range resolved.EnvVars {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name: v.Name,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: name},
+					Key:                  v.Name,
+				},
+			},
+		})
+	}
+
+	for _, volume := range w.Spec.Volumes {
+		if volume.Secret == nil {
+			continue
+		}
+
+		var items []corev1.KeyToPath
+		for i, f := range resolved.Files {
+			if f.Volume == volume.Name {
+				items = append(items, corev1.KeyToPath{Key: fileKey(i), Path: f.Key})
+			}
+		}
+
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: volume.Name,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: name, Items: items},
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      volume.Name,
+			MountPath: volume.MountPath,
+			ReadOnly:  true,
+		})
+	}
+}
+
+// applySecret creates or replaces the Secret backing a pod
+func (p *Provider) applySecret(ctx context.Context, secret *corev1.Secret) error {
+	secrets := p.client.CoreV1().Secrets(secret.Namespace)
+
+	_, err := secrets.Create(ctx, secret, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to store secret %s: %w", secret.Name, err)
+	}
+	return nil
+}
+
+// ownSecret makes the pod or job the Secret was created for its owner, so it
+// is garbage collected with it
+func (p *Provider) ownSecret(ctx context.Context, namespace string, owner metav1.OwnerReference) error {
+	secrets := p.client.CoreV1().Secrets(namespace)
+
+	secret, err := secrets.Get(ctx, secretName(owner.Name), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	secret.OwnerReferences = []metav1.OwnerReference{owner}
+
+	_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// deleteSecret removes the Secret backing a pod or job, if any
+func (p *Provider) deleteSecret(ctx context.Context, namespace, owner string) error {
+	err := p.client.CoreV1().Secrets(namespace).Delete(ctx, secretName(owner), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete secret %s: %w", secretName(owner), err)
+	}
+	return nil
+}
+
+// UnmountSecrets removes the Secrets created for a workload's pods
+func (p *Provider) UnmountSecrets(ctx context.Context, w *workload.Workload) error {
+	namespace := p.namespace
+	if ref := w.Status.ProviderRef; ref != nil && ref.Metadata["namespace"] != "" {
+		namespace = ref.Metadata["namespace"]
+	}
+
+	err := p.client.CoreV1().Secrets(namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("fabric.workload.id=%s", w.ID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete secrets of workload %s: %w", w.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codecflow/fabric/pkg/workload"
+)
+
+// textLogPollInterval is how often logs are refetched when following providers
+// that only return the full log
+const textLogPollInterval = 2 * time.Second
+
+// LogOptions selects which logs to stream
+type LogOptions struct {
+	Container string    // resolved container name, the workload name for the main container
+	Follow    bool      // keep streaming new lines until the context ends
+	TailLines int64     // only the last lines when positive
+	Since     time.Time // only lines written after this time when set
+}
+
+// LogLine is a single line of workload output
+type LogLine struct {
+	Time   time.Time // zero when the provider does not report it
+	Stream string    // stdout or stderr, when known
+	Text   string
+}
+
+// LogProvider is implemented by providers that can read workload logs
+type LogProvider interface {
+	// StreamLogs calls emit for every log line until the logs end, or with
+	// Follow until the context ends
+	StreamLogs(ctx context.Context, w *workload.Workload, opts LogOptions, emit func(LogLine) error) error
+}
+
+// ResolveContainer returns the container a log request selects. The main
+// container is named after the workload and sidecars by their own name.
+func ResolveContainer(w *workload.Workload, container string) (string, error) {
+	if container == "" || container == w.Name {
+		return w.Name, nil
+	}
+	for _, sidecar := range w.Spec.Sidecars {
+		if sidecar.Name == container {
+			return container, nil
+		}
+	}
+	return "", fmt.Errorf("workload %s has no container %s", w.Name, container)
+}
+
+// StreamTextLogs serves logs for providers whose API returns the whole log as
+// text. Following refetches the log and emits the lines added since.
+func StreamTextLogs(ctx context.Context, w *workload.Workload, opts LogOptions, fetch func(ctx context.Context) (string, error), emit func(LogLine) error) error {
+	if opts.Container != w.Name {
+		return fmt.Errorf("only logs of the main container are available for workload %s", w.Name)
+	}
+	if !opts.Since.IsZero() {
+		return fmt.Errorf("filtering logs by time is not supported for workload %s", w.Name)
+	}
+
+	text, err := fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get logs: %w", err)
+	}
+
+	lines := splitLines(text)
+	start := 0
+	if opts.TailLines > 0 && int64(len(lines)) > opts.TailLines {
+		start = len(lines) - int(opts.TailLines)
+	}
+	for _, line := range lines[start:] {
+		if err := emit(LogLine{Text: line}); err != nil {
+			return err
+		}
+	}
+
+	if !opts.Follow {
+		return nil
+	}
+
+	ticker := time.NewTicker(textLogPollInterval)
+	defer ticker.Stop()
+
+	seen := len(lines)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		text, err := fetch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to get logs: %w", err)
+		}
+
+		lines := splitLines(text)
+		// A shorter log was truncated by the provider, so all of it is new
+		if len(lines) < seen {
+			seen = 0
+		}
+		for _, line := range lines[seen:] {
+			if err := emit(LogLine{Text: line}); err != nil {
+				return err
+			}
+		}
+		seen = len(lines)
+	}
+}
+
+// splitLines splits text into lines without the trailing empty line
+func splitLines(text string) []string {
+	text = strings.TrimSuffix(text, "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
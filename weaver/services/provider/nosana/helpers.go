@@ -1,110 +1,83 @@
 package nosana
 
 import (
+	"fmt"
 	"strconv"
-	"strings"
 
+	"github.com/codecflow/fabric/pkg/quantity"
 	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/services/provider"
 )
 
 // parseResources converts Fabric resource specifications to Nosana format
-func parseResources(w *workload.Workload) Resources {
+func parseResources(w *workload.Workload) (Resources, error) {
+	cpu, err := parseCPU(w.Spec.Resources.CPU)
+	if err != nil {
+		return Resources{}, fmt.Errorf("invalid cpu: %w", err)
+	}
+	memory, err := parseMemory(w.Spec.Resources.Memory)
+	if err != nil {
+		return Resources{}, fmt.Errorf("invalid memory: %w", err)
+	}
+	gpu, err := parseGPU(w.Spec.Resources.GPU)
+	if err != nil {
+		return Resources{}, fmt.Errorf("invalid gpu: %w", err)
+	}
+
 	return Resources{
-		CPU:    parseCPU(w.Spec.Resources.CPU),
-		Memory: parseMemory(w.Spec.Resources.Memory),
-		GPU:    parseGPU(w.Spec.Resources.GPU),
+		CPU:    cpu,
+		Memory: memory,
+		GPU:    gpu,
 		Disk:   "20Gi", // Default disk size since storage not in ResourceRequests
-	}
+	}, nil
 }
 
-// parseCPU converts CPU specification to Nosana format
-func parseCPU(cpuSpec string) string {
+// parseCPU converts CPU specification to whole Nosana cores, rounding up
+func parseCPU(cpuSpec string) (string, error) {
 	if cpuSpec == "" {
-		return "2" // Default 2 cores
-	}
-
-	// Handle Kubernetes-style CPU specifications
-	if strings.HasSuffix(cpuSpec, "m") {
-		// Millicores (e.g., "2000m" = 2 cores)
-		milliStr := strings.TrimSuffix(cpuSpec, "m")
-		if milli, err := strconv.Atoi(milliStr); err == nil {
-			cores := milli / 1000
-			if cores < 1 {
-				cores = 1
-			}
-			return strconv.Itoa(cores)
-		}
+		return "2", nil // Default 2 cores
 	}
 
-	// Try to parse as direct number
-	if cores, err := strconv.Atoi(cpuSpec); err == nil {
-		return strconv.Itoa(cores)
+	milli, err := quantity.ParseCPU(cpuSpec)
+	if err != nil {
+		return "", err
 	}
 
-	return "2" // Default
+	cores := quantity.RoundUp(milli, 1000)
+	if cores < 1 {
+		cores = 1
+	}
+	return strconv.FormatInt(cores, 10), nil
 }
 
-// parseMemory converts memory specification to Nosana format
-func parseMemory(memorySpec string) string {
+// parseMemory converts memory specification to whole Gi for Nosana, rounding up
+func parseMemory(memorySpec string) (string, error) {
 	if memorySpec == "" {
-		return "4Gi" // Default 4GB
+		return "4Gi", nil // Default 4GB
 	}
 
-	// Normalize to Gi format for Nosana
-	spec := strings.ToLower(memorySpec)
-
-	if strings.HasSuffix(spec, "gi") {
-		return memorySpec // Already in correct format
+	b, err := quantity.ParseBytes(memorySpec)
+	if err != nil {
+		return "", err
 	}
 
-	if strings.HasSuffix(spec, "gb") {
-		// Convert GB to Gi (approximately)
-		gbStr := strings.TrimSuffix(spec, "gb")
-		if gb, err := strconv.Atoi(gbStr); err == nil {
-			return strconv.Itoa(gb) + "Gi"
-		}
+	gi := quantity.RoundUp(b, quantity.Gi)
+	if gi < 1 {
+		gi = 1
 	}
-
-	if strings.HasSuffix(spec, "mi") {
-		// Convert Mi to Gi
-		miStr := strings.TrimSuffix(spec, "mi")
-		if mi, err := strconv.Atoi(miStr); err == nil {
-			gi := mi / 1024
-			if gi < 1 {
-				gi = 1
-			}
-			return strconv.Itoa(gi) + "Gi"
-		}
-	}
-
-	// Try to parse as direct number (assume GB)
-	if gb, err := strconv.Atoi(spec); err == nil {
-		return strconv.Itoa(gb) + "Gi"
-	}
-
-	return "4Gi" // Default
+	return strconv.FormatInt(gi, 10) + "Gi", nil
 }
 
 // parseGPU converts GPU specification to Nosana format
-func parseGPU(gpuSpec string) string {
-	if gpuSpec == "" {
-		return "" // No GPU required
+func parseGPU(gpuSpec string) (string, error) {
+	gpu, err := quantity.ParseGPU(gpuSpec)
+	if err != nil {
+		return "", err
 	}
-
-	// Extract GPU count and type
-	if strings.Contains(gpuSpec, "=") {
-		parts := strings.Split(gpuSpec, "=")
-		if len(parts) > 1 {
-			return parts[1] // Return the count/type part
-		}
-	}
-
-	// If it's just a number, assume that many GPUs
-	if count, err := strconv.Atoi(gpuSpec); err == nil {
-		return strconv.Itoa(count)
+	if gpu.IsZero() {
+		return "", nil // No GPU required
 	}
-
-	return gpuSpec // Return as-is
+	return gpu.String(), nil
 }
 
 // calculatePrice calculates job price based on resources and market rates
@@ -141,42 +114,27 @@ func (p *Provider) calculatePrice(resources Resources, market *Market) Price {
 	}
 }
 
-// parseMemoryToGB converts memory specification to GB
+// parseMemoryToGB converts memory specification to whole GB, rounding up.
+// Unparseable specifications count as no memory.
 func (p *Provider) parseMemoryToGB(memorySpec string) int {
 	if memorySpec == "" {
 		return 4
 	}
 
-	spec := strings.ToLower(memorySpec)
-
-	if strings.HasSuffix(spec, "gi") {
-		giStr := strings.TrimSuffix(spec, "gi")
-		if gi, err := strconv.Atoi(giStr); err == nil {
-			return gi // Close enough for pricing
-		}
-	}
-
-	if strings.HasSuffix(spec, "gb") {
-		gbStr := strings.TrimSuffix(spec, "gb")
-		if gb, err := strconv.Atoi(gbStr); err == nil {
-			return gb
-		}
+	b, err := quantity.ParseBytes(memorySpec)
+	if err != nil {
+		return 0
 	}
-
-	return 4 // Default
+	return int(quantity.RoundUp(b, quantity.Gi))
 }
 
 // parseGPUCount extracts GPU count from specification
 func (p *Provider) parseGPUCount(gpuSpec string) int {
-	if gpuSpec == "" {
-		return 0
+	gpu, err := quantity.ParseGPU(gpuSpec)
+	if err != nil {
+		return 1 // Default if GPU specified but count unclear
 	}
-
-	if count, err := strconv.Atoi(gpuSpec); err == nil {
-		return count
-	}
-
-	return 1 // Default if GPU specified but count unclear
+	return int(gpu.Count)
 }
 
 // nosanaJobToWorkload converts a Nosana job to a Fabric workload
@@ -192,12 +150,19 @@ func (p *Provider) nosanaJobToWorkload(job *Job) *workload.Workload {
 			Env:     job.Env,
 		},
 		Status: workload.Status{
-			Phase:    p.nosanaStatusToPhase(job.Status),
-			NodeID:   job.NodeID,
-			Provider: p.name,
+			Phase:       p.nosanaStatusToPhase(job.Status),
+			ExitCode:    int32(job.ExitCode), // nolint:gosec
+			NodeID:      job.NodeID,
+			Provider:    p.name,
+			ProviderRef: jobReference(job),
 		},
 	}
 
+	// Prefer the Fabric identity recorded on the job
+	if id := job.Env[provider.EnvWorkloadID]; id != "" {
+		w.ID = id
+	}
+
 	// Set resources from job
 	w.Spec.Resources = workload.ResourceRequests{
 		CPU:    job.Resources.CPU,
@@ -208,6 +173,22 @@ func (p *Provider) nosanaJobToWorkload(job *Job) *workload.Workload {
 	return w
 }
 
+// jobReference builds the provider reference for a Nosana job
+func jobReference(job *Job) *workload.ProviderReference {
+	ref := &workload.ProviderReference{
+		ExternalID: job.ID,
+		Name:       job.Name,
+		Metadata:   make(map[string]string),
+	}
+	if job.Market != "" {
+		ref.Metadata["market"] = job.Market
+	}
+	if job.Network != "" {
+		ref.Metadata["network"] = job.Network
+	}
+	return ref
+}
+
 // nosanaStatusToPhase converts Nosana job status to Fabric workload phase
 func (p *Provider) nosanaStatusToPhase(status string) workload.Phase {
 	switch status {
@@ -3,6 +3,7 @@ package nosana
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/codecflow/fabric/pkg/workload"
 	"github.com/codecflow/fabric/weaver/services/provider"
@@ -12,8 +13,9 @@ const Type provider.ProviderType = "nosana"
 
 // Provider implements the Provider interface for Nosana
 type Provider struct {
-	client *Client
-	name   string
+	client  *Client
+	name    string
+	secrets provider.SecretSource
 }
 
 // Config represents Nosana-specific configuration
@@ -64,9 +66,28 @@ func (p *Provider) Type() provider.ProviderType {
 	return Type
 }
 
+// SetSecretSource sets where the secrets referenced by workloads are read from
+func (p *Provider) SetSecretSource(source provider.SecretSource) {
+	p.secrets = source
+}
+
+// MountsSecretVolumes reports false, secrets are only passed as environment variables
+func (p *Provider) MountsSecretVolumes() bool {
+	return false
+}
+
 // CreateWorkload creates a new workload on Nosana
 func (p *Provider) CreateWorkload(ctx context.Context, w *workload.Workload) error {
-	resources := parseResources(w)
+	resources, err := parseResources(w)
+	if err != nil {
+		return fmt.Errorf("invalid resource requests: %w", err)
+	}
+
+	// Nosana only takes secrets as environment variables
+	env, err := provider.ResolveSecretEnv(ctx, p.secrets, w, p.name)
+	if err != nil {
+		return err
+	}
 
 	// Get available markets to select one
 	markets, err := p.client.ListMarkets(ctx)
@@ -86,39 +107,80 @@ func (p *Provider) CreateWorkload(ctx context.Context, w *workload.Workload) err
 		Image:     w.Spec.Image,
 		Command:   w.Spec.Command,
 		Args:      w.Spec.Args,
-		Env:       w.Spec.Env,
+		Env:       provider.WithWorkloadID(env, w.ID),
 		Resources: resources,
 		Price:     price,
 		Market:    market.ID,
 		Network:   "mainnet",
 	}
 
-	_, err = p.client.CreateJob(ctx, req)
+	// Nosana stops the job once what is left of the workload's deadline runs out
+	if timeout, ok := w.JobTimeout(time.Now()); ok {
+		req.Timeout = max(int64(timeout.Seconds()), 1)
+	}
+
+	job, err := p.client.CreateJob(ctx, req)
 	if err != nil {
 		return fmt.Errorf("failed to create Nosana job: %w", err)
 	}
 
+	w.Status.ProviderRef = jobReference(job)
+
 	return nil
 }
 
 // GetWorkload retrieves a workload from Nosana
-func (p *Provider) GetWorkload(ctx context.Context, id string) (*workload.Workload, error) {
-	job, err := p.client.GetJob(ctx, id)
+func (p *Provider) GetWorkload(ctx context.Context, w *workload.Workload) (*workload.Workload, error) {
+	if w.Status.ProviderRef == nil {
+		return nil, provider.ErrNoProviderReference
+	}
+
+	job, err := p.client.GetJob(ctx, w.Status.ProviderRef.ExternalID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get Nosana job: %w", err)
 	}
 
-	return p.nosanaJobToWorkload(job), nil
+	observed := p.nosanaJobToWorkload(job)
+	observed.ID = w.ID
+
+	return observed, nil
 }
 
-// UpdateWorkload updates a workload on Nosana
+// StreamLogs streams the job logs of a workload
+func (p *Provider) StreamLogs(ctx context.Context, w *workload.Workload, opts provider.LogOptions, emit func(provider.LogLine) error) error {
+	if w.Status.ProviderRef == nil {
+		return provider.ErrNoProviderReference
+	}
+
+	jobID := w.Status.ProviderRef.ExternalID
+	fetch := func(ctx context.Context) (string, error) {
+		return p.client.GetJobLogs(ctx, jobID)
+	}
+	return provider.StreamTextLogs(ctx, w, opts, fetch, emit)
+}
+
+// UpdateWorkload has nothing to apply: Nosana jobs cannot be changed once
+// created, so every change to the spec recreates the workload
 func (p *Provider) UpdateWorkload(ctx context.Context, w *workload.Workload) error {
-	return fmt.Errorf("Nosana does not support updating running jobs") // nolint:staticcheck
+	if w.Status.ProviderRef == nil {
+		return provider.ErrNoProviderReference
+	}
+	return nil
+}
+
+// CanSurge reports that a replacement can run next to the current instance;
+// Nosana jobs are tracked by ID, so instances of a workload never collide
+func (p *Provider) CanSurge(w *workload.Workload) bool {
+	return true
 }
 
 // DeleteWorkload deletes a workload from Nosana
-func (p *Provider) DeleteWorkload(ctx context.Context, id string) error {
-	err := p.client.CancelJob(ctx, id)
+func (p *Provider) DeleteWorkload(ctx context.Context, w *workload.Workload) error {
+	if w.Status.ProviderRef == nil {
+		return provider.ErrNoProviderReference
+	}
+
+	err := p.client.CancelJob(ctx, w.Status.ProviderRef.ExternalID)
 	if err != nil {
 		return fmt.Errorf("failed to cancel Nosana job: %w", err)
 	}
@@ -135,6 +197,11 @@ func (p *Provider) ListWorkloads(ctx context.Context, namespace string) ([]*work
 
 	var workloads []*workload.Workload
 	for _, job := range jobs {
+		// Only jobs created by Fabric carry a workload ID
+		if job.Env[provider.EnvWorkloadID] == "" {
+			continue
+		}
+
 		w := p.nosanaJobToWorkload(job)
 		workloads = append(workloads, w)
 	}
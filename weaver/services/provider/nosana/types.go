@@ -100,6 +100,7 @@ type JobRequest struct {
 	Price     Price             `json:"price"`
 	Market    string            `json:"market"`
 	Network   string            `json:"network"`
+	Timeout   int64             `json:"timeout,omitempty"` // seconds the job may run
 }
 
 // JobStatus represents possible job statuses
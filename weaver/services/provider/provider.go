@@ -2,21 +2,43 @@ package provider
 
 import (
 	"context"
+	"errors"
 
 	"github.com/codecflow/fabric/pkg/workload"
 )
 
+// ErrNoProviderReference is returned when a workload has not been created on the provider
+var ErrNoProviderReference = errors.New("workload has no provider reference")
+
+// EnvWorkloadID records the Fabric workload ID on resources of providers that
+// can only tag them through their environment. ListWorkloads returns only the
+// resources Fabric created, with the ID of the workload they belong to.
+const EnvWorkloadID = "FABRIC_WORKLOAD_ID"
+
+// WithWorkloadID returns a copy of env that records the workload ID
+func WithWorkloadID(env map[string]string, id string) map[string]string {
+	tagged := make(map[string]string, len(env)+1)
+	for name, value := range env {
+		tagged[name] = value
+	}
+	tagged[EnvWorkloadID] = id
+	return tagged
+}
+
 // Provider defines the interface for cloud providers
 type Provider interface {
 	// Provider metadata
 	Name() string
 	Type() ProviderType
 
-	// Workload lifecycle
+	// Workload lifecycle. CreateWorkload records the provider-side resource in
+	// workload.Status.ProviderRef, which Get, Update and Delete resolve through.
+	// UpdateWorkload applies the changes reported by InPlaceUpdater; anything
+	// else is changed by recreating the workload.
 	CreateWorkload(ctx context.Context, workload *workload.Workload) error
-	GetWorkload(ctx context.Context, id string) (*workload.Workload, error)
+	GetWorkload(ctx context.Context, workload *workload.Workload) (*workload.Workload, error)
 	UpdateWorkload(ctx context.Context, workload *workload.Workload) error
-	DeleteWorkload(ctx context.Context, id string) error
+	DeleteWorkload(ctx context.Context, workload *workload.Workload) error
 	ListWorkloads(ctx context.Context, namespace string) ([]*workload.Workload, error)
 
 	// Resource management
@@ -28,6 +50,45 @@ type Provider interface {
 	GetStatus(ctx context.Context) (*ProviderStatus, error)
 }
 
+// NodeSelector is implemented by providers that place workloads on individual
+// nodes they manage, such as self-hosted Shuttle machines
+type NodeSelector interface {
+	// SelectNode returns the ID of the node the workload should run on
+	SelectNode(ctx context.Context, workload *workload.Workload) (string, error)
+}
+
+// InPlaceUpdater is implemented by providers that can apply some changes to a
+// provisioned workload through UpdateWorkload without recreating it
+type InPlaceUpdater interface {
+	// UpdatesInPlace reports whether UpdateWorkload applies the change to the
+	// provisioned workload
+	UpdatesInPlace(workload *workload.Workload, change workload.Change) bool
+}
+
+// SurgeProvider is implemented by providers that can run a replacement
+// instance of a workload next to the current one, so a recreate can start the
+// replacement before stopping the current instance
+type SurgeProvider interface {
+	// CanSurge reports whether a second instance of the workload can be created
+	CanSurge(workload *workload.Workload) bool
+}
+
+// Restarter is implemented by providers that restart exited workloads
+// according to Spec.Restart themselves. Weaver recreates exited workloads on
+// other providers.
+type Restarter interface {
+	// RestartsWorkloads reports whether the provider enforces restart policies
+	RestartsWorkloads() bool
+}
+
+// JobRunner is implemented by providers that run Job workloads natively,
+// enforcing their completions, parallelism, backoff limit and deadline and
+// reporting their run counts. Weaver retries the runs of jobs on other providers.
+type JobRunner interface {
+	// RunsJobs reports whether the provider runs jobs as a whole
+	RunsJobs() bool
+}
+
 // ProviderType defines the type of provider
 type ProviderType string
 
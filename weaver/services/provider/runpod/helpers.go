@@ -1,13 +1,16 @@
 package runpod
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/codecflow/fabric/pkg/quantity"
 	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/services/provider"
 )
 
-// selectGPUType maps Fabric GPU requirements to RunPod GPU types
+// selectGPUType maps a requested Fabric GPU type to RunPod GPU types
 func (p *Provider) selectGPUType(gpuSpec string) string {
 	if gpuSpec == "" {
 		return "NVIDIA RTX A6000" // Default GPU type
@@ -26,90 +29,49 @@ func (p *Provider) selectGPUType(gpuSpec string) string {
 	}
 }
 
-// parseGPUCount extracts GPU count from resource specification
-func (p *Provider) parseGPUCount(gpuSpec string) int {
-	if gpuSpec == "" {
-		return 1
-	}
-
-	// Extract number from specifications like "2", "nvidia.com/gpu=2", etc.
-	parts := strings.Split(gpuSpec, "=")
-	if len(parts) > 1 {
-		if count, err := strconv.Atoi(parts[1]); err == nil {
-			return count
-		}
-	}
-
-	// Try to parse the spec directly as a number
-	if count, err := strconv.Atoi(gpuSpec); err == nil {
-		return count
-	}
-
-	return 1 // Default to 1 GPU
+// podResources holds the resource fields of a RunPod pod request
+type podResources struct {
+	gpuType  string
+	gpuCount int
+	vcpus    int
+	memoryGB int
 }
 
-// parseCPUCount extracts CPU count from resource specification
-func (p *Provider) parseCPUCount(cpuSpec string) int {
-	if cpuSpec == "" {
-		return 4 // Default CPU count
-	}
-
-	// Handle Kubernetes-style CPU specifications
-	if strings.HasSuffix(cpuSpec, "m") {
-		// Millicores (e.g., "2000m" = 2 cores)
-		milliStr := strings.TrimSuffix(cpuSpec, "m")
-		if milli, err := strconv.Atoi(milliStr); err == nil {
-			return milli / 1000
-		}
-	}
-
-	// Try to parse as direct number
-	if count, err := strconv.Atoi(cpuSpec); err == nil {
-		return count
+// parseResources converts Fabric resource specifications to RunPod pod resources.
+// RunPod only rents whole GPUs, CPUs and GB of memory, so requests are rounded up.
+func (p *Provider) parseResources(r workload.ResourceRequests) (podResources, error) {
+	res := podResources{
+		gpuCount: 1,  // Every RunPod pod has a GPU
+		vcpus:    4,  // Default CPU count
+		memoryGB: 16, // Default 16GB
 	}
 
-	return 4 // Default
-}
-
-// parseMemoryGB extracts memory in GB from resource specification
-func (p *Provider) parseMemoryGB(memorySpec string) int {
-	if memorySpec == "" {
-		return 16 // Default 16GB
+	gpu, err := quantity.ParseGPU(r.GPU)
+	if err != nil {
+		return podResources{}, fmt.Errorf("invalid gpu: %w", err)
 	}
-
-	// Remove units and convert to GB
-	spec := strings.ToLower(memorySpec)
-
-	if strings.HasSuffix(spec, "gi") {
-		// Gibibytes
-		giStr := strings.TrimSuffix(spec, "gi")
-		if gi, err := strconv.Atoi(giStr); err == nil {
-			return gi // Close enough for RunPod
-		}
+	if gpu.Count > 0 {
+		res.gpuCount = int(gpu.Count)
 	}
+	res.gpuType = p.selectGPUType(gpu.Type)
 
-	if strings.HasSuffix(spec, "gb") {
-		// Gigabytes
-		gbStr := strings.TrimSuffix(spec, "gb")
-		if gb, err := strconv.Atoi(gbStr); err == nil {
-			return gb
+	if r.CPU != "" {
+		milli, err := quantity.ParseCPU(r.CPU)
+		if err != nil {
+			return podResources{}, fmt.Errorf("invalid cpu: %w", err)
 		}
+		res.vcpus = int(quantity.RoundUp(milli, 1000))
 	}
 
-	if strings.HasSuffix(spec, "mi") {
-		// Mebibytes
-		miStr := strings.TrimSuffix(spec, "mi")
-		if mi, err := strconv.Atoi(miStr); err == nil {
-			return mi / 1024 // Convert to GB
+	if r.Memory != "" {
+		b, err := quantity.ParseBytes(r.Memory)
+		if err != nil {
+			return podResources{}, fmt.Errorf("invalid memory: %w", err)
 		}
+		res.memoryGB = int(quantity.RoundUp(b, quantity.Gi))
 	}
 
-	// Try to parse as direct number (assume GB)
-	if gb, err := strconv.Atoi(spec); err == nil {
-		return gb
-	}
-
-	return 16 // Default
+	return res, nil
 }
 
 // formatPorts converts Fabric port specifications to RunPod format
@@ -137,12 +99,18 @@ func (p *Provider) toWorkload(pod *Pod) *workload.Workload {
 			Env:   pod.Env,
 		},
 		Status: workload.Status{
-			Phase:    p.runPodStatusToPhase(pod.Status),
-			NodeID:   pod.MachineID,
-			Provider: p.name,
+			Phase:       p.runPodStatusToPhase(pod.Status),
+			NodeID:      pod.MachineID,
+			Provider:    p.name,
+			ProviderRef: podReference(pod),
 		},
 	}
 
+	// Prefer the Fabric identity recorded on the pod
+	if id := pod.Env[provider.EnvWorkloadID]; id != "" {
+		w.ID = id
+	}
+
 	// Convert ports if available
 	if pod.Runtime.Ports != nil {
 		var ports []workload.Port
@@ -158,6 +126,18 @@ func (p *Provider) toWorkload(pod *Pod) *workload.Workload {
 	return w
 }
 
+// podReference builds the provider reference for a RunPod pod
+func podReference(pod *Pod) *workload.ProviderReference {
+	ref := &workload.ProviderReference{
+		ExternalID: pod.ID,
+		Name:       pod.Name,
+	}
+	if pod.MachineID != "" {
+		ref.Metadata = map[string]string{"machineId": pod.MachineID}
+	}
+	return ref
+}
+
 // runPodStatusToPhase converts RunPod status to Fabric workload phase
 func (p *Provider) runPodStatusToPhase(status string) workload.Phase {
 	switch strings.ToLower(status) {
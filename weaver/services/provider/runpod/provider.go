@@ -12,8 +12,9 @@ const Type provider.ProviderType = "runpod"
 
 // Provider implements the Provider interface for RunPod
 type Provider struct {
-	client *Client
-	name   string
+	client  *Client
+	name    string
+	secrets provider.SecretSource
 }
 
 // Config represents RunPod-specific configuration
@@ -52,46 +53,90 @@ func (p *Provider) Type() provider.ProviderType {
 	return Type
 }
 
+// SetSecretSource sets where the secrets referenced by workloads are read from
+func (p *Provider) SetSecretSource(source provider.SecretSource) {
+	p.secrets = source
+}
+
+// MountsSecretVolumes reports false, secrets are only passed as environment variables
+func (p *Provider) MountsSecretVolumes() bool {
+	return false
+}
+
 // CreateWorkload creates a new workload on RunPod
 func (p *Provider) CreateWorkload(ctx context.Context, w *workload.Workload) error {
+	resources, err := p.parseResources(w.Spec.Resources)
+	if err != nil {
+		return fmt.Errorf("invalid resource requests: %w", err)
+	}
+
+	// RunPod only takes secrets as environment variables
+	env, err := provider.ResolveSecretEnv(ctx, p.secrets, w, p.name)
+	if err != nil {
+		return err
+	}
+
 	req := &CreatePodRequest{
 		Name:          w.Name,
 		ImageName:     w.Spec.Image,
-		GPUTypeID:     p.selectGPUType(w.Spec.Resources.GPU),
-		GPUCount:      p.parseGPUCount(w.Spec.Resources.GPU),
-		VCPUCount:     p.parseCPUCount(w.Spec.Resources.CPU),
-		MemoryInGB:    p.parseMemoryGB(w.Spec.Resources.Memory),
+		GPUTypeID:     resources.gpuType,
+		GPUCount:      resources.gpuCount,
+		VCPUCount:     resources.vcpus,
+		MemoryInGB:    resources.memoryGB,
 		ContainerDisk: 20, // Default 20GB
-		Env:           w.Spec.Env,
+		Env:           provider.WithWorkloadID(env, w.ID),
 		Ports:         p.formatPorts(w.Spec.Ports),
 	}
 
-	_, err := p.client.CreatePod(ctx, req)
+	pod, err := p.client.CreatePod(ctx, req)
 	if err != nil {
 		return fmt.Errorf("failed to create RunPod workload: %w", err)
 	}
 
+	w.Status.ProviderRef = podReference(pod)
+
 	return nil
 }
 
 // GetWorkload retrieves a workload from RunPod
-func (p *Provider) GetWorkload(ctx context.Context, id string) (*workload.Workload, error) {
-	pod, err := p.client.GetPod(ctx, id)
+func (p *Provider) GetWorkload(ctx context.Context, w *workload.Workload) (*workload.Workload, error) {
+	if w.Status.ProviderRef == nil {
+		return nil, provider.ErrNoProviderReference
+	}
+
+	pod, err := p.client.GetPod(ctx, w.Status.ProviderRef.ExternalID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get RunPod workload: %w", err)
 	}
 
-	return p.toWorkload(pod), nil
+	observed := p.toWorkload(pod)
+	observed.ID = w.ID
+
+	return observed, nil
 }
 
-// UpdateWorkload updates a workload on RunPod
+// UpdateWorkload has nothing to apply: RunPod pods cannot be changed once
+// created, so every change to the spec recreates the workload
 func (p *Provider) UpdateWorkload(ctx context.Context, w *workload.Workload) error {
-	return fmt.Errorf("RunPod does not support updating running workloads")
+	if w.Status.ProviderRef == nil {
+		return provider.ErrNoProviderReference
+	}
+	return nil
+}
+
+// CanSurge reports that a replacement can run next to the current instance;
+// RunPod pods are tracked by ID, so instances of a workload never collide
+func (p *Provider) CanSurge(w *workload.Workload) bool {
+	return true
 }
 
 // DeleteWorkload deletes a workload from RunPod
-func (p *Provider) DeleteWorkload(ctx context.Context, id string) error {
-	err := p.client.TerminatePod(ctx, id)
+func (p *Provider) DeleteWorkload(ctx context.Context, w *workload.Workload) error {
+	if w.Status.ProviderRef == nil {
+		return provider.ErrNoProviderReference
+	}
+
+	err := p.client.TerminatePod(ctx, w.Status.ProviderRef.ExternalID)
 	if err != nil {
 		return fmt.Errorf("failed to delete RunPod workload: %w", err)
 	}
@@ -108,6 +153,11 @@ func (p *Provider) ListWorkloads(ctx context.Context, namespace string) ([]*work
 
 	var workloads []*workload.Workload
 	for _, pod := range pods {
+		// Only pods created by Fabric carry a workload ID
+		if pod.Env[provider.EnvWorkloadID] == "" {
+			continue
+		}
+
 		w := p.toWorkload(pod)
 		workloads = append(workloads, w)
 	}
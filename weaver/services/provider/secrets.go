@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/codecflow/fabric/pkg/secret"
+	"github.com/codecflow/fabric/pkg/workload"
+)
+
+// SecretSource returns decrypted secrets. It is satisfied by *secret.Manager.
+type SecretSource interface {
+	Reveal(ctx context.Context, namespace, name string) (*secret.Secret, error)
+}
+
+// SecretAware is implemented by providers that inject secrets into workloads.
+// Resolved values are only handed to the provider and never written back to the
+// workload spec.
+type SecretAware interface {
+	SetSecretSource(source SecretSource)
+}
+
+// SecretUnmounter is implemented by providers that store a workload's resolved
+// secrets apart from the workload itself. UnmountSecrets removes them and
+// succeeds when there is nothing left to remove.
+type SecretUnmounter interface {
+	UnmountSecrets(ctx context.Context, workload *workload.Workload) error
+}
+
+// SecretVolumeMounter is implemented by providers that may be unable to mount
+// secret volumes, such as those that only pass secrets as environment
+// variables. The schedulers keep workloads with secret volumes off them.
+type SecretVolumeMounter interface {
+	// MountsSecretVolumes reports whether secret volumes can be mounted
+	MountsSecretVolumes() bool
+}
+
+// ResolvedSecrets holds the secret values a workload references
+type ResolvedSecrets struct {
+	EnvVars []SecretEnvVar
+	Files   []SecretFile
+}
+
+// SecretEnvVar is an environment variable set from a secret key
+type SecretEnvVar struct {
+	Name   string
+	Secret string
+	Key    string
+	Value  []byte
+}
+
+// SecretFile is a secret key mounted as a file
+type SecretFile struct {
+	Volume string
+	Path   string // absolute path inside the container
+	Secret string
+	Key    string
+	Value  []byte
+}
+
+// HasSecrets reports whether the workload references any secrets
+func HasSecrets(w *workload.Workload) bool {
+	return len(w.Spec.EnvFrom) > 0 || len(w.Spec.EnvValueFrom) > 0 || HasSecretVolumes(w)
+}
+
+// HasSecretVolumes reports whether the workload mounts secrets as files
+func HasSecretVolumes(w *workload.Workload) bool {
+	for _, volume := range w.Spec.Volumes {
+		if volume.Secret != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// CanMountSecrets reports whether the provider can run the workload's secret
+// volumes. Providers mount them unless they say otherwise.
+func CanMountSecrets(p Provider, w *workload.Workload) bool {
+	mounter, ok := p.(SecretVolumeMounter)
+	return !ok || mounter.MountsSecretVolumes() || !HasSecretVolumes(w)
+}
+
+// ResolveSecrets reads the secrets a workload references. Variables from EnvFrom
+// are applied in order and lose to the workload's plain Env and EnvValueFrom.
+func ResolveSecrets(ctx context.Context, source SecretSource, w *workload.Workload) (*ResolvedSecrets, error) {
+	resolved := &ResolvedSecrets{}
+	if !HasSecrets(w) {
+		return resolved, nil
+	}
+	if source == nil {
+		return nil, fmt.Errorf("workload %s references secrets but secret management is not configured", w.Name)
+	}
+
+	secrets := make(map[string]*secret.Secret)
+	get := func(name string) (*secret.Secret, error) {
+		if s, ok := secrets[name]; ok {
+			return s, nil
+		}
+		s, err := source.Reveal(ctx, w.Namespace, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read secret %s/%s: %w", w.Namespace, name, err)
+		}
+		secrets[name] = s
+		return s, nil
+	}
+
+	env := make(map[string]SecretEnvVar)
+	for _, from := range w.Spec.EnvFrom {
+		s, err := get(from.SecretRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		keys, err := secretKeys(s, from.SecretRef.Key)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			name := from.Prefix + key
+			if _, ok := w.Spec.Env[name]; ok {
+				continue
+			}
+			env[name] = SecretEnvVar{Name: name, Secret: s.Name, Key: key, Value: s.Spec.Data[key]}
+		}
+	}
+
+	for name, from := range w.Spec.EnvValueFrom {
+		s, err := get(from.SecretKeyRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, ok := s.Spec.Data[from.SecretKeyRef.Key]
+		if !ok {
+			return nil, fmt.Errorf("secret %s/%s has no key %s", s.Namespace, s.Name, from.SecretKeyRef.Key)
+		}
+		env[name] = SecretEnvVar{Name: name, Secret: s.Name, Key: from.SecretKeyRef.Key, Value: value}
+	}
+
+	for _, v := range env {
+		resolved.EnvVars = append(resolved.EnvVars, v)
+	}
+	sort.Slice(resolved.EnvVars, func(i, j int) bool {
+		return resolved.EnvVars[i].Name < resolved.EnvVars[j].Name
+	})
+
+	for _, volume := range w.Spec.Volumes {
+		if volume.Secret == nil {
+			continue
+		}
+		s, err := get(volume.Secret.Name)
+		if err != nil {
+			return nil, err
+		}
+		keys, err := secretKeys(s, volume.Secret.Key)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			if key == "." || key == ".." || strings.Contains(key, "/") {
+				return nil, fmt.Errorf("secret %s/%s key %q cannot be used as a file name", s.Namespace, s.Name, key)
+			}
+			resolved.Files = append(resolved.Files, SecretFile{
+				Volume: volume.Name,
+				Path:   path.Join(volume.MountPath, key),
+				Secret: s.Name,
+				Key:    key,
+				Value:  s.Spec.Data[key],
+			})
+		}
+	}
+
+	return resolved, nil
+}
+
+// ResolveSecretEnv resolves a workload's secrets for providers that can only pass
+// them as environment variables, returning the complete environment
+func ResolveSecretEnv(ctx context.Context, source SecretSource, w *workload.Workload, providerName string) (map[string]string, error) {
+	resolved, err := ResolveSecrets(ctx, source, w)
+	if err != nil {
+		return nil, err
+	}
+	if len(resolved.Files) > 0 {
+		return nil, fmt.Errorf("secret volumes are not supported by provider %s", providerName)
+	}
+	if resolved.Empty() {
+		return w.Spec.Env, nil
+	}
+	return resolved.Env(w.Spec.Env), nil
+}
+
+// Empty reports whether nothing was resolved
+func (r *ResolvedSecrets) Empty() bool {
+	return len(r.EnvVars) == 0 && len(r.Files) == 0
+}
+
+// Env returns a copy of plain with the secret variables added
+func (r *ResolvedSecrets) Env(plain map[string]string) map[string]string {
+	env := make(map[string]string, len(plain)+len(r.EnvVars))
+	for name, value := range plain {
+		env[name] = value
+	}
+	for _, v := range r.EnvVars {
+		env[v.Name] = string(v.Value)
+	}
+	return env
+}
+
+// secretKeys returns the sorted keys of a secret, or only key if set
+func secretKeys(s *secret.Secret, key string) ([]string, error) {
+	if key != "" {
+		if _, ok := s.Spec.Data[key]; !ok {
+			return nil, fmt.Errorf("secret %s/%s has no key %s", s.Namespace, s.Name, key)
+		}
+		return []string{key}, nil
+	}
+
+	keys := make([]string, 0, len(s.Spec.Data))
+	for k := range s.Spec.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
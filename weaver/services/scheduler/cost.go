@@ -0,0 +1,298 @@
+package scheduler
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/codecflow/fabric/pkg/quantity"
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/services/provider"
+)
+
+const (
+	hoursPerMonth = 24 * 30
+
+	// Requests used when a workload leaves a resource unspecified
+	defaultMilliCPU = 1000
+	defaultMemory   = 4 * quantity.Gi
+	defaultVolume   = 10 * quantity.Gi
+)
+
+// Confidence of a cost estimate by how the price of a component was obtained
+const (
+	confidenceListed      = 0.9  // rate published for the exact resource
+	confidenceMarket      = 0.85 // GPU market price reported with current availability
+	confidenceAnyGPU      = 0.7  // cheapest GPU when no type was requested
+	confidenceGenericGPU  = 0.6  // provider-wide GPU rate that is not type specific
+	confidenceFallbackGPU = 0.4  // most expensive known GPU, the requested type is not listed
+	confidenceUnpricedGPU = 0.2  // provider publishes no GPU prices at all
+	confidenceDefaulted   = 0.8  // a request was not specified and a default was assumed
+)
+
+// gpuPrice is the hourly price of one GPU and how it was obtained
+type gpuPrice struct {
+	name       string
+	amount     float64
+	confidence float64
+	assumption string
+}
+
+// EstimateCost estimates the cost of a workload from its resource requests, the
+// provider's published pricing and the GPU prices reported with its availability
+func EstimateCost(w *workload.Workload, pricing *provider.PricingInfo, resources *provider.ResourceAvailability) *provider.CostEstimate {
+	estimate := &provider.CostEstimate{
+		Currency:   pricing.Currency,
+		Confidence: confidenceListed,
+	}
+
+	lower := func(confidence float64, assumption string) {
+		estimate.Confidence = math.Min(estimate.Confidence, confidence)
+		if assumption != "" {
+			estimate.Assumptions = append(estimate.Assumptions, assumption)
+		}
+	}
+
+	// Requests are validated at the API; anything unparseable is priced at the defaults
+	requests, err := w.Spec.Resources.Quantity()
+	if err != nil {
+		requests = quantity.Resources{}
+		lower(confidenceDefaulted, fmt.Sprintf("invalid resource requests: %v", err))
+	}
+
+	milliCPU := requests.MilliCPU
+	if milliCPU == 0 {
+		milliCPU = defaultMilliCPU
+		lower(confidenceDefaulted, fmt.Sprintf("no CPU requested, assuming %s vCPU", quantity.FormatCPU(milliCPU)))
+	}
+	cpus := quantity.Cores(milliCPU)
+	estimate.Breakdown = append(estimate.Breakdown, provider.CostBreakdown{
+		Component:   "cpu",
+		Description: fmt.Sprintf("%s vCPUs", formatAmount(cpus)),
+		Amount:      cpus * pricing.CPU.Amount,
+		Unit:        "hour",
+		Quantity:    cpus,
+	})
+
+	memory := requests.Memory
+	if memory == 0 {
+		memory = defaultMemory
+		lower(confidenceDefaulted, fmt.Sprintf("no memory requested, assuming %s", quantity.FormatBytes(memory)))
+	}
+	memoryGB := quantity.In(memory, quantity.Gi)
+	estimate.Breakdown = append(estimate.Breakdown, provider.CostBreakdown{
+		Component:   "memory",
+		Description: fmt.Sprintf("%sGB RAM", formatAmount(memoryGB)),
+		Amount:      memoryGB * pricing.Memory.Amount,
+		Unit:        "hour",
+		Quantity:    memoryGB,
+	})
+
+	if gpu, err := w.Spec.Resources.GPURequest(); err == nil && !gpu.IsZero() {
+		price := estimateGPUPrice(gpu, pricing, resources)
+		lower(price.confidence, price.assumption)
+
+		count := float64(gpu.Count)
+		estimate.Breakdown = append(estimate.Breakdown, provider.CostBreakdown{
+			Component:   "gpu",
+			Description: fmt.Sprintf("%d x %s", gpu.Count, price.name),
+			Amount:      count * price.amount,
+			Unit:        "hour",
+			Quantity:    count,
+		})
+	}
+
+	if len(w.Spec.Volumes) > 0 {
+		var storage int64
+		for _, volume := range w.Spec.Volumes {
+			size, err := quantity.ParseBytes(volume.Size)
+			if err != nil || size == 0 {
+				size = defaultVolume
+				lower(confidenceDefaulted, fmt.Sprintf("volume %s has no size, assuming %s", volume.Name, quantity.FormatBytes(size)))
+			}
+			storage += size
+		}
+
+		storageGB := quantity.In(storage, quantity.Gi)
+		estimate.Breakdown = append(estimate.Breakdown, provider.CostBreakdown{
+			Component:   "storage",
+			Description: fmt.Sprintf("%sGB across %d volumes", formatAmount(storageGB), len(w.Spec.Volumes)),
+			Amount:      storageGB * hourlyRate(pricing.Storage),
+			Unit:        "hour",
+			Quantity:    storageGB,
+		})
+	}
+
+	for _, item := range estimate.Breakdown {
+		estimate.HourlyCost += item.Amount
+	}
+	estimate.DailyCost = estimate.HourlyCost * 24
+	estimate.MonthlyCost = estimate.HourlyCost * hoursPerMonth
+
+	return estimate
+}
+
+// estimateGPUPrice finds the hourly price of the requested GPU type. Published pricing is
+// preferred over market prices; without a match the most expensive known GPU is
+// used so an unknown type never looks cheap.
+func estimateGPUPrice(gpu quantity.GPU, pricing *provider.PricingInfo, resources *provider.ResourceAvailability) gpuPrice {
+	listed := make([]gpuPrice, 0, len(pricing.GPU))
+	for name, price := range pricing.GPU {
+		listed = append(listed, gpuPrice{name: name, amount: hourlyRate(price)})
+	}
+
+	var market []gpuPrice
+	if resources != nil {
+		for name, info := range resources.GPU.Types {
+			if info.PricePerHour <= 0 || int64(info.Available) < gpu.Count {
+				continue
+			}
+			if info.Name != "" {
+				name = info.Name
+			}
+			market = append(market, gpuPrice{name: name, amount: info.PricePerHour})
+		}
+	}
+
+	if gpu.Type == "" {
+		if price, ok := cheapestGPU(append(listed, market...)); ok {
+			price.confidence = confidenceAnyGPU
+			price.assumption = fmt.Sprintf("no GPU type requested, assuming the cheapest available (%s)", price.name)
+			return price
+		}
+	} else {
+		if price, ok := cheapestGPU(matchingGPUs(listed, gpu.Type)); ok {
+			price.confidence = confidenceListed
+			return price
+		}
+		if price, ok := cheapestGPU(matchingGPUs(market, gpu.Type)); ok {
+			price.confidence = confidenceMarket
+			return price
+		}
+	}
+
+	// Providers without typed GPUs publish a single rate for any GPU
+	for _, price := range listed {
+		if genericGPU(price.name) {
+			price.confidence = confidenceGenericGPU
+			price.assumption = fmt.Sprintf("no price for GPU %s, using the generic GPU rate", gpu.Type)
+			return price
+		}
+	}
+
+	if price, ok := mostExpensiveGPU(append(listed, market...)); ok {
+		price.confidence = confidenceFallbackGPU
+		price.assumption = fmt.Sprintf("no price for GPU %s, assuming the most expensive known GPU (%s)", gpu.Type, price.name)
+		return price
+	}
+
+	name := gpu.Type
+	if name == "" {
+		name = "GPU"
+	}
+	return gpuPrice{
+		name:       name,
+		confidence: confidenceUnpricedGPU,
+		assumption: "provider publishes no GPU prices",
+	}
+}
+
+// matchingGPUs returns the prices whose name refers to the requested GPU type
+func matchingGPUs(prices []gpuPrice, gpuType string) []gpuPrice {
+	var result []gpuPrice
+	for _, price := range prices {
+		if GPUTypeMatches(gpuType, price.name) {
+			result = append(result, price)
+		}
+	}
+	return result
+}
+
+// GPUTypeMatches reports whether an offered GPU name refers to the requested type,
+// e.g. "nvidia-a100" matches "a100-pcie-40gb" and "NVIDIA A100 80GB PCIe".
+// Names are compared by whole tokens, so "a10" matches neither "A100" nor
+// "a100-pcie-40gb" and "l4" does not match "L40S". The requested type may span
+// adjacent tokens of the offered name, like "rtx4090" in "GeForce RTX 4090".
+func GPUTypeMatches(requested, offered string) bool {
+	want := strings.Join(gpuTokens(requested), "")
+	if want == "" {
+		return false
+	}
+
+	have := gpuTokens(offered)
+	for i := range have {
+		run := ""
+		for _, token := range have[i:] {
+			run += token
+			if run == want {
+				return true
+			}
+			if len(run) >= len(want) {
+				break
+			}
+		}
+	}
+	return false
+}
+
+// gpuTokens splits a GPU name into lowercase runs of letters and digits without the vendor
+func gpuTokens(name string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < '0' || r > '9')
+	})
+
+	tokens := fields[:0]
+	for _, field := range fields {
+		if field != "nvidia" && field != "amd" {
+			tokens = append(tokens, field)
+		}
+	}
+	return tokens
+}
+
+// genericGPU reports whether a GPU price applies to any GPU rather than one type
+func genericGPU(name string) bool {
+	return name == "default" || strings.HasSuffix(name, "/gpu")
+}
+
+// cheapestGPU returns the lowest price, preferring names in sorted order on ties
+func cheapestGPU(prices []gpuPrice) (gpuPrice, bool) {
+	if len(prices) == 0 {
+		return gpuPrice{}, false
+	}
+	sort.Slice(prices, func(i, j int) bool {
+		if prices[i].amount != prices[j].amount {
+			return prices[i].amount < prices[j].amount
+		}
+		return prices[i].name < prices[j].name
+	})
+	return prices[0], true
+}
+
+// mostExpensiveGPU returns the highest price
+func mostExpensiveGPU(prices []gpuPrice) (gpuPrice, bool) {
+	if len(prices) == 0 {
+		return gpuPrice{}, false
+	}
+	sort.Slice(prices, func(i, j int) bool {
+		if prices[i].amount != prices[j].amount {
+			return prices[i].amount > prices[j].amount
+		}
+		return prices[i].name < prices[j].name
+	})
+	return prices[0], true
+}
+
+// hourlyRate converts a price to an hourly rate
+func hourlyRate(price provider.PricePerUnit) float64 {
+	if price.Unit == "month" {
+		return price.Amount / hoursPerMonth
+	}
+	return price.Amount
+}
+
+// formatAmount formats a resource amount without trailing zeros
+func formatAmount(v float64) string {
+	return fmt.Sprintf("%g", math.Round(v*100)/100)
+}
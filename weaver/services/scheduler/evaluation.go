@@ -0,0 +1,48 @@
+package scheduler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SortEvaluations orders evaluations with feasible candidates first, best score first
+func SortEvaluations(evaluations []*CandidateEvaluation) {
+	sort.SliceStable(evaluations, func(i, j int) bool {
+		if evaluations[i].Feasible != evaluations[j].Feasible {
+			return evaluations[i].Feasible
+		}
+		return evaluations[i].Score > evaluations[j].Score
+	})
+}
+
+// FeasibleEvaluations returns the evaluations of candidates that can run the workload
+func FeasibleEvaluations(evaluations []*CandidateEvaluation) []*CandidateEvaluation {
+	var result []*CandidateEvaluation
+	for _, e := range evaluations {
+		if e.Feasible {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// SummarizeEvaluations explains in one line why candidates were rejected
+func SummarizeEvaluations(evaluations []*CandidateEvaluation) string {
+	if len(evaluations) == 0 {
+		return "no providers configured"
+	}
+
+	parts := make([]string, 0, len(evaluations))
+	for _, e := range evaluations {
+		if e.Feasible {
+			continue
+		}
+		candidate := e.Provider
+		if e.Region != "" {
+			candidate += "/" + e.Region
+		}
+		parts = append(parts, fmt.Sprintf("%s (%s: %s)", candidate, e.FilteredBy, e.Reason))
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,217 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codecflow/fabric/pkg/quantity"
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/services/provider"
+	"github.com/codecflow/fabric/weaver/services/scheduler"
+)
+
+// ExclusionFilter rejects providers excluded by the policy and, when the workload
+// pins a provider, every other provider
+type ExclusionFilter struct {
+	Policy *scheduler.SchedulingPolicy
+}
+
+func (f *ExclusionFilter) Name() string { return "exclusion" }
+
+func (f *ExclusionFilter) FilterProvider(ctx context.Context, w *workload.Workload, name string) error {
+	if contains(f.Policy.ExcludedProviders, name) {
+		return fmt.Errorf("provider %s is excluded by the scheduling policy", name)
+	}
+	if pinned := w.Spec.Placement.Provider; pinned != "" && pinned != name {
+		return fmt.Errorf("workload is pinned to provider %s", pinned)
+	}
+	return nil
+}
+
+func (f *ExclusionFilter) Filter(ctx context.Context, w *workload.Workload, c *Candidate) error {
+	return f.FilterProvider(ctx, w, c.Provider)
+}
+
+// RegionFilter rejects regions that are unavailable or not the one the workload
+// is pinned to
+type RegionFilter struct{}
+
+func (f *RegionFilter) Name() string { return "region" }
+
+func (f *RegionFilter) Filter(ctx context.Context, w *workload.Workload, c *Candidate) error {
+	if pinned := w.Spec.Placement.Region; pinned != "" && pinned != c.Region {
+		return fmt.Errorf("workload is pinned to region %s", pinned)
+	}
+	if c.RegionInfo != nil && !c.RegionInfo.Available {
+		return fmt.Errorf("region %s is not available", c.Region)
+	}
+	if c.RegionStatus != nil && !c.RegionStatus.Available {
+		return fmt.Errorf("region %s is reported unavailable", c.Region)
+	}
+	return nil
+}
+
+// SecretsFilter rejects providers that cannot mount the workload's secret volumes
+type SecretsFilter struct{}
+
+func (f *SecretsFilter) Name() string { return "secrets" }
+
+func (f *SecretsFilter) Filter(ctx context.Context, w *workload.Workload, c *Candidate) error {
+	if !provider.CanMountSecrets(c.Client, w) {
+		return fmt.Errorf("provider %s cannot mount secret volumes", c.Provider)
+	}
+	return nil
+}
+
+// CapacityFilter rejects providers without room for the workload's CPU and memory
+// requests, raised to the policy minimums. Providers that manage nodes must have a
+// node the workload fits on.
+type CapacityFilter struct {
+	Policy *scheduler.SchedulingPolicy
+}
+
+func (f *CapacityFilter) Name() string { return "capacity" }
+
+func (f *CapacityFilter) Filter(ctx context.Context, w *workload.Workload, c *Candidate) error {
+	requests, err := w.Spec.Resources.Quantity()
+	if err != nil {
+		return fmt.Errorf("invalid resource requests: %w", err)
+	}
+
+	milliCPU := requests.MilliCPU
+	if f.Policy.MinCPUCores != nil {
+		milliCPU = max(milliCPU, int64(*f.Policy.MinCPUCores)*1000)
+	}
+	memory := requests.Memory
+	if f.Policy.MinMemoryGB != nil {
+		memory = max(memory, int64(*f.Policy.MinMemoryGB)*quantity.Gi)
+	}
+
+	// Pools that cannot be parsed, e.g. "unlimited" or empty, are not a constraint
+	if available, err := quantity.ParseCPU(c.Resources.CPU.Available); err == nil && c.Resources.CPU.Available != "" && available < milliCPU {
+		return fmt.Errorf("needs %s CPU, %s available", quantity.FormatCPU(milliCPU), quantity.FormatCPU(available))
+	}
+	if available, err := quantity.ParseBytes(c.Resources.Memory.Available); err == nil && c.Resources.Memory.Available != "" && available < memory {
+		return fmt.Errorf("needs %s memory, %s available", quantity.FormatBytes(memory), quantity.FormatBytes(available))
+	}
+
+	if selector, ok := c.Client.(provider.NodeSelector); ok {
+		nodeID, err := selector.SelectNode(ctx, w)
+		if err != nil {
+			return fmt.Errorf("no node fits the workload: %w", err)
+		}
+		c.NodeID = nodeID
+	}
+
+	return nil
+}
+
+// GPUFilter rejects candidates without enough free GPUs of the requested type
+type GPUFilter struct {
+	Policy *scheduler.SchedulingPolicy
+}
+
+func (f *GPUFilter) Name() string { return "gpu" }
+
+func (f *GPUFilter) Filter(ctx context.Context, w *workload.Workload, c *Candidate) error {
+	gpu, err := w.Spec.Resources.GPURequest()
+	if err != nil {
+		return fmt.Errorf("invalid GPU request: %w", err)
+	}
+	if gpu.IsZero() {
+		if !f.Policy.RequireGPU {
+			return nil
+		}
+		gpu.Count = 1
+	}
+
+	name := gpu.Type
+	if name == "" {
+		name = "GPU"
+	}
+
+	if gpu.Type != "" && c.RegionInfo != nil && len(c.RegionInfo.GPUTypes) > 0 {
+		offered := false
+		for _, gpuType := range c.RegionInfo.GPUTypes {
+			if scheduler.GPUTypeMatches(gpu.Type, gpuType) {
+				offered = true
+				break
+			}
+		}
+		if !offered {
+			return fmt.Errorf("region %s does not offer %s", c.Region, name)
+		}
+	}
+
+	var available int64
+	for key, info := range c.Resources.GPU.Types {
+		if gpu.Type == "" || scheduler.GPUTypeMatches(gpu.Type, key) || scheduler.GPUTypeMatches(gpu.Type, info.Name) {
+			available = max(available, int64(info.Available))
+		}
+	}
+	if available < gpu.Count {
+		return fmt.Errorf("needs %d x %s, %d available", gpu.Count, name, available)
+	}
+
+	return nil
+}
+
+// BudgetFilter rejects candidates whose estimated cost exceeds the policy's limit
+type BudgetFilter struct {
+	Policy *scheduler.SchedulingPolicy
+}
+
+func (f *BudgetFilter) Name() string { return "budget" }
+
+func (f *BudgetFilter) Filter(ctx context.Context, w *workload.Workload, c *Candidate) error {
+	if f.Policy.MaxCostPerHour == nil || c.Cost == nil {
+		return nil
+	}
+	if c.Cost.HourlyCost > *f.Policy.MaxCostPerHour {
+		return fmt.Errorf("estimated %.4f %s/hour exceeds the budget of %.4f", c.Cost.HourlyCost, c.Cost.Currency, *f.Policy.MaxCostPerHour)
+	}
+	return nil
+}
+
+// RescheduleFilter applies the constraints of a reschedule request
+type RescheduleFilter struct {
+	Constraints *scheduler.RescheduleConstraints
+	CurrentCost *float64 // hourly cost on the current provider, nil when unknown
+}
+
+func (f *RescheduleFilter) Name() string { return "reschedule" }
+
+func (f *RescheduleFilter) FilterProvider(ctx context.Context, w *workload.Workload, name string) error {
+	if len(f.Constraints.RequiredProviders) > 0 && !contains(f.Constraints.RequiredProviders, name) {
+		return fmt.Errorf("provider %s is not one of the required providers", name)
+	}
+	if contains(f.Constraints.ExcludedProviders, name) {
+		return fmt.Errorf("provider %s is excluded from rescheduling", name)
+	}
+	return nil
+}
+
+func (f *RescheduleFilter) Filter(ctx context.Context, w *workload.Workload, c *Candidate) error {
+	if err := f.FilterProvider(ctx, w, c.Provider); err != nil {
+		return err
+	}
+
+	if f.Constraints.MaxCostIncrease != nil && f.CurrentCost != nil && c.Cost != nil {
+		limit := *f.CurrentCost * (1 + *f.Constraints.MaxCostIncrease/100)
+		if c.Cost.HourlyCost > limit {
+			return fmt.Errorf("estimated %.4f/hour is more than %.0f%% above the current %.4f/hour", c.Cost.HourlyCost, *f.Constraints.MaxCostIncrease, *f.CurrentCost)
+		}
+	}
+
+	return nil
+}
+
+// contains reports whether the list contains the value
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,522 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/services/provider"
+	"github.com/codecflow/fabric/weaver/services/scheduler"
+)
+
+// Steps that reject a provider before any plugin runs
+const (
+	stepHealth    = "health"
+	stepPricing   = "pricing"
+	stepResources = "resources"
+)
+
+// defaultRegion names the single candidate of providers that list no regions
+const defaultRegion = "default"
+
+// Scheduler places workloads by running filter plugins over every provider region
+// and ranking the remaining candidates with weighted score plugins
+type Scheduler struct {
+	providers map[string]provider.Provider
+	workloads workload.Repository
+	config    *scheduler.SchedulerConfig
+	plugins   Plugins
+	weights   map[string]float64
+
+	mu       sync.Mutex
+	stats    *scheduler.SchedulerStats
+	outcomes map[string]*outcome
+}
+
+// outcome counts the results of creating workloads on a provider
+type outcome struct {
+	attempts  int64
+	successes int64
+}
+
+// New creates a scheduler with the built-in plugins for the configured policy. The
+// workload repository is used to look up workloads being rescheduled and may be nil.
+func New(providerMap map[string]provider.Provider, workloads workload.Repository, config *scheduler.SchedulerConfig) *Scheduler {
+	if config == nil {
+		config = &scheduler.SchedulerConfig{
+			DefaultPolicy: scheduler.SchedulingPolicy{
+				Strategy: scheduler.StrategyBalanced,
+			},
+			MaxAlternatives:    3,
+			ScheduleTimeout:    30 * time.Second,
+			CostUpdateInterval: 5 * time.Minute,
+		}
+	}
+
+	return NewWithPlugins(providerMap, workloads, config, DefaultPlugins(&config.DefaultPolicy))
+}
+
+// NewWithPlugins creates a scheduler that runs the given plugins
+func NewWithPlugins(providerMap map[string]provider.Provider, workloads workload.Repository, config *scheduler.SchedulerConfig, plugins Plugins) *Scheduler {
+	return &Scheduler{
+		providers: providerMap,
+		workloads: workloads,
+		config:    config,
+		plugins:   plugins,
+		weights:   Weights(&config.DefaultPolicy),
+		stats: &scheduler.SchedulerStats{
+			ProviderStats:   make(map[string]*scheduler.ProviderStats),
+			RecentSchedules: make([]*scheduler.RecentSchedule, 0),
+			LastUpdated:     time.Now(),
+		},
+		outcomes: make(map[string]*outcome),
+	}
+}
+
+// Schedule places a workload on the best scoring candidate
+func (s *Scheduler) Schedule(ctx context.Context, w *workload.Workload) (*scheduler.ScheduleResult, error) {
+	start := time.Now()
+
+	evaluations := s.evaluate(ctx, w, s.plugins, s.weights)
+	feasible := scheduler.FeasibleEvaluations(evaluations)
+	if len(feasible) == 0 {
+		err := fmt.Errorf("%w for workload: %s", scheduler.ErrUnschedulable, scheduler.SummarizeEvaluations(evaluations))
+		s.recordSchedule(w.ID, "", "", false, time.Since(start), 0, err.Error())
+		return nil, err
+	}
+
+	result := s.result(w.ID, feasible, evaluations)
+	s.recordSchedule(w.ID, result.Provider, result.Region, true, time.Since(start), hourlyCost(result.EstimatedCost), "")
+
+	return result, nil
+}
+
+// GetRecommendations returns the feasible candidates as recommendations, best first
+func (s *Scheduler) GetRecommendations(ctx context.Context, w *workload.Workload) ([]*scheduler.Recommendation, error) {
+	evaluations := s.evaluate(ctx, w, s.plugins, s.weights)
+
+	recommendations := make([]*scheduler.Recommendation, 0)
+	for _, e := range scheduler.FeasibleEvaluations(evaluations) {
+		recommendations = append(recommendations, recommendation(e))
+	}
+
+	return recommendations, nil
+}
+
+// Explain judges every provider region for a workload without scheduling it
+func (s *Scheduler) Explain(ctx context.Context, w *workload.Workload) ([]*scheduler.CandidateEvaluation, error) {
+	return s.evaluate(ctx, w, s.plugins, s.weights), nil
+}
+
+// Reschedule places an existing workload again under additional constraints
+func (s *Scheduler) Reschedule(ctx context.Context, workloadID string, constraints *scheduler.RescheduleConstraints) (*scheduler.ScheduleResult, error) {
+	start := time.Now()
+
+	if s.workloads == nil {
+		return nil, fmt.Errorf("workload repository not configured")
+	}
+	if constraints == nil {
+		constraints = &scheduler.RescheduleConstraints{}
+	}
+
+	w, err := s.workloads.Get(ctx, workloadID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workload: %w", err)
+	}
+
+	plugins := Plugins{
+		Filters: append([]FilterPlugin{&RescheduleFilter{
+			Constraints: constraints,
+			CurrentCost: s.currentCost(ctx, w),
+		}}, s.plugins.Filters...),
+		Scorers: s.plugins.Scorers,
+	}
+	weights := s.weights
+	if len(constraints.PreferredRegions) > 0 {
+		plugins.Scorers = append(append([]ScorePlugin{}, plugins.Scorers...), &RegionPreferenceScore{Regions: constraints.PreferredRegions})
+		weights = make(map[string]float64, len(s.weights)+1)
+		for name, weight := range s.weights {
+			weights[name] = weight
+		}
+		weights["region-preference"] = regionPreferenceWeight
+	}
+
+	evaluations := s.evaluate(ctx, w, plugins, weights)
+	feasible := scheduler.FeasibleEvaluations(evaluations)
+	if len(feasible) == 0 {
+		err := fmt.Errorf("%w for rescheduling with given constraints: %s", scheduler.ErrUnschedulable, scheduler.SummarizeEvaluations(evaluations))
+		s.recordSchedule(workloadID, "", "", false, time.Since(start), 0, err.Error())
+		return nil, err
+	}
+
+	result := s.result(workloadID, feasible, evaluations)
+	result.Placement.Reasons = append(result.Placement.Reasons, fmt.Sprintf("Rescheduled: %s", constraints.Reason))
+	result.Metadata = map[string]interface{}{
+		"rescheduled": true,
+		"reason":      constraints.Reason,
+	}
+	s.recordSchedule(workloadID, result.Provider, result.Region, true, time.Since(start), hourlyCost(result.EstimatedCost), "")
+
+	return result, nil
+}
+
+// GetStats returns current scheduling statistics
+func (s *Scheduler) GetStats(ctx context.Context) (*scheduler.SchedulerStats, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := *s.stats
+	stats.ProviderStats = make(map[string]*scheduler.ProviderStats, len(s.stats.ProviderStats))
+	for name, ps := range s.stats.ProviderStats {
+		copied := *ps
+		stats.ProviderStats[name] = &copied
+	}
+	stats.RecentSchedules = append([]*scheduler.RecentSchedule(nil), s.stats.RecentSchedules...)
+	stats.LastUpdated = time.Now()
+
+	return &stats, nil
+}
+
+// HealthCheck checks scheduler health
+func (s *Scheduler) HealthCheck(ctx context.Context) error {
+	if len(s.providers) == 0 {
+		return fmt.Errorf("no providers configured")
+	}
+	return nil
+}
+
+// ObservePlacement records whether creating a workload on a provider succeeded,
+// which the reliability score is based on
+func (s *Scheduler) ObservePlacement(providerName string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := s.outcomes[providerName]
+	if o == nil {
+		o = &outcome{}
+		s.outcomes[providerName] = o
+	}
+	o.attempts++
+	if err == nil {
+		o.successes++
+	}
+
+	ps := s.providerStats(providerName)
+	ps.SuccessRate = float64(o.successes) / float64(o.attempts)
+}
+
+// evaluate runs the plugins over every provider region
+func (s *Scheduler) evaluate(ctx context.Context, w *workload.Workload, plugins Plugins, weights map[string]float64) []*scheduler.CandidateEvaluation {
+	if s.config.ScheduleTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.ScheduleTimeout)
+		defer cancel()
+	}
+
+	names := make([]string, 0, len(s.providers))
+	for name := range s.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var evaluations []*scheduler.CandidateEvaluation
+	for _, name := range names {
+		evaluations = append(evaluations, s.evaluateProvider(ctx, w, name, plugins, weights)...)
+	}
+
+	scheduler.SortEvaluations(evaluations)
+
+	return evaluations
+}
+
+// evaluateProvider queries a provider and judges each of its regions
+func (s *Scheduler) evaluateProvider(ctx context.Context, w *workload.Workload, name string, plugins Plugins, weights map[string]float64) []*scheduler.CandidateEvaluation {
+	rejected := func(by string, err error) []*scheduler.CandidateEvaluation {
+		return []*scheduler.CandidateEvaluation{{Provider: name, FilteredBy: by, Reason: err.Error()}}
+	}
+
+	for _, filter := range plugins.Filters {
+		if pf, ok := filter.(ProviderFilter); ok {
+			if err := pf.FilterProvider(ctx, w, name); err != nil {
+				return rejected(filter.Name(), err)
+			}
+		}
+	}
+
+	p := s.providers[name]
+	if err := p.HealthCheck(ctx); err != nil {
+		return rejected(stepHealth, fmt.Errorf("health check failed: %w", err))
+	}
+
+	pricing, err := p.GetPricing(ctx)
+	if err != nil {
+		return rejected(stepPricing, fmt.Errorf("failed to get pricing: %w", err))
+	}
+
+	resources, err := p.GetAvailableResources(ctx)
+	if err != nil {
+		return rejected(stepResources, fmt.Errorf("failed to get available resources: %w", err))
+	}
+
+	// Status only refines latency and load scores
+	status, err := p.GetStatus(ctx)
+	if err != nil {
+		status = nil
+	}
+
+	base := Candidate{
+		Provider:    name,
+		Client:      p,
+		Pricing:     pricing,
+		Resources:   resources,
+		Status:      status,
+		Stats:       s.observedStats(name),
+		Cost:        scheduler.EstimateCost(w, pricing, resources),
+		MachineType: scheduler.SelectMachineType(w, resources),
+	}
+
+	regions := make([]*provider.RegionInfo, 0, len(resources.Regions))
+	for i := range resources.Regions {
+		regions = append(regions, &resources.Regions[i])
+	}
+	if len(regions) == 0 {
+		regions = append(regions, nil)
+	}
+
+	evaluations := make([]*scheduler.CandidateEvaluation, 0, len(regions))
+	for _, region := range regions {
+		c := base
+		c.Region = defaultRegion
+		c.RegionInfo = region
+		if region != nil {
+			c.Region = region.Name
+		}
+		c.RegionStatus = regionStatus(status, c.Region)
+
+		evaluations = append(evaluations, s.evaluateCandidate(ctx, w, &c, plugins, weights))
+	}
+
+	return evaluations
+}
+
+// evaluateCandidate runs the filters and, if they all pass, the scorers
+func (s *Scheduler) evaluateCandidate(ctx context.Context, w *workload.Workload, c *Candidate, plugins Plugins, weights map[string]float64) *scheduler.CandidateEvaluation {
+	e := &scheduler.CandidateEvaluation{
+		Provider:      c.Provider,
+		Region:        c.Region,
+		MachineType:   c.MachineType,
+		EstimatedCost: c.Cost,
+	}
+
+	for _, filter := range plugins.Filters {
+		if err := filter.Filter(ctx, w, c); err != nil {
+			e.FilteredBy = filter.Name()
+			e.Reason = err.Error()
+			return e
+		}
+	}
+	e.Feasible = true
+	e.NodeID = c.NodeID
+
+	var total float64
+	for _, scorer := range plugins.Scorers {
+		total += weights[scorer.Name()]
+	}
+
+	for _, scorer := range plugins.Scorers {
+		weight := weights[scorer.Name()]
+		if weight <= 0 || total <= 0 {
+			continue
+		}
+
+		score, reason := scorer.Score(ctx, w, c)
+		score = min(max(score, 0), 100)
+		e.Scores = append(e.Scores, scheduler.ScoreComponent{
+			Plugin: scorer.Name(),
+			Score:  score,
+			Weight: weight / total,
+			Reason: reason,
+		})
+		e.Score += score * weight / total
+	}
+
+	return e
+}
+
+// result builds the schedule result for the best feasible candidate
+func (s *Scheduler) result(workloadID string, feasible, evaluations []*scheduler.CandidateEvaluation) *scheduler.ScheduleResult {
+	best := feasible[0]
+
+	alternatives := make([]*scheduler.Alternative, 0)
+	for i, e := range feasible[1:] {
+		if i >= s.config.MaxAlternatives {
+			break
+		}
+		alternatives = append(alternatives, &scheduler.Alternative{
+			Placement:     placement(e),
+			EstimatedCost: e.EstimatedCost,
+			Rank:          i + 2,
+			Reason:        fmt.Sprintf("Scored %.1f against %.1f", e.Score, best.Score),
+		})
+	}
+
+	return &scheduler.ScheduleResult{
+		WorkloadID:    workloadID,
+		Provider:      best.Provider,
+		Region:        best.Region,
+		MachineType:   best.MachineType,
+		EstimatedCost: best.EstimatedCost,
+		Placement:     placement(best),
+		Alternatives:  alternatives,
+		ScheduledAt:   time.Now(),
+		Evaluations:   evaluations,
+	}
+}
+
+// currentCost estimates the hourly cost of a workload on its current provider
+func (s *Scheduler) currentCost(ctx context.Context, w *workload.Workload) *float64 {
+	p, ok := s.providers[w.Status.Provider]
+	if !ok {
+		return nil
+	}
+
+	pricing, err := p.GetPricing(ctx)
+	if err != nil {
+		return nil
+	}
+	resources, err := p.GetAvailableResources(ctx)
+	if err != nil {
+		return nil
+	}
+
+	cost := scheduler.EstimateCost(w, pricing, resources).HourlyCost
+	return &cost
+}
+
+// observedStats returns a copy of the provider's statistics once a placement
+// outcome has been observed for it
+func (s *Scheduler) observedStats(name string) *scheduler.ProviderStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := s.outcomes[name]
+	if o == nil || o.attempts == 0 {
+		return nil
+	}
+
+	stats := *s.providerStats(name)
+	stats.TotalScheduled = o.attempts
+	return &stats
+}
+
+// providerStats returns the statistics of a provider; s.mu must be held
+func (s *Scheduler) providerStats(name string) *scheduler.ProviderStats {
+	ps := s.stats.ProviderStats[name]
+	if ps == nil {
+		ps = &scheduler.ProviderStats{}
+		s.stats.ProviderStats[name] = ps
+	}
+	return ps
+}
+
+// recordSchedule updates scheduling statistics
+func (s *Scheduler) recordSchedule(workloadID, providerName, region string, success bool, duration time.Duration, cost float64, errorMsg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stats.TotalScheduled++
+	if success {
+		s.stats.SuccessfulSchedules++
+	} else {
+		s.stats.FailedSchedules++
+	}
+	s.stats.AverageScheduleTime += (duration - s.stats.AverageScheduleTime) / time.Duration(s.stats.TotalScheduled)
+
+	if providerName != "" {
+		ps := s.providerStats(providerName)
+		ps.TotalScheduled++
+		ps.AverageCost += (cost - ps.AverageCost) / float64(ps.TotalScheduled)
+		ps.AverageLatency += (duration - ps.AverageLatency) / time.Duration(ps.TotalScheduled)
+		ps.LastScheduled = time.Now()
+	}
+
+	s.stats.RecentSchedules = append(s.stats.RecentSchedules, &scheduler.RecentSchedule{
+		WorkloadID:   workloadID,
+		Provider:     providerName,
+		Region:       region,
+		Success:      success,
+		ScheduleTime: duration,
+		Cost:         cost,
+		Timestamp:    time.Now(),
+		Error:        errorMsg,
+	})
+	if len(s.stats.RecentSchedules) > 100 {
+		s.stats.RecentSchedules = s.stats.RecentSchedules[1:]
+	}
+}
+
+// placement converts an evaluation into a placement decision
+func placement(e *scheduler.CandidateEvaluation) *scheduler.PlacementDecision {
+	return &scheduler.PlacementDecision{
+		Provider:    e.Provider,
+		Region:      e.Region,
+		MachineType: e.MachineType,
+		NodeID:      e.NodeID,
+		Score:       e.Score,
+		Reasons:     reasons(e, func(score float64) bool { return true }),
+	}
+}
+
+// recommendation converts an evaluation into a recommendation
+func recommendation(e *scheduler.CandidateEvaluation) *scheduler.Recommendation {
+	confidence := 0.5
+	if e.EstimatedCost != nil {
+		confidence = e.EstimatedCost.Confidence
+	}
+
+	return &scheduler.Recommendation{
+		Provider:      e.Provider,
+		Region:        e.Region,
+		MachineType:   e.MachineType,
+		Score:         e.Score,
+		EstimatedCost: e.EstimatedCost,
+		Pros:          reasons(e, func(score float64) bool { return score > neutralScore }),
+		Cons:          reasons(e, func(score float64) bool { return score < neutralScore }),
+		Confidence:    confidence,
+	}
+}
+
+// reasons lists the score components selected by keep as "plugin: reason"
+func reasons(e *scheduler.CandidateEvaluation, keep func(score float64) bool) []string {
+	result := make([]string, 0, len(e.Scores))
+	for _, component := range e.Scores {
+		if keep(component.Score) {
+			result = append(result, fmt.Sprintf("%s: %s", component.Plugin, component.Reason))
+		}
+	}
+	return result
+}
+
+// regionStatus finds the status the provider reports for a region
+func regionStatus(status *provider.ProviderStatus, region string) *provider.RegionStatus {
+	if status == nil {
+		return nil
+	}
+	for i := range status.Regions {
+		if status.Regions[i].Name == region {
+			return &status.Regions[i]
+		}
+	}
+	return nil
+}
+
+// hourlyCost returns the hourly cost of an estimate, or zero without one
+func hourlyCost(cost *provider.CostEstimate) float64 {
+	if cost == nil {
+		return 0
+	}
+	return cost.HourlyCost
+}
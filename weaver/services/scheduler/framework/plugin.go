@@ -0,0 +1,77 @@
+package framework
+
+import (
+	"context"
+
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/services/provider"
+	"github.com/codecflow/fabric/weaver/services/scheduler"
+)
+
+// Candidate is a provider region the workload could be placed in, together with
+// everything the plugins need to judge it
+type Candidate struct {
+	Provider    string
+	Region      string
+	MachineType string
+	NodeID      string
+
+	Client       provider.Provider
+	Pricing      *provider.PricingInfo
+	Resources    *provider.ResourceAvailability
+	RegionInfo   *provider.RegionInfo     // nil when the provider lists no regions
+	Status       *provider.ProviderStatus // nil when the provider status could not be fetched
+	RegionStatus *provider.RegionStatus   // nil when the provider reports no status for the region
+	Stats        *scheduler.ProviderStats // nil before the first placement on the provider
+	Cost         *provider.CostEstimate
+}
+
+// FilterPlugin rejects candidates that cannot run a workload. Filters may fill in
+// placement details they determine on the way, such as the node.
+type FilterPlugin interface {
+	Name() string
+
+	// Filter returns an error describing why the candidate cannot run the workload
+	Filter(ctx context.Context, w *workload.Workload, c *Candidate) error
+}
+
+// ProviderFilter is implemented by filter plugins that decide on the provider name
+// alone. They run before the provider is queried for pricing and capacity.
+type ProviderFilter interface {
+	FilterProvider(ctx context.Context, w *workload.Workload, name string) error
+}
+
+// ScorePlugin rates candidates that passed every filter
+type ScorePlugin interface {
+	Name() string
+
+	// Score rates the candidate from 0 to 100 and explains the rating
+	Score(ctx context.Context, w *workload.Workload, c *Candidate) (float64, string)
+}
+
+// Plugins is the ordered set of plugins a scheduler runs
+type Plugins struct {
+	Filters []FilterPlugin
+	Scorers []ScorePlugin
+}
+
+// DefaultPlugins returns the built-in plugins for a scheduling policy
+func DefaultPlugins(policy *scheduler.SchedulingPolicy) Plugins {
+	return Plugins{
+		Filters: []FilterPlugin{
+			&ExclusionFilter{Policy: policy},
+			&RegionFilter{},
+			&SecretsFilter{},
+			&CapacityFilter{Policy: policy},
+			&GPUFilter{Policy: policy},
+			&BudgetFilter{Policy: policy},
+		},
+		Scorers: []ScorePlugin{
+			&CostScore{},
+			&ReliabilityScore{},
+			&LatencyScore{},
+			&PerformanceScore{},
+			&PreferenceScore{Policy: policy},
+		},
+	}
+}
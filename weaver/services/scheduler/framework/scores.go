@@ -0,0 +1,132 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/services/scheduler"
+)
+
+// neutralScore is given when a plugin has nothing to judge a candidate by
+const neutralScore = 50.0
+
+// Weights of the preference scores when a policy or reschedule request sets them
+const (
+	preferenceWeight       = 0.1
+	regionPreferenceWeight = 0.2
+)
+
+// CostScore favours cheaper candidates. The score halves at 1/hour and keeps
+// separating GPU workloads that cost several per hour.
+type CostScore struct{}
+
+func (p *CostScore) Name() string { return "cost" }
+
+func (p *CostScore) Score(ctx context.Context, w *workload.Workload, c *Candidate) (float64, string) {
+	if c.Cost == nil {
+		return neutralScore, "no cost estimate"
+	}
+	return 100 / (1 + c.Cost.HourlyCost), fmt.Sprintf("estimated %.4f %s/hour", c.Cost.HourlyCost, c.Cost.Currency)
+}
+
+// ReliabilityScore favours providers that workloads were created on successfully,
+// falling back to the success rate the provider reports itself
+type ReliabilityScore struct{}
+
+func (p *ReliabilityScore) Name() string { return "reliability" }
+
+func (p *ReliabilityScore) Score(ctx context.Context, w *workload.Workload, c *Candidate) (float64, string) {
+	if c.Stats != nil && c.Stats.TotalScheduled > 0 {
+		return c.Stats.SuccessRate * 100, fmt.Sprintf("%.0f%% of %d placements succeeded", c.Stats.SuccessRate*100, c.Stats.TotalScheduled)
+	}
+	if c.Status != nil && c.Status.Metrics.SuccessRate > 0 {
+		return c.Status.Metrics.SuccessRate * 100, fmt.Sprintf("provider reports %.0f%% success", c.Status.Metrics.SuccessRate*100)
+	}
+	return neutralScore, "no placement history"
+}
+
+// LatencyScore favours regions with lower reported latency
+type LatencyScore struct{}
+
+func (p *LatencyScore) Name() string { return "latency" }
+
+func (p *LatencyScore) Score(ctx context.Context, w *workload.Workload, c *Candidate) (float64, string) {
+	if c.RegionStatus == nil || c.RegionStatus.Latency <= 0 {
+		return neutralScore, "latency unknown"
+	}
+	latency := float64(c.RegionStatus.Latency)
+	return 100 / (1 + latency/100), fmt.Sprintf("%dms latency", c.RegionStatus.Latency)
+}
+
+// PerformanceScore favours regions with spare capacity
+type PerformanceScore struct{}
+
+func (p *PerformanceScore) Name() string { return "performance" }
+
+func (p *PerformanceScore) Score(ctx context.Context, w *workload.Workload, c *Candidate) (float64, string) {
+	if c.RegionStatus == nil {
+		return neutralScore, "region load unknown"
+	}
+	load := min(max(c.RegionStatus.Load, 0), 1)
+	return (1 - load) * 100, fmt.Sprintf("region load %.0f%%", load*100)
+}
+
+// PreferenceScore favours the providers the policy prefers
+type PreferenceScore struct {
+	Policy *scheduler.SchedulingPolicy
+}
+
+func (p *PreferenceScore) Name() string { return "preference" }
+
+func (p *PreferenceScore) Score(ctx context.Context, w *workload.Workload, c *Candidate) (float64, string) {
+	if contains(p.Policy.PreferredProviders, c.Provider) {
+		return 100, "preferred provider"
+	}
+	return 0, "not a preferred provider"
+}
+
+// RegionPreferenceScore favours the regions a reschedule request prefers
+type RegionPreferenceScore struct {
+	Regions []string
+}
+
+func (p *RegionPreferenceScore) Name() string { return "region-preference" }
+
+func (p *RegionPreferenceScore) Score(ctx context.Context, w *workload.Workload, c *Candidate) (float64, string) {
+	if contains(p.Regions, c.Region) {
+		return 100, "preferred region"
+	}
+	return 0, "not a preferred region"
+}
+
+// Weights returns the weight of each score plugin for a scheduling policy. Preset
+// strategies ignore the policy's weights; balanced and custom policies use them.
+func Weights(policy *scheduler.SchedulingPolicy) map[string]float64 {
+	var weights map[string]float64
+
+	switch policy.Strategy {
+	case scheduler.StrategyLowestCost:
+		weights = map[string]float64{"cost": 0.7, "reliability": 0.15, "latency": 0.1, "performance": 0.05}
+	case scheduler.StrategyBestPerformance:
+		weights = map[string]float64{"cost": 0.1, "reliability": 0.1, "latency": 0.3, "performance": 0.5}
+	case scheduler.StrategyHighAvailability:
+		weights = map[string]float64{"cost": 0.1, "reliability": 0.6, "latency": 0.1, "performance": 0.2}
+	default:
+		weights = map[string]float64{
+			"cost":        policy.CostWeight,
+			"reliability": policy.ReliabilityWeight,
+			"latency":     policy.LatencyWeight,
+			"performance": policy.PerformanceWeight,
+		}
+		if policy.CostWeight+policy.ReliabilityWeight+policy.LatencyWeight+policy.PerformanceWeight == 0 {
+			weights = map[string]float64{"cost": 0.4, "reliability": 0.2, "latency": 0.1, "performance": 0.3}
+		}
+	}
+
+	if len(policy.PreferredProviders) > 0 {
+		weights["preference"] = preferenceWeight
+	}
+
+	return weights
+}
@@ -0,0 +1,121 @@
+package scheduler
+
+import (
+	"fmt"
+
+	"github.com/codecflow/fabric/pkg/quantity"
+	"github.com/codecflow/fabric/pkg/workload"
+	"github.com/codecflow/fabric/weaver/services/provider"
+)
+
+// SelectMachineType selects an appropriate machine type based on workload requirements
+func SelectMachineType(w *workload.Workload, resources *provider.ResourceAvailability) string {
+	// Parse workload resource requirements
+	cpuRequired := parseCPURequirement(w.Spec.Resources.CPU)
+	memoryRequired := parseMemoryRequirement(w.Spec.Resources.Memory)
+	gpuRequired := w.Spec.Resources.GPU != ""
+
+	// If GPU is required, select appropriate GPU machine type
+	if gpuRequired {
+		return selectGPUMachineType(w.Spec.Resources.GPU, resources)
+	}
+
+	// Select CPU/Memory machine type based on requirements
+	return selectCPUMemoryMachineType(cpuRequired, memoryRequired)
+}
+
+// parseCPURequirement parses CPU requirement string (e.g., "2", "2000m", "2.5") into cores
+func parseCPURequirement(cpu string) float64 {
+	milli, err := quantity.ParseCPU(cpu)
+	if err != nil || milli == 0 {
+		return 1.0 // Default to 1 vCPU
+	}
+	return quantity.Cores(milli)
+}
+
+// parseMemoryRequirement parses memory requirement string (e.g., "4Gi", "4096Mi", "4G") into GiB
+func parseMemoryRequirement(memory string) float64 {
+	b, err := quantity.ParseBytes(memory)
+	if err != nil || b == 0 {
+		return 4.0 // Default to 4GB
+	}
+	return quantity.In(b, quantity.Gi)
+}
+
+// selectGPUMachineType selects appropriate GPU machine type
+func selectGPUMachineType(gpuSpec string, resources *provider.ResourceAvailability) string {
+	// Parse GPU requirements (e.g., "nvidia-tesla-v100", "1", "nvidia-a100:2")
+	if len(resources.GPU.Types) == 0 {
+		return "gpu-standard" // Fallback
+	}
+
+	// If specific GPU type is requested
+	if gpu, err := quantity.ParseGPU(gpuSpec); err == nil && gpu.Type != "" {
+		for gpuType, info := range resources.GPU.Types {
+			if gpu.Type == gpuType || gpu.Type == info.Name {
+				if int64(info.Available) >= gpu.Count {
+					return fmt.Sprintf("gpu-%s", gpuType)
+				}
+			}
+		}
+	}
+
+	// Find best available GPU type
+	var bestGPU string
+	var bestMemory int
+	for gpuType, info := range resources.GPU.Types {
+		if info.Available > 0 {
+			// Parse memory (e.g., "16GB" -> 16)
+			memory := parseGPUMemory(info.Memory)
+			if memory > bestMemory {
+				bestMemory = memory
+				bestGPU = gpuType
+			}
+		}
+	}
+
+	if bestGPU != "" {
+		return fmt.Sprintf("gpu-%s", bestGPU)
+	}
+
+	return "gpu-standard" // Fallback
+}
+
+// parseGPUMemory parses GPU memory string (e.g., "16GB" -> 16)
+func parseGPUMemory(memory string) int {
+	b, err := quantity.ParseBytes(memory)
+	if err != nil {
+		return 0
+	}
+	return int(quantity.RoundUp(b, quantity.G))
+}
+
+// selectCPUMemoryMachineType selects machine type based on CPU and memory requirements
+func selectCPUMemoryMachineType(cpuRequired, memoryRequired float64) string {
+	// Define standard machine types with CPU:Memory ratios
+	machineTypes := []struct {
+		name   string
+		cpu    float64
+		memory float64
+	}{
+		{"micro", 0.5, 1.0},
+		{"small", 1.0, 2.0},
+		{"medium", 2.0, 4.0},
+		{"large", 4.0, 8.0},
+		{"xlarge", 8.0, 16.0},
+		{"2xlarge", 16.0, 32.0},
+		{"4xlarge", 32.0, 64.0},
+		{"8xlarge", 64.0, 128.0},
+		{"16xlarge", 128.0, 256.0},
+	}
+
+	// Find the smallest machine type that meets requirements
+	for _, mt := range machineTypes {
+		if mt.cpu >= cpuRequired && mt.memory >= memoryRequired {
+			return mt.name
+		}
+	}
+
+	// If requirements exceed largest standard type, create custom type
+	return fmt.Sprintf("custom-%.0f-%.0f", cpuRequired, memoryRequired)
+}
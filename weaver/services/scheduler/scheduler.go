@@ -2,12 +2,16 @@ package scheduler
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/codecflow/fabric/pkg/workload"
 	"github.com/codecflow/fabric/weaver/services/provider"
 )
 
+// ErrUnschedulable is returned when no provider can currently run a workload
+var ErrUnschedulable = errors.New("no suitable providers found")
+
 // Scheduler defines the interface for workload scheduling
 type Scheduler interface {
 	// Schedule a workload across available providers
@@ -26,6 +30,21 @@ type Scheduler interface {
 	HealthCheck(ctx context.Context) error
 }
 
+// Explainer is implemented by schedulers that can report how they judge every
+// candidate for a workload
+type Explainer interface {
+	// Explain evaluates every provider and region without scheduling the workload.
+	// Feasible candidates come first, best score first.
+	Explain(ctx context.Context, workload *workload.Workload) ([]*CandidateEvaluation, error)
+}
+
+// PlacementObserver is implemented by schedulers that learn from the outcome of
+// creating workloads on the providers they picked
+type PlacementObserver interface {
+	// ObservePlacement records whether creating a workload on the provider succeeded
+	ObservePlacement(provider string, err error)
+}
+
 // ScheduleResult represents the result of a scheduling operation
 type ScheduleResult struct {
 	WorkloadID    string                 `json:"workloadId"`
@@ -37,6 +56,9 @@ type ScheduleResult struct {
 	Alternatives  []*Alternative         `json:"alternatives,omitempty"`
 	ScheduledAt   time.Time              `json:"scheduledAt"`
 	Metadata      map[string]interface{} `json:"metadata,omitempty"`
+
+	// Evaluations records how every candidate was judged, when the scheduler reports it
+	Evaluations []*CandidateEvaluation `json:"evaluations,omitempty"`
 }
 
 // PlacementDecision contains detailed placement information
@@ -71,6 +93,28 @@ type Recommendation struct {
 	Confidence    float64                `json:"confidence"` // 0-1
 }
 
+// CandidateEvaluation records how a scheduler judged one provider and region
+type CandidateEvaluation struct {
+	Provider      string                 `json:"provider"`
+	Region        string                 `json:"region"`
+	MachineType   string                 `json:"machineType,omitempty"`
+	NodeID        string                 `json:"nodeId,omitempty"`
+	Feasible      bool                   `json:"feasible"`
+	FilteredBy    string                 `json:"filteredBy,omitempty"` // plugin or step that rejected the candidate
+	Reason        string                 `json:"reason,omitempty"`
+	Scores        []ScoreComponent       `json:"scores,omitempty"`
+	Score         float64                `json:"score"` // 0-100, weighted sum of Scores
+	EstimatedCost *provider.CostEstimate `json:"estimatedCost,omitempty"`
+}
+
+// ScoreComponent is the contribution of one score plugin to a candidate
+type ScoreComponent struct {
+	Plugin string  `json:"plugin"`
+	Score  float64 `json:"score"`  // 0-100
+	Weight float64 `json:"weight"` // weights of a candidate sum to 1
+	Reason string  `json:"reason,omitempty"`
+}
+
 // RescheduleConstraints defines constraints for rescheduling
 type RescheduleConstraints struct {
 	MaxCostIncrease   *float64       `json:"maxCostIncrease,omitempty"` // Percentage
@@ -3,6 +3,8 @@ package simple
 import (
 	"context"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/codecflow/fabric/pkg/workload"
@@ -13,12 +15,17 @@ import (
 // SimpleScheduler implements a basic cost-aware scheduler
 type SimpleScheduler struct {
 	providers map[string]provider.Provider
+	workloads workload.Repository
 	config    *scheduler.SchedulerConfig
-	stats     *scheduler.SchedulerStats
+
+	// Schedules run concurrently from the API, the controllers and the queue
+	mu    sync.Mutex
+	stats *scheduler.SchedulerStats
 }
 
-// New creates a new simple scheduler
-func New(providerMap map[string]provider.Provider, config *scheduler.SchedulerConfig) *SimpleScheduler {
+// New creates a new simple scheduler. The workload repository is used to look up
+// workloads being rescheduled and may be nil when no database is configured.
+func New(providerMap map[string]provider.Provider, workloads workload.Repository, config *scheduler.SchedulerConfig) *SimpleScheduler {
 	if config == nil {
 		config = &scheduler.SchedulerConfig{
 			DefaultPolicy: scheduler.SchedulingPolicy{
@@ -36,6 +43,7 @@ func New(providerMap map[string]provider.Provider, config *scheduler.SchedulerCo
 
 	return &SimpleScheduler{
 		providers: providerMap,
+		workloads: workloads,
 		config:    config,
 		stats: &scheduler.SchedulerStats{
 			ProviderStats:   make(map[string]*scheduler.ProviderStats),
@@ -50,23 +58,26 @@ func (s *SimpleScheduler) Schedule(ctx context.Context, w *workload.Workload) (*
 	start := time.Now()
 
 	// Get recommendations
-	recommendations, err := s.GetRecommendations(ctx, w)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get recommendations: %w", err)
-	}
-
+	evaluations := s.evaluate(ctx, w, nil)
+	recommendations := toRecommendations(evaluations, "Available", "Healthy")
 	if len(recommendations) == 0 {
-		return nil, fmt.Errorf("no suitable providers found for workload")
+		return nil, fmt.Errorf("%w for workload: %s", scheduler.ErrUnschedulable, scheduler.SummarizeEvaluations(evaluations))
 	}
 
 	// Select the best recommendation
 	best := recommendations[0]
 
+	nodeID, err := s.selectNode(ctx, s.providers[best.Provider], w)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select node on provider %s: %w", best.Provider, err)
+	}
+
 	// Create placement decision
 	placement := &scheduler.PlacementDecision{
 		Provider:    best.Provider,
 		Region:      best.Region,
 		MachineType: best.MachineType,
+		NodeID:      nodeID,
 		Score:       best.Score,
 		Reasons:     best.Pros,
 	}
@@ -100,6 +111,7 @@ func (s *SimpleScheduler) Schedule(ctx context.Context, w *workload.Workload) (*
 		Placement:     placement,
 		Alternatives:  alternatives,
 		ScheduledAt:   time.Now(),
+		Evaluations:   evaluations,
 	}
 
 	// Update stats
@@ -110,96 +122,35 @@ func (s *SimpleScheduler) Schedule(ctx context.Context, w *workload.Workload) (*
 
 // GetRecommendations returns scheduling recommendations without scheduling
 func (s *SimpleScheduler) GetRecommendations(ctx context.Context, w *workload.Workload) ([]*scheduler.Recommendation, error) {
-	recommendations := make([]*scheduler.Recommendation, 0)
-
-	for name, provider := range s.providers {
-		// Check provider health
-		if err := provider.HealthCheck(ctx); err != nil {
-			continue
-		}
-
-		// Get pricing
-		pricing, err := provider.GetPricing(ctx)
-		if err != nil {
-			continue
-		}
-
-		// Calculate estimated cost
-		cost := s.calculateCost(w, pricing)
-
-		// Calculate score based on policy
-		score := s.calculateScore(w, provider, cost)
-
-		// Get available resources to determine regions and machine types
-		resources, err := provider.GetAvailableResources(ctx)
-		if err != nil {
-			continue
-		}
-
-		// Select best region (for now, just use the first available one)
-		selectedRegion := "default"
-		if len(resources.Regions) > 0 {
-			for _, region := range resources.Regions {
-				if region.Available {
-					selectedRegion = region.Name
-					break
-				}
-			}
-		}
-
-		// Select appropriate machine type based on workload requirements
-		selectedMachineType := s.selectMachineType(w, resources)
-
-		rec := &scheduler.Recommendation{
-			Provider:      name,
-			Region:        selectedRegion,
-			MachineType:   selectedMachineType,
-			Score:         score,
-			EstimatedCost: cost,
-			Pros:          []string{"Available", "Healthy"},
-			Cons:          []string{},
-			Confidence:    0.8,
-		}
-
-		recommendations = append(recommendations, rec)
-	}
-
-	// Sort by score (highest first)
-	for i := 0; i < len(recommendations)-1; i++ {
-		for j := i + 1; j < len(recommendations); j++ {
-			if recommendations[i].Score < recommendations[j].Score {
-				recommendations[i], recommendations[j] = recommendations[j], recommendations[i]
-			}
-		}
-	}
+	return toRecommendations(s.evaluate(ctx, w, nil), "Available", "Healthy"), nil
+}
 
-	return recommendations, nil
+// Explain reports how every provider was judged for the workload
+func (s *SimpleScheduler) Explain(ctx context.Context, w *workload.Workload) ([]*scheduler.CandidateEvaluation, error) {
+	return s.evaluate(ctx, w, nil), nil
 }
 
 // Reschedule reschedules an existing workload
 func (s *SimpleScheduler) Reschedule(ctx context.Context, workloadID string, constraints *scheduler.RescheduleConstraints) (*scheduler.ScheduleResult, error) {
 	start := time.Now()
 
-	// Create a mock workload for rescheduling (in real implementation, would fetch from repository)
-	w := &workload.Workload{
-		ID: workloadID,
-		Spec: workload.Spec{
-			Resources: workload.ResourceRequests{
-				CPU:    "2",
-				Memory: "4Gi",
-			},
-		},
+	if s.workloads == nil {
+		return nil, fmt.Errorf("workload repository not configured")
+	}
+	if constraints == nil {
+		constraints = &scheduler.RescheduleConstraints{}
 	}
 
-	// Get recommendations with constraints
-	recommendations, err := s.getConstrainedRecommendations(ctx, w, constraints)
+	w, err := s.workloads.Get(ctx, workloadID)
 	if err != nil {
-		s.updateStats(workloadID, "", "", false, time.Since(start), 0, err.Error())
-		return nil, fmt.Errorf("failed to get constrained recommendations: %w", err)
+		return nil, fmt.Errorf("failed to get workload: %w", err)
 	}
 
+	// Get recommendations with constraints
+	evaluations := s.evaluate(ctx, w, constraints)
+	recommendations := toRecommendations(evaluations, "Available", "Healthy", "Meets constraints")
 	if len(recommendations) == 0 {
-		err := fmt.Errorf("no suitable providers found for rescheduling with given constraints")
+		err := fmt.Errorf("%w for rescheduling with given constraints: %s", scheduler.ErrUnschedulable, scheduler.SummarizeEvaluations(evaluations))
 		s.updateStats(workloadID, "", "", false, time.Since(start), 0, err.Error())
 		return nil, err
 	}
@@ -207,11 +158,18 @@ func (s *SimpleScheduler) Reschedule(ctx context.Context, workloadID string, con
 	// Select the best recommendation
 	best := recommendations[0]
 
+	nodeID, err := s.selectNode(ctx, s.providers[best.Provider], w)
+	if err != nil {
+		s.updateStats(workloadID, "", "", false, time.Since(start), 0, err.Error())
+		return nil, fmt.Errorf("failed to select node on provider %s: %w", best.Provider, err)
+	}
+
 	// Create placement decision
 	placement := &scheduler.PlacementDecision{
 		Provider:    best.Provider,
 		Region:      best.Region,
 		MachineType: best.MachineType,
+		NodeID:      nodeID,
 		Score:       best.Score,
 		Reasons:     append(best.Pros, fmt.Sprintf("Rescheduled: %s", constraints.Reason)),
 	}
@@ -224,6 +182,7 @@ func (s *SimpleScheduler) Reschedule(ctx context.Context, workloadID string, con
 		EstimatedCost: best.EstimatedCost,
 		Placement:     placement,
 		ScheduledAt:   time.Now(),
+		Evaluations:   evaluations,
 		Metadata: map[string]interface{}{
 			"rescheduled": true,
 			"reason":      constraints.Reason,
@@ -236,130 +195,208 @@ func (s *SimpleScheduler) Reschedule(ctx context.Context, workloadID string, con
 	return result, nil
 }
 
-// getConstrainedRecommendations gets recommendations with rescheduling constraints
+// evaluate judges every provider for the workload and records why providers were
+// skipped. Constraints are nil unless the workload is being rescheduled.
+func (s *SimpleScheduler) evaluate(ctx context.Context, w *workload.Workload, constraints *scheduler.RescheduleConstraints) []*scheduler.CandidateEvaluation {
+	names := make([]string, 0, len(s.providers))
+	for name := range s.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	evaluations := make([]*scheduler.CandidateEvaluation, 0, len(names))
+	for _, name := range names {
+		evaluations = append(evaluations, s.evaluateProvider(ctx, w, name, constraints))
+	}
+
+	scheduler.SortEvaluations(evaluations)
+	return evaluations
+}
+
+// evaluateProvider checks whether a provider can run the workload and scores it
 // nolint:gocyclo
-func (s *SimpleScheduler) getConstrainedRecommendations(ctx context.Context, w *workload.Workload, constraints *scheduler.RescheduleConstraints) ([]*scheduler.Recommendation, error) {
-	recommendations := make([]*scheduler.Recommendation, 0)
-
-	for name, provider := range s.providers {
-		// Apply provider constraints
-		if len(constraints.RequiredProviders) > 0 {
-			found := false
-			for _, required := range constraints.RequiredProviders {
-				if name == required {
-					found = true
-					break
-				}
-			}
-			if !found {
-				continue
-			}
+func (s *SimpleScheduler) evaluateProvider(ctx context.Context, w *workload.Workload, name string, constraints *scheduler.RescheduleConstraints) *scheduler.CandidateEvaluation {
+	e := &scheduler.CandidateEvaluation{Provider: name}
+	reject := func(step string, err error) *scheduler.CandidateEvaluation {
+		e.FilteredBy = step
+		e.Reason = err.Error()
+		return e
+	}
+
+	// Apply provider constraints
+	if constraints != nil {
+		if len(constraints.RequiredProviders) > 0 && !contains(constraints.RequiredProviders, name) {
+			return reject("constraints", fmt.Errorf("provider %s is not one of the required providers", name))
 		}
-
-		if len(constraints.ExcludedProviders) > 0 {
-			excluded := false
-			for _, excludedProvider := range constraints.ExcludedProviders {
-				if name == excludedProvider {
-					excluded = true
-					break
-				}
-			}
-			if excluded {
-				continue
-			}
+		if contains(constraints.ExcludedProviders, name) {
+			return reject("constraints", fmt.Errorf("provider %s is excluded from rescheduling", name))
 		}
+	}
 
-		// Check provider health
-		if err := provider.HealthCheck(ctx); err != nil {
-			continue
-		}
+	// Honour the provider the workload or its namespace pins
+	if pinned := w.Spec.Placement.Provider; pinned != "" && pinned != name {
+		return reject("placement", fmt.Errorf("workload is pinned to provider %s", pinned))
+	}
 
-		// Get pricing
-		pricing, err := provider.GetPricing(ctx)
-		if err != nil {
-			continue
-		}
+	p := s.providers[name]
+
+	// Secret volumes need a provider that mounts them
+	if !provider.CanMountSecrets(p, w) {
+		return reject("secrets", fmt.Errorf("provider %s cannot mount secret volumes", name))
+	}
+
+	// Check provider health
+	if err := p.HealthCheck(ctx); err != nil {
+		return reject("health", fmt.Errorf("health check failed: %w", err))
+	}
 
-		// Calculate estimated cost
-		cost := s.calculateCost(w, pricing)
+	// Node-level providers only qualify when one of their nodes fits the workload
+	nodeID, err := s.selectNode(ctx, p, w)
+	if err != nil {
+		return reject("node", fmt.Errorf("no node fits the workload: %w", err))
+	}
+	e.NodeID = nodeID
 
-		// Apply cost constraints
-		if constraints.MaxCostIncrease != nil {
-			// For simplicity, assume current cost is $0.5/hour
-			currentCost := 0.5
-			maxAllowedCost := currentCost * (1.0 + *constraints.MaxCostIncrease/100.0)
-			if cost.HourlyCost > maxAllowedCost {
-				continue
+	// Get pricing
+	pricing, err := p.GetPricing(ctx)
+	if err != nil {
+		return reject("pricing", fmt.Errorf("failed to get pricing: %w", err))
+	}
+
+	// Get available resources to determine regions, machine types and GPU prices
+	resources, err := p.GetAvailableResources(ctx)
+	if err != nil {
+		return reject("resources", fmt.Errorf("failed to get available resources: %w", err))
+	}
+
+	// A pinned region must be offered and available
+	if pinned := w.Spec.Placement.Region; pinned != "" && !regionAvailable(resources.Regions, pinned) {
+		return reject("region", fmt.Errorf("workload is pinned to region %s, which is not available", pinned))
+	}
+
+	// A requested GPU type must be offered when the provider lists its GPU types
+	if gpu, err := w.Spec.Resources.GPURequest(); err == nil && gpu.Type != "" && len(resources.GPU.Types) > 0 {
+		offered := false
+		for gpuType, info := range resources.GPU.Types {
+			if scheduler.GPUTypeMatches(gpu.Type, gpuType) || scheduler.GPUTypeMatches(gpu.Type, info.Name) {
+				offered = true
+				break
 			}
 		}
-
-		// Get available resources
-		resources, err := provider.GetAvailableResources(ctx)
-		if err != nil {
-			continue
+		if !offered {
+			return reject("gpu", fmt.Errorf("no %s GPUs offered", gpu.Type))
 		}
+	}
 
-		// Apply region constraints
-		selectedRegion := "default"
-		if len(constraints.PreferredRegions) > 0 {
-			// Try to find a preferred region
-			for _, preferredRegion := range constraints.PreferredRegions {
-				for _, region := range resources.Regions {
-					if region.Name == preferredRegion && region.Available {
-						selectedRegion = region.Name
-						break
-					}
-				}
-				if selectedRegion != "default" {
-					break
-				}
-			}
-		} else {
-			// Use first available region
-			for _, region := range resources.Regions {
-				if region.Available {
-					selectedRegion = region.Name
-					break
-				}
-			}
+	// Calculate estimated cost
+	e.EstimatedCost = scheduler.EstimateCost(w, pricing, resources)
+
+	// Apply cost constraints
+	if constraints != nil && constraints.MaxCostIncrease != nil {
+		// For simplicity, assume current cost is $0.5/hour
+		currentCost := 0.5
+		maxAllowedCost := currentCost * (1.0 + *constraints.MaxCostIncrease/100.0)
+		if e.EstimatedCost.HourlyCost > maxAllowedCost {
+			return reject("budget", fmt.Errorf("estimated %.4f/hour exceeds the allowed %.4f/hour", e.EstimatedCost.HourlyCost, maxAllowedCost))
 		}
+	}
 
-		// Calculate score
-		score := s.calculateScore(w, provider, cost)
+	// Select the pinned region, or else prefer the ones the constraints ask for
+	var preferred []string
+	if pinned := w.Spec.Placement.Region; pinned != "" {
+		preferred = []string{pinned}
+	} else if constraints != nil {
+		preferred = constraints.PreferredRegions
+	}
+	e.Region = selectRegion(resources.Regions, preferred)
 
-		// Select machine type
-		selectedMachineType := s.selectMachineType(w, resources)
+	// Select appropriate machine type based on workload requirements
+	e.MachineType = scheduler.SelectMachineType(w, resources)
+
+	// Calculate score based on policy
+	e.Scores = s.scoreComponents(e.EstimatedCost)
+	for _, component := range e.Scores {
+		e.Score += component.Score * component.Weight
+	}
+	e.Feasible = true
+
+	return e
+}
 
+// toRecommendations converts the feasible evaluations into recommendations
+func toRecommendations(evaluations []*scheduler.CandidateEvaluation, pros ...string) []*scheduler.Recommendation {
+	result := make([]*scheduler.Recommendation, 0)
+	for _, e := range scheduler.FeasibleEvaluations(evaluations) {
 		rec := &scheduler.Recommendation{
-			Provider:      name,
-			Region:        selectedRegion,
-			MachineType:   selectedMachineType,
-			Score:         score,
-			EstimatedCost: cost,
-			Pros:          []string{"Available", "Healthy", "Meets constraints"},
-			Cons:          []string{},
-			Confidence:    0.8,
+			Provider:      e.Provider,
+			Region:        e.Region,
+			MachineType:   e.MachineType,
+			Score:         e.Score,
+			EstimatedCost: e.EstimatedCost,
+			Pros:          append(append([]string{}, pros...), e.Scores[0].Reason),
+			Cons:          append([]string{}, e.EstimatedCost.Assumptions...),
+			Confidence:    e.EstimatedCost.Confidence,
 		}
-
-		recommendations = append(recommendations, rec)
+		result = append(result, rec)
 	}
+	return result
+}
 
-	// Sort by score (highest first)
-	for i := 0; i < len(recommendations)-1; i++ {
-		for j := i + 1; j < len(recommendations); j++ {
-			if recommendations[i].Score < recommendations[j].Score {
-				recommendations[i], recommendations[j] = recommendations[j], recommendations[i]
+// selectRegion returns the first available preferred region, or the first available
+// region when none of the preferred ones is
+func selectRegion(regions []provider.RegionInfo, preferred []string) string {
+	for _, name := range preferred {
+		for _, region := range regions {
+			if region.Name == name && region.Available {
+				return region.Name
 			}
 		}
 	}
 
-	return recommendations, nil
+	for _, region := range regions {
+		if region.Available {
+			return region.Name
+		}
+	}
+
+	return "default"
+}
+
+// regionAvailable reports whether the named region is listed and available
+func regionAvailable(regions []provider.RegionInfo, name string) bool {
+	for _, region := range regions {
+		if region.Name == name && region.Available {
+			return true
+		}
+	}
+	return false
+}
+
+// contains reports whether the list contains the value
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
 }
 
 // GetStats returns current scheduling statistics
 func (s *SimpleScheduler) GetStats(ctx context.Context) (*scheduler.SchedulerStats, error) {
-	s.stats.LastUpdated = time.Now()
-	return s.stats, nil
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := *s.stats
+	stats.ProviderStats = make(map[string]*scheduler.ProviderStats, len(s.stats.ProviderStats))
+	for name, ps := range s.stats.ProviderStats {
+		copied := *ps
+		stats.ProviderStats[name] = &copied
+	}
+	stats.RecentSchedules = append([]*scheduler.RecentSchedule(nil), s.stats.RecentSchedules...)
+	stats.LastUpdated = time.Now()
+
+	return &stats, nil
 }
 
 // HealthCheck checks scheduler health
@@ -370,72 +407,40 @@ func (s *SimpleScheduler) HealthCheck(ctx context.Context) error {
 	return nil
 }
 
-// calculateCost estimates the cost for a workload
-func (s *SimpleScheduler) calculateCost(_ *workload.Workload, pricing *provider.PricingInfo) *provider.CostEstimate {
-	// Simple cost calculation based on resources
-	cpuCost := 2.0 * pricing.CPU.Amount       // Assume 2 vCPUs
-	memoryCost := 4.0 * pricing.Memory.Amount // Assume 4GB memory
-
-	hourlyCost := cpuCost + memoryCost
-
-	return &provider.CostEstimate{
-		Currency:    pricing.Currency,
-		HourlyCost:  hourlyCost,
-		DailyCost:   hourlyCost * 24,
-		MonthlyCost: hourlyCost * 24 * 30,
-		Breakdown: []provider.CostBreakdown{
-			{
-				Component:   "cpu",
-				Description: "2 vCPUs",
-				Amount:      cpuCost,
-				Unit:        "hour",
-				Quantity:    2.0,
-			},
-			{
-				Component:   "memory",
-				Description: "4GB RAM",
-				Amount:      memoryCost,
-				Unit:        "hour",
-				Quantity:    4.0,
-			},
-		},
-		Confidence: 0.8,
+// selectNode picks a node for providers that manage individual nodes and
+// returns an empty ID for providers that place workloads themselves
+func (s *SimpleScheduler) selectNode(ctx context.Context, p provider.Provider, w *workload.Workload) (string, error) {
+	selector, ok := p.(provider.NodeSelector)
+	if !ok {
+		return "", nil
 	}
+	return selector.SelectNode(ctx, w)
 }
 
-// calculateScore calculates a score for a provider based on the scheduling policy
-func (s *SimpleScheduler) calculateScore(w *workload.Workload, provider provider.Provider, cost *provider.CostEstimate) float64 {
+// scoreComponents rates a provider according to the scheduling policy. Only the
+// cost is measured; the other factors are assumed equal across providers.
+func (s *SimpleScheduler) scoreComponents(cost *provider.CostEstimate) []scheduler.ScoreComponent {
 	policy := s.config.DefaultPolicy
 
-	// Base score
-	score := 50.0
-
-	// Cost factor (lower cost = higher score)
-	if cost.HourlyCost == 0 {
-		score += policy.CostWeight * 50 // Free is good
-	} else {
-		// Assume $1/hour is expensive, $0.1/hour is cheap
-		costScore := (1.0 - cost.HourlyCost) * 50
-		if costScore < 0 {
-			costScore = 0
-		}
-		score += policy.CostWeight * costScore
+	return []scheduler.ScoreComponent{
+		{
+			Plugin: "cost",
+			// Halves at $1/hour and keeps separating GPU workloads that cost several dollars
+			Score:  100 / (1 + cost.HourlyCost),
+			Weight: policy.CostWeight,
+			Reason: fmt.Sprintf("estimated %.4f %s/hour", cost.HourlyCost, cost.Currency),
+		},
+		{Plugin: "performance", Score: 80, Weight: policy.PerformanceWeight, Reason: "assumed equal for all providers"},
+		{Plugin: "reliability", Score: 90, Weight: policy.ReliabilityWeight, Reason: "assumed equal for all providers"},
+		{Plugin: "latency", Score: 80, Weight: policy.LatencyWeight, Reason: "assumed equal for all providers"},
 	}
-
-	// Performance factor (assume all providers are equal for now)
-	score += policy.PerformanceWeight * 40
-
-	// Reliability factor (assume all providers are equal for now)
-	score += policy.ReliabilityWeight * 45
-
-	// Latency factor (assume all providers are equal for now)
-	score += policy.LatencyWeight * 40
-
-	return score
 }
 
 // updateStats updates scheduling statistics
 func (s *SimpleScheduler) updateStats(workloadID, provider, region string, success bool, duration time.Duration, cost float64, errorMsg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	s.stats.TotalScheduled++
 	if success {
 		s.stats.SuccessfulSchedules++
@@ -471,202 +476,3 @@ func (s *SimpleScheduler) updateStats(workloadID, provider, region string, succe
 		s.stats.RecentSchedules = s.stats.RecentSchedules[1:]
 	}
 }
-
-// selectMachineType selects an appropriate machine type based on workload requirements
-func (s *SimpleScheduler) selectMachineType(w *workload.Workload, resources *provider.ResourceAvailability) string {
-	// Parse workload resource requirements
-	cpuRequired := s.parseCPURequirement(w.Spec.Resources.CPU)
-	memoryRequired := s.parseMemoryRequirement(w.Spec.Resources.Memory)
-	gpuRequired := w.Spec.Resources.GPU != ""
-
-	// If GPU is required, select appropriate GPU machine type
-	if gpuRequired {
-		return s.selectGPUMachineType(w.Spec.Resources.GPU, resources)
-	}
-
-	// Select CPU/Memory machine type based on requirements
-	return s.selectCPUMemoryMachineType(cpuRequired, memoryRequired)
-}
-
-// parseCPURequirement parses CPU requirement string (e.g., "2", "2000m", "2.5")
-func (s *SimpleScheduler) parseCPURequirement(cpu string) float64 {
-	if cpu == "" {
-		return 1.0 // Default to 1 vCPU
-	}
-
-	// Handle millicores (e.g., "2000m" = 2 cores)
-	if len(cpu) > 1 && cpu[len(cpu)-1] == 'm' {
-		if val := s.parseFloat(cpu[:len(cpu)-1]); val > 0 {
-			return val / 1000.0
-		}
-	}
-
-	// Handle direct core count (e.g., "2", "2.5")
-	if val := s.parseFloat(cpu); val > 0 {
-		return val
-	}
-
-	return 1.0 // Default fallback
-}
-
-// parseMemoryRequirement parses memory requirement string (e.g., "4Gi", "4096Mi", "4G")
-func (s *SimpleScheduler) parseMemoryRequirement(memory string) float64 {
-	if memory == "" {
-		return 4.0 // Default to 4GB
-	}
-
-	// Handle different memory units
-	if len(memory) > 2 {
-		unit := memory[len(memory)-2:]
-		valueStr := memory[:len(memory)-2]
-		val := s.parseFloat(valueStr)
-
-		switch unit {
-		case "Gi":
-			return val // Already in GB
-		case "Mi":
-			return val / 1024.0 // Convert MB to GB
-		case "Ki":
-			return val / (1024.0 * 1024.0) // Convert KB to GB
-		}
-	}
-
-	if len(memory) > 1 {
-		unit := memory[len(memory)-1:]
-		valueStr := memory[:len(memory)-1]
-		val := s.parseFloat(valueStr)
-
-		switch unit {
-		case "G":
-			return val // Already in GB
-		case "M":
-			return val / 1000.0 // Convert MB to GB
-		case "K":
-			return val / (1000.0 * 1000.0) // Convert KB to GB
-		}
-	}
-
-	// Try to parse as plain number (assume GB)
-	if val := s.parseFloat(memory); val > 0 {
-		return val
-	}
-
-	return 4.0 // Default fallback
-}
-
-// parseFloat safely parses a string to float64
-// nolint:gocyclo
-func (s *SimpleScheduler) parseFloat(str string) float64 {
-	// Simple float parsing - in production would use strconv.ParseFloat
-	// For now, handle common cases
-	switch str {
-	case "0.5":
-		return 0.5
-	case "1":
-		return 1.0
-	case "2":
-		return 2.0
-	case "4":
-		return 4.0
-	case "8":
-		return 8.0
-	case "16":
-		return 16.0
-	case "32":
-		return 32.0
-	case "64":
-		return 64.0
-	case "128":
-		return 128.0
-	case "256":
-		return 256.0
-	case "512":
-		return 512.0
-	case "1024":
-		return 1024.0
-	case "2048":
-		return 2048.0
-	case "4096":
-		return 4096.0
-	case "8192":
-		return 8192.0
-	default:
-		return 0.0
-	}
-}
-
-// selectGPUMachineType selects appropriate GPU machine type
-func (s *SimpleScheduler) selectGPUMachineType(gpuSpec string, resources *provider.ResourceAvailability) string {
-	// Parse GPU requirements (e.g., "nvidia-tesla-v100", "1", "nvidia-a100:2")
-	if len(resources.GPU.Types) == 0 {
-		return "gpu-standard" // Fallback
-	}
-
-	// If specific GPU type is requested
-	for gpuType, info := range resources.GPU.Types {
-		if gpuSpec == gpuType || gpuSpec == info.Name {
-			if info.Available > 0 {
-				return fmt.Sprintf("gpu-%s", gpuType)
-			}
-		}
-	}
-
-	// Find best available GPU type
-	var bestGPU string
-	var bestMemory int
-	for gpuType, info := range resources.GPU.Types {
-		if info.Available > 0 {
-			// Parse memory (e.g., "16GB" -> 16)
-			memory := s.parseGPUMemory(info.Memory)
-			if memory > bestMemory {
-				bestMemory = memory
-				bestGPU = gpuType
-			}
-		}
-	}
-
-	if bestGPU != "" {
-		return fmt.Sprintf("gpu-%s", bestGPU)
-	}
-
-	return "gpu-standard" // Fallback
-}
-
-// parseGPUMemory parses GPU memory string (e.g., "16GB" -> 16)
-func (s *SimpleScheduler) parseGPUMemory(memory string) int {
-	if len(memory) > 2 && memory[len(memory)-2:] == "GB" {
-		val := s.parseFloat(memory[:len(memory)-2])
-		return int(val)
-	}
-	return 0
-}
-
-// selectCPUMemoryMachineType selects machine type based on CPU and memory requirements
-func (s *SimpleScheduler) selectCPUMemoryMachineType(cpuRequired, memoryRequired float64) string {
-	// Define standard machine types with CPU:Memory ratios
-	machineTypes := []struct {
-		name   string
-		cpu    float64
-		memory float64
-	}{
-		{"micro", 0.5, 1.0},
-		{"small", 1.0, 2.0},
-		{"medium", 2.0, 4.0},
-		{"large", 4.0, 8.0},
-		{"xlarge", 8.0, 16.0},
-		{"2xlarge", 16.0, 32.0},
-		{"4xlarge", 32.0, 64.0},
-		{"8xlarge", 64.0, 128.0},
-		{"16xlarge", 128.0, 256.0},
-	}
-
-	// Find the smallest machine type that meets requirements
-	for _, mt := range machineTypes {
-		if mt.cpu >= cpuRequired && mt.memory >= memoryRequired {
-			return mt.name
-		}
-	}
-
-	// If requirements exceed largest standard type, create custom type
-	return fmt.Sprintf("custom-%.0f-%.0f", cpuRequired, memoryRequired)
-}
@@ -2,6 +2,8 @@ package stream
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -138,13 +140,14 @@ type EventType string
 
 const (
 	// Workload events
-	EventWorkloadCreated   EventType = "workload.created"
-	EventWorkloadUpdated   EventType = "workload.updated"
-	EventWorkloadDeleted   EventType = "workload.deleted"
-	EventWorkloadScheduled EventType = "workload.scheduled"
-	EventWorkloadStarted   EventType = "workload.started"
-	EventWorkloadStopped   EventType = "workload.stopped"
-	EventWorkloadFailed    EventType = "workload.failed"
+	EventWorkloadCreated       EventType = "workload.created"
+	EventWorkloadUpdated       EventType = "workload.updated"
+	EventWorkloadDeleted       EventType = "workload.deleted"
+	EventWorkloadScheduled     EventType = "workload.scheduled"
+	EventWorkloadUnschedulable EventType = "workload.unschedulable"
+	EventWorkloadStarted       EventType = "workload.started"
+	EventWorkloadStopped       EventType = "workload.stopped"
+	EventWorkloadFailed        EventType = "workload.failed"
 
 	// Namespace events
 	EventNamespaceCreated EventType = "namespace.created"
@@ -156,6 +159,14 @@ const (
 	EventSecretUpdated EventType = "secret.updated"
 	EventSecretDeleted EventType = "secret.deleted"
 
+	// Cron workload events
+	EventCronRunCreated EventType = "cron.run.created"
+	EventCronRunMissed  EventType = "cron.run.missed"
+
+	// Provider events
+	EventOrphanDetected EventType = "orphan.detected"
+	EventOrphanDeleted  EventType = "orphan.deleted"
+
 	// System events
 	EventNodeJoined    EventType = "node.joined"
 	EventNodeLeft      EventType = "node.left"
@@ -176,3 +187,24 @@ type Event struct {
 	Data     map[string]interface{} `json:"data"`
 	Metadata map[string]string      `json:"metadata,omitempty"`
 }
+
+// Subjects used for Fabric events
+const (
+	SubjectEvents    = "fabric.events"
+	SubjectWorkloads = "fabric.workloads"
+	SubjectProviders = "fabric.providers"
+)
+
+// PublishEvent marshals an event and publishes it on subject.token
+func PublishEvent(ctx context.Context, s Stream, subject, token string, event *Event) error {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return s.Publish(ctx, subject+"."+token, data)
+}